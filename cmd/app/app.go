@@ -5,8 +5,8 @@ import (
 )
 
 // Version 应用版本号
-// 可以通过构建时注入: go build -ldflags "-X github.com/cyrnicolase/dev-tools/cmd/app.Version=1.8.1"
-var Version = "1.8.1"
+// 可以通过构建时注入: go build -ldflags "-X github.com/cyrnicolase/dev-tools/cmd/app.Version=1.9.0"
+var Version = "1.9.0"
 
 // GetVersion 获取应用版本号（包级别函数）
 func GetVersion() string {
@@ -50,6 +50,10 @@ func (a *App) Startup(ctx context.Context) {
 	a.Handlers.Hash.SetContext(ctx)
 	// 设置JSONHandler的上下文（用于文件对话框）
 	a.Handlers.JSON.SetContext(ctx)
+	// 设置Base64Handler的上下文（用于文件对话框）
+	a.Handlers.Base64.SetContext(ctx)
+	// 设置HexHandler的上下文（用于文件对话框）
+	a.Handlers.Hex.SetContext(ctx)
 }
 
 // GetVersion 获取应用版本号（实例方法）
@@ -17,6 +17,8 @@ type HandlerRegistry struct {
 	Translate    *handlers.TranslateHandler
 	Hash         *handlers.HashHandler
 	RandomString *handlers.RandomStringHandler
+	Hex          *handlers.HexHandler
+	Cron         *handlers.CronHandler
 }
 
 // NewHandlerRegistry 创建新的处理器注册表
@@ -32,6 +34,8 @@ func NewHandlerRegistry() *HandlerRegistry {
 		Translate:    handlers.NewTranslateHandler(),
 		Hash:         handlers.NewHashHandler(),
 		RandomString: handlers.NewRandomStringHandler(),
+		Hex:          handlers.NewHexHandler(),
+		Cron:         handlers.NewCronHandler(),
 	}
 }
 
@@ -1,6 +1,16 @@
 package handlers
 
 import (
+	"context"
+	"fmt"
+	"mime"
+	"os"
+	"path/filepath"
+
+	"github.com/wailsapp/wails/v2/pkg/runtime"
+
+	base64app "github.com/cyrnicolase/dev-tools/internal/base64/application"
+	base64domain "github.com/cyrnicolase/dev-tools/internal/base64/domain"
 	"github.com/cyrnicolase/dev-tools/internal/base64/interfaces"
 	historydomain "github.com/cyrnicolase/dev-tools/internal/history/domain"
 )
@@ -8,8 +18,14 @@ import (
 // Base64Handler Base64 工具处理器
 type Base64Handler struct {
 	api *interfaces.API
+	ctx context.Context
 }
 
+const (
+	// maxBase64FileSize Base64 工具可读取的最大文件大小
+	maxBase64FileSize = 50 * 1024 * 1024
+)
+
 // NewBase64Handler 创建新的 Base64Handler 实例
 func NewBase64Handler() *Base64Handler {
 	return &Base64Handler{
@@ -17,6 +33,11 @@ func NewBase64Handler() *Base64Handler {
 	}
 }
 
+// SetContext 设置上下文（用于文件对话框）
+func (h *Base64Handler) SetContext(ctx context.Context) {
+	h.ctx = ctx
+}
+
 // Encode 编码为 Base64
 func (h *Base64Handler) Encode(input string) string {
 	return h.api.Encode(input)
@@ -47,6 +68,247 @@ func (h *Base64Handler) ValidateURLSafe(input string) bool {
 	return h.api.ValidateURLSafe(input)
 }
 
+// ListVariants 获取支持的编码变体
+func (h *Base64Handler) ListVariants() []base64domain.VariantInfo {
+	return h.api.ListVariants()
+}
+
+// EncodeVariant 使用指定编码变体编码
+func (h *Base64Handler) EncodeVariant(variant, input string) (string, error) {
+	return h.api.EncodeVariant(variant, input)
+}
+
+// DecodeVariant 使用指定编码变体解码，lenient 为 true 时忽略空白并容忍缺失填充
+func (h *Base64Handler) DecodeVariant(variant, input string, lenient bool) (string, error) {
+	return h.api.DecodeVariant(variant, input, lenient)
+}
+
+// DetectVariant 自动识别编码变体
+func (h *Base64Handler) DetectVariant(input string) ([]base64domain.DetectResult, error) {
+	return h.api.DetectVariant(input)
+}
+
+// EncodeVariantWithAlphabet 使用自定义字母表编码，alphabet 为空时使用默认字母表
+func (h *Base64Handler) EncodeVariantWithAlphabet(variant, alphabet, input string) (string, error) {
+	return h.api.EncodeVariantWithAlphabet(variant, alphabet, input)
+}
+
+// DecodeVariantWithAlphabet 使用自定义字母表解码，alphabet 为空时使用默认字母表
+func (h *Base64Handler) DecodeVariantWithAlphabet(variant, alphabet, input string, lenient bool) (string, error) {
+	return h.api.DecodeVariantWithAlphabet(variant, alphabet, input, lenient)
+}
+
+// DetectVariantWithAlphabet 使用自定义字母表识别编码变体
+func (h *Base64Handler) DetectVariantWithAlphabet(input, alphabet string) ([]base64domain.DetectResult, error) {
+	return h.api.DetectVariantWithAlphabet(input, alphabet)
+}
+
+// ValidateAlphabet 校验自定义字母表
+func (h *Base64Handler) ValidateAlphabet(variant, alphabet string) error {
+	return h.api.ValidateAlphabet(variant, alphabet)
+}
+
+// DecodeBinary 二进制安全解码，非文本内容返回十六进制预览，图片额外提供预览
+func (h *Base64Handler) DecodeBinary(variant, input string, lenient bool) (*base64domain.DecodedPayload, error) {
+	return h.api.DecodeBinary(variant, input, lenient)
+}
+
+// OpenFileDialog 打开文件选择对话框
+func (h *Base64Handler) OpenFileDialog() (string, error) {
+	if h.ctx == nil {
+		return "", fmt.Errorf("上下文未初始化")
+	}
+
+	filePath, err := runtime.OpenFileDialog(h.ctx, runtime.OpenDialogOptions{
+		Title: "选择文件",
+	})
+	if err != nil {
+		return "", err
+	}
+	return filePath, nil
+}
+
+// EncodeFile 读取文件并使用指定编码变体编码
+func (h *Base64Handler) EncodeFile(variant, filePath string) (string, error) {
+	data, err := readLimitedFile(filePath, maxBase64FileSize)
+	if err != nil {
+		return "", err
+	}
+	return h.api.EncodeBytes(variant, data)
+}
+
+// DecodeToFileDialog 解码并通过保存对话框直接写入文件
+// 默认文件名根据识别出的 MIME 类型选择扩展名；用户取消时返回空字符串
+func (h *Base64Handler) DecodeToFileDialog(variant, input string, lenient bool) (string, error) {
+	if h.ctx == nil {
+		return "", fmt.Errorf("上下文未初始化")
+	}
+
+	data, err := h.api.DecodeBytes(variant, input, lenient)
+	if err != nil {
+		return "", err
+	}
+	extension := base64domain.ExtensionForMIMEType(h.api.DetectMIMEType(data))
+
+	filePath, err := runtime.SaveFileDialog(h.ctx, runtime.SaveDialogOptions{
+		Title:           "保存解码结果",
+		DefaultFilename: "decoded" + extension,
+	})
+	if err != nil {
+		return "", fmt.Errorf("打开保存对话框失败: %v", err)
+	}
+	if filePath == "" {
+		return "", nil
+	}
+
+	if err := os.WriteFile(filePath, data, 0644); err != nil {
+		return "", fmt.Errorf("保存文件失败: %v", err)
+	}
+	return filePath, nil
+}
+
+// BuildDataURI 将文本构建为 data URI，mediaType 为空时自动识别
+func (h *Base64Handler) BuildDataURI(input, mediaType string, useBase64 bool) string {
+	return h.api.BuildDataURI([]byte(input), mediaType, useBase64)
+}
+
+// BuildDataURIFromFile 读取文件并构建 data URI
+// mediaType 为空时优先根据扩展名推断（如 .svg、.css），否则根据文件内容识别
+func (h *Base64Handler) BuildDataURIFromFile(filePath, mediaType string, useBase64 bool) (string, error) {
+	data, err := readLimitedFile(filePath, maxBase64FileSize)
+	if err != nil {
+		return "", err
+	}
+	if mediaType == "" {
+		mediaType = mime.TypeByExtension(filepath.Ext(filePath))
+	}
+	return h.api.BuildDataURI(data, mediaType, useBase64), nil
+}
+
+// ParseDataURI 解析 data URI 的媒体类型、参数与内容
+func (h *Base64Handler) ParseDataURI(uri string) (*base64domain.DataURIInfo, error) {
+	return h.api.ParseDataURI(uri)
+}
+
+// SaveDataURIDialog 解析 data URI 并通过保存对话框写入文件，用户取消时返回空字符串
+func (h *Base64Handler) SaveDataURIDialog(uri string) (string, error) {
+	if h.ctx == nil {
+		return "", fmt.Errorf("上下文未初始化")
+	}
+
+	data, mediaType, err := h.api.DecodeDataURI(uri)
+	if err != nil {
+		return "", err
+	}
+
+	filePath, err := runtime.SaveFileDialog(h.ctx, runtime.SaveDialogOptions{
+		Title:           "保存 data URI 内容",
+		DefaultFilename: "data" + base64domain.ExtensionForMIMEType(mediaType),
+	})
+	if err != nil {
+		return "", fmt.Errorf("打开保存对话框失败: %v", err)
+	}
+	if filePath == "" {
+		return "", nil
+	}
+
+	if err := os.WriteFile(filePath, data, 0644); err != nil {
+		return "", fmt.Errorf("保存文件失败: %v", err)
+	}
+	return filePath, nil
+}
+
+// Compress 压缩文本，结果使用十六进制或 Base64 变体包装
+// codec 为 gzip、zlib、deflate、brotli、zstd；level 为 -1 时使用默认级别
+func (h *Base64Handler) Compress(codec, wrap, input string, level int) (*base64app.CompressResult, error) {
+	return h.api.Compress(codec, wrap, input, level)
+}
+
+// Decompress 解除包装并解压，codec/wrap 为 "auto" 时根据魔数自动识别
+func (h *Base64Handler) Decompress(codec, wrap, input string, formatJSON bool) (*base64app.DecompressResult, error) {
+	return h.api.Decompress(codec, wrap, input, formatJSON)
+}
+
+// DecompressFile 读取压缩文件并解压
+func (h *Base64Handler) DecompressFile(codec, filePath string, formatJSON bool) (*base64app.DecompressResult, error) {
+	data, err := readLimitedFile(filePath, maxBase64FileSize)
+	if err != nil {
+		return nil, err
+	}
+	return h.api.DecompressBytes(codec, data, formatJSON)
+}
+
+// CompressToFileDialog 压缩文本并通过保存对话框写入原始压缩文件，用户取消时返回空字符串
+func (h *Base64Handler) CompressToFileDialog(codec, input string, level int) (string, error) {
+	if h.ctx == nil {
+		return "", fmt.Errorf("上下文未初始化")
+	}
+
+	data, err := h.api.CompressBytes(codec, []byte(input), level)
+	if err != nil {
+		return "", err
+	}
+
+	filePath, err := runtime.SaveFileDialog(h.ctx, runtime.SaveDialogOptions{
+		Title:           "保存压缩文件",
+		DefaultFilename: "data" + compressedFileExtension(codec),
+	})
+	if err != nil {
+		return "", fmt.Errorf("打开保存对话框失败: %v", err)
+	}
+	if filePath == "" {
+		return "", nil
+	}
+
+	if err := os.WriteFile(filePath, data, 0644); err != nil {
+		return "", fmt.Errorf("保存文件失败: %v", err)
+	}
+	return filePath, nil
+}
+
+// compressedFileExtension 返回压缩算法对应的惯用扩展名
+func compressedFileExtension(codec string) string {
+	switch codec {
+	case base64domain.CodecGzip:
+		return ".gz"
+	case base64domain.CodecZlib:
+		return ".zz"
+	case base64domain.CodecBrotli:
+		return ".br"
+	case base64domain.CodecZstd:
+		return ".zst"
+	default:
+		return ".deflate"
+	}
+}
+
+// readLimitedFile 读取文件内容，校验路径有效且大小不超过上限
+func readLimitedFile(filePath string, maxSize int64) ([]byte, error) {
+	if filePath == "" {
+		return nil, fmt.Errorf("文件路径不能为空")
+	}
+
+	fileInfo, err := os.Stat(filePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("文件不存在: %s", filePath)
+		}
+		return nil, fmt.Errorf("无法访问文件: %v", err)
+	}
+	if fileInfo.IsDir() {
+		return nil, fmt.Errorf("路径指向的是目录，不是文件: %s", filePath)
+	}
+	if fileInfo.Size() > maxSize {
+		return nil, fmt.Errorf("文件过大: %s 超过 %d MB 限制", filepath.Base(filePath), maxSize/1024/1024)
+	}
+
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("无法读取文件内容: %v", err)
+	}
+	return data, nil
+}
+
 // ListHistory 获取历史记录
 func (h *Base64Handler) ListHistory() ([]historydomain.ToolHistoryRecord, error) {
 	return h.api.ListHistory()
@@ -0,0 +1,51 @@
+package handlers
+
+import (
+	cronapp "github.com/cyrnicolase/dev-tools/internal/cron/application"
+	crondomain "github.com/cyrnicolase/dev-tools/internal/cron/domain"
+	"github.com/cyrnicolase/dev-tools/internal/cron/interfaces"
+	historydomain "github.com/cyrnicolase/dev-tools/internal/history/domain"
+)
+
+// CronHandler cron 表达式工具处理器
+type CronHandler struct {
+	api *interfaces.API
+}
+
+// NewCronHandler 创建新的 CronHandler 实例
+func NewCronHandler() *CronHandler {
+	return &CronHandler{
+		api: interfaces.NewAPI(),
+	}
+}
+
+// Explain 解析表达式并生成中英文说明，dialect 为 auto、standard、seconds 或 quartz
+func (h *CronHandler) Explain(expression, dialect string) (*crondomain.Explanation, error) {
+	return h.api.Explain(expression, dialect)
+}
+
+// NextRuns 计算 from 之后 count 次触发时间（from 为空时从当前时间开始）
+// 时间按 timezone 计算并按 format 输出，夏令时导致跳过或重复的触发会被标记
+func (h *CronHandler) NextRuns(expression, dialect, from string, count int, format, timezone string) (*cronapp.NextRunsResult, error) {
+	return h.api.NextRuns(expression, dialect, from, count, format, timezone)
+}
+
+// Build 根据表单选项构建表达式
+func (h *CronHandler) Build(opts crondomain.BuildOptions) (*cronapp.BuildResult, error) {
+	return h.api.Build(opts)
+}
+
+// ListHistory 获取历史记录
+func (h *CronHandler) ListHistory() ([]historydomain.ToolHistoryRecord, error) {
+	return h.api.ListHistory()
+}
+
+// AddHistory 添加历史记录
+func (h *CronHandler) AddHistory(record historydomain.ToolHistoryRecord) ([]historydomain.ToolHistoryRecord, error) {
+	return h.api.AddHistory(record)
+}
+
+// ClearHistory 清空历史记录
+func (h *CronHandler) ClearHistory() error {
+	return h.api.ClearHistory()
+}
@@ -5,10 +5,14 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
+	"strings"
 
 	historydomain "github.com/cyrnicolase/dev-tools/internal/history/domain"
 	"github.com/wailsapp/wails/v2/pkg/runtime"
 
+	hashapp "github.com/cyrnicolase/dev-tools/internal/hash/application"
+	hashdomain "github.com/cyrnicolase/dev-tools/internal/hash/domain"
 	hashapi "github.com/cyrnicolase/dev-tools/internal/hash/interfaces"
 )
 
@@ -92,6 +96,143 @@ func (h *HashHandler) OpenFileDialog() (string, error) {
 	return filePath, nil
 }
 
+// OpenDirectoryDialog 打开目录选择对话框
+func (h *HashHandler) OpenDirectoryDialog() (string, error) {
+	if h.ctx == nil {
+		return "", fmt.Errorf("上下文未初始化")
+	}
+
+	dirPath, err := runtime.OpenDirectoryDialog(h.ctx, runtime.OpenDialogOptions{
+		Title: "选择目录",
+	})
+	if err != nil {
+		return "", err
+	}
+	return dirPath, nil
+}
+
+// HashDirectory 计算目录下所有文件的散列值并生成清单
+// format 为 "gnu" 或 "bsd"，include/exclude 为 glob 规则，concurrency 为 0 时按 CPU 核数并发
+func (h *HashHandler) HashDirectory(dirPath, algorithm, format string, include, exclude []string, concurrency int) (*hashapp.DirectoryHashResult, error) {
+	if err := checkDirectory(dirPath); err != nil {
+		return nil, err
+	}
+	return h.api.HashDirectory(dirPath, format, hashdomain.DirectoryHashOptions{
+		Algorithm:   algorithm,
+		Include:     include,
+		Exclude:     exclude,
+		Concurrency: concurrency,
+	})
+}
+
+// VerifyManifest 使用清单文本校验目录，报告缺失、修改和多余的文件
+func (h *HashHandler) VerifyManifest(dirPath, content string, include, exclude []string) (*hashdomain.VerifyReport, error) {
+	if err := checkDirectory(dirPath); err != nil {
+		return nil, err
+	}
+	return h.api.VerifyManifest(dirPath, content, "", hashdomain.DirectoryHashOptions{
+		Include: include,
+		Exclude: exclude,
+	})
+}
+
+// VerifyManifestFile 读取清单文件并校验目录
+func (h *HashHandler) VerifyManifestFile(dirPath, manifestPath string, include, exclude []string) (*hashdomain.VerifyReport, error) {
+	if manifestPath == "" {
+		return nil, fmt.Errorf("清单文件路径不能为空")
+	}
+	content, err := os.ReadFile(manifestPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("文件不存在: %s", manifestPath)
+		}
+		return nil, fmt.Errorf("无法读取文件内容: %v", err)
+	}
+	if err := checkDirectory(dirPath); err != nil {
+		return nil, err
+	}
+	return h.api.VerifyManifest(dirPath, string(content), "", hashdomain.DirectoryHashOptions{
+		Include:      include,
+		Exclude:      exclude,
+		ManifestPath: manifestRelPath(dirPath, manifestPath),
+	})
+}
+
+// manifestRelPath 返回清单文件相对于校验目录的路径；清单不在目录内时返回空字符串
+func manifestRelPath(dirPath, manifestPath string) string {
+	absDir, err := filepath.Abs(dirPath)
+	if err != nil {
+		return ""
+	}
+	absManifest, err := filepath.Abs(manifestPath)
+	if err != nil {
+		return ""
+	}
+	rel, err := filepath.Rel(absDir, absManifest)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return ""
+	}
+	return filepath.ToSlash(rel)
+}
+
+// SaveManifestDialog 打开保存文件对话框并保存清单内容
+func (h *HashHandler) SaveManifestDialog(content, algorithm string) error {
+	if h.ctx == nil {
+		return fmt.Errorf("上下文未初始化")
+	}
+
+	filePath, err := runtime.SaveFileDialog(h.ctx, runtime.SaveDialogOptions{
+		Title:           "保存校验清单",
+		DefaultFilename: hashdomain.ManifestFileName(algorithm),
+	})
+	if err != nil {
+		return fmt.Errorf("打开保存对话框失败: %v", err)
+	}
+
+	// 如果用户取消保存，返回空（不是错误）
+	if filePath == "" {
+		return nil
+	}
+
+	if err := os.WriteFile(filePath, []byte(content), 0644); err != nil {
+		return fmt.Errorf("保存文件失败: %v", err)
+	}
+	return nil
+}
+
+// checkDirectory 校验路径存在且为目录
+func checkDirectory(dirPath string) error {
+	if dirPath == "" {
+		return fmt.Errorf("目录路径不能为空")
+	}
+	info, err := os.Stat(dirPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return fmt.Errorf("目录不存在: %s", dirPath)
+		}
+		return fmt.Errorf("无法访问目录: %v", err)
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("路径指向的是文件，不是目录: %s", dirPath)
+	}
+	return nil
+}
+
+// HashPassword 生成密码散列（bcrypt、scrypt、Argon2id、PBKDF2）
+func (h *HashHandler) HashPassword(password string, opts hashdomain.PasswordHashOptions) (string, error) {
+	return h.api.HashPassword(password, opts)
+}
+
+// VerifyPassword 校验明文密码与已有散列是否匹配
+func (h *HashHandler) VerifyPassword(password, encoded string) (bool, error) {
+	return h.api.VerifyPassword(password, encoded)
+}
+
+// InspectPasswordHash 解析密码散列中的算法参数
+func (h *HashHandler) InspectPasswordHash(encoded string) (*hashdomain.PasswordHashInfo, error) {
+	return h.api.InspectPasswordHash(encoded)
+}
+
 // ListHistory 获取历史记录
 func (h *HashHandler) ListHistory() ([]historydomain.ToolHistoryRecord, error) {
 	return h.api.ListHistory()
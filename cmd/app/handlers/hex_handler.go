@@ -0,0 +1,107 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/wailsapp/wails/v2/pkg/runtime"
+
+	hexdomain "github.com/cyrnicolase/dev-tools/internal/hex/domain"
+	"github.com/cyrnicolase/dev-tools/internal/hex/interfaces"
+	historydomain "github.com/cyrnicolase/dev-tools/internal/history/domain"
+)
+
+// HexHandler 十六进制工具处理器
+type HexHandler struct {
+	api *interfaces.API
+	ctx context.Context
+}
+
+const (
+	// maxHexFileSize 文件整体转换为十六进制字符串时的最大文件大小，浏览转储不受此限制
+	maxHexFileSize = 10 * 1024 * 1024
+)
+
+// NewHexHandler 创建新的 HexHandler 实例
+func NewHexHandler() *HexHandler {
+	return &HexHandler{
+		api: interfaces.NewAPI(),
+	}
+}
+
+// SetContext 设置上下文（用于文件对话框）
+func (h *HexHandler) SetContext(ctx context.Context) {
+	h.ctx = ctx
+}
+
+// TextToHex 将文本转换为十六进制字符串
+func (h *HexHandler) TextToHex(text, style string, uppercase bool) (string, error) {
+	return h.api.TextToHex(text, style, uppercase)
+}
+
+// HexToText 将十六进制字符串转换为文本
+func (h *HexHandler) HexToText(input string) (*hexdomain.TextResult, error) {
+	return h.api.HexToText(input)
+}
+
+// Reformat 转换十六进制字符串格式（如连续十六进制转 C 数组）
+func (h *HexHandler) Reformat(input, style string, uppercase bool) (string, error) {
+	return h.api.Reformat(input, style, uppercase)
+}
+
+// DumpText 将文本渲染为 xxd 风格转储
+func (h *HexHandler) DumpText(text string, opts hexdomain.DumpOptions) string {
+	return h.api.DumpText(text, opts)
+}
+
+// DumpHex 将十六进制字符串渲染为 xxd 风格转储
+func (h *HexHandler) DumpHex(input string, opts hexdomain.DumpOptions) (string, error) {
+	return h.api.DumpHex(input, opts)
+}
+
+// OpenFileDialog 打开文件选择对话框
+func (h *HexHandler) OpenFileDialog() (string, error) {
+	if h.ctx == nil {
+		return "", fmt.Errorf("上下文未初始化")
+	}
+
+	filePath, err := runtime.OpenFileDialog(h.ctx, runtime.OpenDialogOptions{
+		Title: "选择文件",
+	})
+	if err != nil {
+		return "", err
+	}
+	return filePath, nil
+}
+
+// DumpFilePage 分页浏览文件的 xxd 风格转储，offset 为起始字节，pageSize 为每页字节数
+func (h *HexHandler) DumpFilePage(filePath string, offset int64, pageSize int, opts hexdomain.DumpOptions) (*hexdomain.DumpPage, error) {
+	if filePath == "" {
+		return nil, fmt.Errorf("文件路径不能为空")
+	}
+	return h.api.DumpFilePage(filePath, offset, pageSize, opts)
+}
+
+// FileToHex 读取文件并转换为指定格式的十六进制字符串
+func (h *HexHandler) FileToHex(filePath, style string, uppercase bool) (string, error) {
+	data, err := readLimitedFile(filePath, maxHexFileSize)
+	if err != nil {
+		return "", err
+	}
+	return h.api.BytesToHex(data, style, uppercase)
+}
+
+// ListHistory 获取历史记录
+func (h *HexHandler) ListHistory() ([]historydomain.ToolHistoryRecord, error) {
+	return h.api.ListHistory()
+}
+
+// AddHistory 添加历史记录
+func (h *HexHandler) AddHistory(record historydomain.ToolHistoryRecord) ([]historydomain.ToolHistoryRecord, error) {
+	return h.api.AddHistory(record)
+}
+
+// ClearHistory 清空历史记录
+func (h *HexHandler) ClearHistory() error {
+	return h.api.ClearHistory()
+}
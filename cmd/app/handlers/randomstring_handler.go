@@ -2,6 +2,7 @@ package handlers
 
 import (
 	historydomain "github.com/cyrnicolase/dev-tools/internal/history/domain"
+	randomstringdomain "github.com/cyrnicolase/dev-tools/internal/randomstring/domain"
 	randomstringapi "github.com/cyrnicolase/dev-tools/internal/randomstring/interfaces"
 )
 
@@ -27,6 +28,22 @@ func (h *RandomStringHandler) GenerateBatch(length int, includeNumbers, includeL
 	return h.api.GenerateBatch(length, includeNumbers, includeLowercase, includeUppercase, includeSpecial, count)
 }
 
+// GeneratePassword 按策略生成密码：每种选中的字符类型至少出现指定次数，可排除易混淆字符、使用自定义字符集
+func (h *RandomStringHandler) GeneratePassword(policy randomstringdomain.PasswordPolicy) (*randomstringdomain.PasswordResult, error) {
+	return h.api.GeneratePassword(policy)
+}
+
+// GeneratePassphrase 从内嵌的 EFF 词表生成 diceware 口令
+func (h *RandomStringHandler) GeneratePassphrase(opts randomstringdomain.PassphraseOptions) (*randomstringdomain.PasswordResult, error) {
+	return h.api.GeneratePassphrase(opts)
+}
+
+// AnalyzeStrength 分析密码强度：识别常用密码、单词、键盘路径、日期、重复、序列与 l33t 替换，
+// 估算多种攻击场景下的破解时间并给出改进建议；userInputs 为用户名、邮箱等个人信息，可为空
+func (h *RandomStringHandler) AnalyzeStrength(password string, userInputs []string) (*randomstringdomain.StrengthResult, error) {
+	return h.api.AnalyzeStrength(password, userInputs)
+}
+
 // ListHistory 获取历史记录
 func (h *RandomStringHandler) ListHistory() ([]historydomain.ToolHistoryRecord, error) {
 	return h.api.ListHistory()
@@ -52,6 +52,97 @@ func (h *TimestampHandler) GetCurrentTimestampMilli() int64 {
 	return h.api.GetCurrentTimestampMilli()
 }
 
+// ConvertTimestamp 时间戳转时间字符串，unit 为 auto 时按数值大小识别秒/毫秒/微秒/纳秒
+// 时间戳以字符串传入与返回，避免纳秒时间戳在 JavaScript 中丢失精度
+func (h *TimestampHandler) ConvertTimestamp(input, unit, format, timezone string) (*timestampdomain.TimestampConversion, error) {
+	return h.api.ConvertTimestamp(input, unit, format, timezone)
+}
+
+// TimeStringToTimestamps 时间字符串转秒、毫秒、微秒、纳秒四种时间戳
+func (h *TimestampHandler) TimeStringToTimestamps(timeStr, format, timezone string) (*timestampdomain.TimestampConversion, error) {
+	return h.api.TimeStringToTimestamps(timeStr, format, timezone)
+}
+
+// SmartParse 自动识别时间格式（ISO 8601、RFC 2822、日志格式、中文日期等）
+// 或相对时间表达式（now-15m、yesterday 09:00、next monday），并返回匹配到的格式
+func (h *TimestampHandler) SmartParse(input, format, timezone string) (*timestampdomain.SmartParseResult, error) {
+	return h.api.SmartParse(input, format, timezone)
+}
+
+// WorldClock 将同一时刻展示在多个时区，包含 UTC 偏移、夏令时标记与时区缩写
+// input 为空时使用当前时间；不含时区信息时按第一个时区解释
+func (h *TimestampHandler) WorldClock(input, format string, zones []string) ([]timestampdomain.ZoneTime, error) {
+	return h.api.WorldClock(input, format, zones)
+}
+
+// MeetingPlanner 以第一个时区的日期（2006-01-02）为基准，按小时列出各时区本地时间
+// 并标记工作时间（workStart-workEnd 点，周末除外）的重叠时段
+func (h *TimestampHandler) MeetingPlanner(date string, zones []string, workStart, workEnd int) (*timestampdomain.MeetingPlan, error) {
+	return h.api.MeetingPlanner(date, zones, workStart, workEnd)
+}
+
+// SuggestTimezones 返回与输入相近的时区名称
+func (h *TimestampHandler) SuggestTimezones(name string) []string {
+	return h.api.SuggestTimezones(name)
+}
+
+// ListTimezones 返回所有已知的 IANA 时区名称
+func (h *TimestampHandler) ListTimezones() []string {
+	return h.api.ListTimezones()
+}
+
+// AddPeriod 在所选时区内对时间加减时长，支持 +3d4h、+1 month、P1Y2M3DT4H
+// 年月按日历计算（1 月 31 日加 1 个月为 2 月末），input 为空时使用当前时间
+func (h *TimestampHandler) AddPeriod(input, expr, format, timezone string) (*timestampdomain.ArithmeticResult, error) {
+	return h.api.AddPeriod(input, expr, format, timezone)
+}
+
+// Difference 计算两个时间的差值，返回日历差值与按天、小时、分钟、秒等单位的总量
+func (h *TimestampHandler) Difference(from, to, format, timezone string) (*timestampdomain.TimeDifference, error) {
+	return h.api.Difference(from, to, format, timezone)
+}
+
+// BusinessDays 统计两个日期之间（含首尾）的工作日数，排除周末与节假日（2006-01-02）
+func (h *TimestampHandler) BusinessDays(from, to, timezone string, holidays []string) (*timestampdomain.BusinessDayResult, error) {
+	return h.api.BusinessDays(from, to, timezone, holidays)
+}
+
+// AddBusinessDays 对时间加减工作日，跳过周末与节假日
+func (h *TimestampHandler) AddBusinessDays(input string, days int, format, timezone string, holidays []string) (*timestampdomain.ArithmeticResult, error) {
+	return h.api.AddBusinessDays(input, days, format, timezone, holidays)
+}
+
+// ParseDuration 解析 Go time.Duration 字符串（1h30m、250ms）并给出可读形式
+func (h *TimestampHandler) ParseDuration(input string) (*timestampdomain.DurationInfo, error) {
+	return h.api.ParseDuration(input)
+}
+
+// TranslateLayout 在 Go 布局、strftime（%Y-%m-%d）、Java（yyyy-MM-dd）与 moment.js（YYYY-MM-DD）格式之间转换
+// syntax 为空或 auto 时自动识别
+func (h *TimestampHandler) TranslateLayout(pattern, syntax, timezone string) (*timestampdomain.LayoutTranslation, error) {
+	return h.api.TranslateLayout(pattern, syntax, timezone)
+}
+
+// ListFormats 获取内置格式与已保存的自定义格式
+func (h *TimestampHandler) ListFormats() []timestampdomain.FormatInfo {
+	return h.api.ListFormats()
+}
+
+// SaveFormat 保存命名格式，保存后可在所有格式下拉框中按名称使用
+func (h *TimestampHandler) SaveFormat(name, pattern, syntax string) ([]timestampdomain.FormatInfo, error) {
+	return h.api.SaveFormat(name, pattern, syntax)
+}
+
+// DeleteFormat 删除命名格式
+func (h *TimestampHandler) DeleteFormat(name string) ([]timestampdomain.FormatInfo, error) {
+	return h.api.DeleteFormat(name)
+}
+
+// BatchConvert 批量转换日志、CSV 等文本中的时间戳（to-time）或时间字符串（to-epoch），原位替换并返回逐行对照
+func (h *TimestampHandler) BatchConvert(text string, opts timestampdomain.BatchOptions) (*timestampdomain.BatchResult, error) {
+	return h.api.BatchConvert(text, opts)
+}
+
 // ListTimestampHistory 获取历史记录
 func (h *TimestampHandler) ListTimestampHistory() ([]timestampdomain.HistoryRecord, error) {
 	return h.api.ListTimestampHistory()
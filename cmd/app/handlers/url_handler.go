@@ -2,6 +2,8 @@ package handlers
 
 import (
 	historydomain "github.com/cyrnicolase/dev-tools/internal/history/domain"
+	urlapp "github.com/cyrnicolase/dev-tools/internal/url/application"
+	urldomain "github.com/cyrnicolase/dev-tools/internal/url/domain"
 	"github.com/cyrnicolase/dev-tools/internal/url/interfaces"
 )
 
@@ -27,6 +29,76 @@ func (h *URLHandler) Decode(input string) (string, error) {
 	return h.api.Decode(input)
 }
 
+// ListModes 返回所有支持的编码模式
+func (h *URLHandler) ListModes() []urldomain.ModeInfo {
+	return h.api.ListModes()
+}
+
+// EncodeMode 使用指定模式编码（查询参数、路径段、完整 URL、RFC 3986、表单）
+func (h *URLHandler) EncodeMode(mode, input string) (string, error) {
+	return h.api.EncodeMode(mode, input)
+}
+
+// DecodeMode 使用指定模式解码
+func (h *URLHandler) DecodeMode(mode, input string) (string, error) {
+	return h.api.DecodeMode(mode, input)
+}
+
+// DecodeRecursive 递归解码多次编码的字符串，返回每一层的结果
+func (h *URLHandler) DecodeRecursive(mode, input string) (*urldomain.RecursiveDecodeResult, error) {
+	return h.api.DecodeRecursive(mode, input)
+}
+
+// Parse 将 URL 拆分为协议、用户信息、主机、端口、路径段、查询参数与片段
+func (h *URLHandler) Parse(rawURL string) (*urldomain.URLComponents, error) {
+	return h.api.Parse(rawURL)
+}
+
+// Build 将编辑后的各组成部分重新组装为 URL
+func (h *URLHandler) Build(components *urldomain.URLComponents) (string, error) {
+	return h.api.Build(components)
+}
+
+// ListConventions 返回所有支持的嵌套键约定（扁平、PHP/Rails、qs、Spring）
+func (h *URLHandler) ListConventions() []urldomain.ConventionInfo {
+	return h.api.ListConventions()
+}
+
+// QueryToJSON 将查询字符串或表单请求体转换为 JSON
+func (h *URLHandler) QueryToJSON(input, convention string) (string, error) {
+	return h.api.QueryToJSON(input, convention)
+}
+
+// JSONToQuery 将 JSON 对象转换为查询字符串
+func (h *URLHandler) JSONToQuery(input, convention string) (string, error) {
+	return h.api.JSONToQuery(input, convention)
+}
+
+// ConvertIDN 将主机名转换为 Punycode 与 Unicode 两种形式，并分析同形异义风险
+func (h *URLHandler) ConvertIDN(input string) (*urldomain.IDNResult, error) {
+	return h.api.ConvertIDN(input)
+}
+
+// AnalyzeScripts 分析字符串中的文字系统混用与易混淆字符
+func (h *URLHandler) AnalyzeScripts(input string) *urldomain.ScriptReport {
+	return h.api.AnalyzeScripts(input)
+}
+
+// Normalize 使用 NFC/NFD/NFKC/NFKD 规范化字符串，并返回码点明细
+func (h *URLHandler) Normalize(input, form string) (*urldomain.NormalizationResult, error) {
+	return h.api.Normalize(input, form)
+}
+
+// ParseCurl 解析 curl 命令为结构化请求（方法、URL、请求头、请求体、认证、Cookie）
+func (h *URLHandler) ParseCurl(command string) (*urlapp.CurlParseResult, error) {
+	return h.api.ParseCurl(command)
+}
+
+// ConvertCurl 将 curl 命令转换为 Go net/http、Python requests、fetch 或 HTTPie
+func (h *URLHandler) ConvertCurl(command, target string) (string, error) {
+	return h.api.ConvertCurl(command, target)
+}
+
 // ListHistory 获取历史记录
 func (h *URLHandler) ListHistory() ([]historydomain.ToolHistoryRecord, error) {
 	return h.api.ListHistory()
@@ -2,6 +2,8 @@ package handlers
 
 import (
 	historydomain "github.com/cyrnicolase/dev-tools/internal/history/domain"
+	uuidapp "github.com/cyrnicolase/dev-tools/internal/uuid/application"
+	uuiddomain "github.com/cyrnicolase/dev-tools/internal/uuid/domain"
 	uuidapi "github.com/cyrnicolase/dev-tools/internal/uuid/interfaces"
 )
 
@@ -47,6 +49,61 @@ func (h *UUIDHandler) GenerateBatch(version string, count int, namespace, name s
 	return h.api.GenerateBatch(version, count, namespace, name)
 }
 
+// GenerateV6 生成 UUID v6
+func (h *UUIDHandler) GenerateV6() (string, error) {
+	return h.api.GenerateV6()
+}
+
+// GenerateV8 生成 UUID v8，payload 为空时随机，为 32 位十六进制时按原样使用，其他文本取 SHA-256 摘要
+func (h *UUIDHandler) GenerateV8(payload string) (string, error) {
+	return h.api.GenerateV8(payload)
+}
+
+// GenerateULID 生成 ULID
+func (h *UUIDHandler) GenerateULID() (string, error) {
+	return h.api.GenerateULID()
+}
+
+// GenerateNanoID 生成 NanoID，alphabet 为空时使用 URL 安全字母表，length 小于等于 0 时为 21
+func (h *UUIDHandler) GenerateNanoID(alphabet string, length int) (string, error) {
+	return h.api.GenerateNanoID(alphabet, length)
+}
+
+// GenerateWithOptions 批量生成 UUID（v1-v8、nil、max）、ULID 或 NanoID
+// 输出支持大写、去连字符、花括号、URN、引号，以及每行一个、JSON 数组或 SQL IN (...) 列表
+func (h *UUIDHandler) GenerateWithOptions(opts uuiddomain.GenerateOptions, format uuiddomain.OutputFormat) (*uuidapp.GenerateResult, error) {
+	return h.api.GenerateWithOptions(opts, format)
+}
+
+// ListNamespaces 获取预定义的命名空间（DNS、URL、OID、X500）
+func (h *UUIDHandler) ListNamespaces() []uuiddomain.Namespace {
+	return h.api.ListNamespaces()
+}
+
+// HashNames 为每行一个的名称生成确定的 v3/v5 UUID，namespace 可以是 dns、url、oid、x500 或 UUID
+// exportFormat 为 csv 或 json
+func (h *UUIDHandler) HashNames(version, namespace, names, exportFormat string) (*uuidapp.HashNamesResult, error) {
+	return h.api.HashNames(version, namespace, names, exportFormat)
+}
+
+// InspectID 解析 Snowflake（可配置纪元与位布局）、MongoDB ObjectID、ULID、KSUID 与 UUID v1/v6/v7
+// 返回嵌入时间（按 format 与 timezone 格式化）以及 worker、序列号、随机数等组成部分
+func (h *UUIDHandler) InspectID(input string, opts uuiddomain.InspectOptions, format, timezone string) (*uuidapp.IDInspection, error) {
+	return h.api.InspectID(input, opts, format, timezone)
+}
+
+// ParseUUID 规范化（花括号、URN、大小写、无连字符、Base64、字节数组）并校验 UUID
+// 返回版本、变体、v1/v6/v7 的时间戳、v1/v6 的时钟序列与节点，以及标准、十六进制、Base64、字节数组与 GUID 字节序等写法
+// byteOrder 为 guid 时按 Microsoft 混合字节序解释 Base64 与字节数组输入
+func (h *UUIDHandler) ParseUUID(input, byteOrder, format, timezone string) (*uuidapp.UUIDParseResult, error) {
+	return h.api.ParseUUID(input, byteOrder, format, timezone)
+}
+
+// ListSnowflakePresets 获取预设的 Snowflake 布局（Twitter、Discord、Instagram、Sonyflake）
+func (h *UUIDHandler) ListSnowflakePresets() []uuiddomain.SnowflakeLayout {
+	return h.api.ListSnowflakePresets()
+}
+
 // ListHistory 获取历史记录
 func (h *UUIDHandler) ListHistory() ([]historydomain.ToolHistoryRecord, error) {
 	return h.api.ListHistory()
@@ -37,6 +37,8 @@ func NewToolManager() *ToolManager {
 			"translate",
 			"hash",
 			"randomstring",
+			"hex",
+			"cron",
 		},
 		currentToolIndex: 0,
 	}
@@ -20,6 +20,9 @@ function renderMap(mapValue, toolId, section) {
   if (toolId === 'randomstring' && section === 'output') {
     return Object.values(mapValue).join('\n')
   }
+  if (toolId === 'hex' && section === 'output') {
+    return Object.values(mapValue).join('\n')
+  }
   if (toolId === 'uuid' && section === 'output') {
     return Object.values(mapValue).join('\n')
   }
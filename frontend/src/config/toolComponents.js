@@ -11,6 +11,8 @@ import IPQueryTool from '../tools/ipquery/IPQueryTool'
 import TranslateTool from '../tools/translate/TranslateTool'
 import HashTool from '../tools/hash/HashTool'
 import RandomStringTool from '../tools/randomstring/RandomStringTool'
+import HexTool from '../tools/hex/HexTool'
+import CronTool from '../tools/cron/CronTool'
 import HelpTool from '../menus/help/HelpTool'
 
 /**
@@ -59,6 +61,14 @@ export const TOOL_COMPONENTS = {
     component: RandomStringTool,
     className: 'flex-1 min-h-0 flex flex-col',
   },
+  hex: {
+    component: HexTool,
+    className: 'flex-1 min-h-0 flex flex-col',
+  },
+  cron: {
+    component: CronTool,
+    className: 'flex-1 min-h-0 flex flex-col',
+  },
   help: {
     component: HelpTool,
     className: '',
@@ -3,7 +3,7 @@
  */
 
 // 工具 ID 列表
-export const TOOL_IDS = ['json', 'base64', 'timestamp', 'uuid', 'url', 'qrcode', 'ipquery', 'translate', 'hash', 'randomstring']
+export const TOOL_IDS = ['json', 'base64', 'timestamp', 'uuid', 'url', 'qrcode', 'ipquery', 'translate', 'hash', 'randomstring', 'hex', 'cron']
 
 // 视图列表（包括工具和菜单视图）
 export const VIEW_IDS = [...TOOL_IDS, 'help']
@@ -20,6 +20,8 @@ export const TOOLS = [
   { id: 'translate', name: '翻译', icon: '🌐' },
   { id: 'hash', name: '散列值', icon: '🔑' },
   { id: 'randomstring', name: '随机字符串', icon: '🎲' },
+  { id: 'hex', name: '十六进制', icon: '🔢' },
+  { id: 'cron', name: 'Cron', icon: '🗓️' },
 ]
 
 // 默认工具 ID
@@ -52,6 +52,9 @@ function HelpTool({ scrollToToolId }) {
         '在输入框中输入要编码或解码的内容',
         '选择"编码"或"解码"模式',
         '可选择"URL 安全"选项（编码时）',
+        '「二进制解码」安全解码任意内容：文本直接输出，二进制数据显示类型、大小与十六进制预览，图片可直接预览',
+        '「解码到文件」通过保存对话框写入解码后的原始字节，默认扩展名根据识别出的类型选择；「从文件编码」读取本地文件并编码',
+        '勾选"宽松解码"可忽略空白并容忍缺失的填充',
         '点击"编码"或"解码"按钮执行操作',
         '使用"复制"按钮复制结果',
         '成功操作会记录到历史记录中，可查看最近50条'
@@ -67,7 +70,10 @@ function HelpTool({ scrollToToolId }) {
         '或选择日期时间转换为时间戳',
         '毫秒时间戳转换为时间时，DateTime 格式会自动带上毫秒位（如 2026-08-10 20:30:26.123）',
         '时间转时间戳同时输出秒级与毫秒级，均可复制',
-        '支持多种时间格式（默认 YYYY-MM-DD HH:mm:ss）',
+        '支持多种时间格式（默认 YYYY-MM-DD HH:mm:ss），格式下拉框包含全部内置格式与已保存的自定义格式',
+        '「自定义格式」可输入 strftime、Java、moment.js 或 Go 格式，预览翻译结果后按名称保存；选中自定义格式后可删除',
+        '「批量转换」可粘贴日志、CSV 等文本，将其中的时间戳与时间字符串原位互转，左右对照预览结果并列出逐行替换',
+        '「世界时钟与会议规划」可添加多个 IANA 时区，查看同一时刻在各时区的时间、UTC 偏移与夏令时；按日期生成逐小时对照表，高亮所有时区都在工作时间内的时段',
         '时间戳转时间自动识别单位，仅支持 10 位（秒）或 13 位（毫秒）',
         '点击「当前」可填入当前秒级时间戳',
         '转换历史会记录最近 50 条成功记录，重启应用后仍会保留并存储在本地数据库',
@@ -83,16 +89,22 @@ function HelpTool({ scrollToToolId }) {
       description: '生成 UUID（通用唯一标识符）',
       alfred: 'uuid',
       usage: [
-        '选择 UUID 版本（v1、v3、v4、v5、v7）',
+        '选择类型：UUID v1、v3、v4、v5、v6、v7、v8、Nil、Max，以及 ULID、NanoID',
         '设置生成数量（1-1000）',
         '配置格式选项：',
         '  - 大小写：小写（默认）或大写',
         '  - 连字符：带连字符（默认）或无连字符',
+        '  - 包裹：花括号或 URN；引号：单引号或双引号',
+        '  - 列表形式：每行一个、JSON 数组或 SQL IN (...) 列表',
+        'v8 可填写自定义内容；NanoID 可自定义字母表与长度',
         'v3 和 v5 版本需要提供 namespace 和 name',
-        '点击"生成 UUID"按钮生成',
+        '点击"生成"按钮生成',
         '使用"复制"按钮复制单个 UUID',
         '使用"复制全部"按钮复制所有 UUID',
-        '每次生成会记录历史，可查看最近50条生成记录'
+        '每次生成会记录历史，可查看最近50条生成记录',
+        '「按名称批量生成」每行一个名称，使用 DNS、URL、OID、X.500 或自定义命名空间生成确定的 v3/v5 UUID，可导出为 CSV 或 JSON',
+        '「UUID 解析」可规范化花括号、URN、无连字符、Base64、字节数组等写法，显示版本、变体、时间戳、时钟序列与节点，并给出各种写法（含 GUID 字节序）',
+        '「ID 解析」支持 Snowflake（Twitter、Discord、Instagram、Sonyflake 布局）、MongoDB ObjectID、ULID、KSUID 与 UUID v1/v6/v7，显示嵌入时间与各组成部分'
       ]
     },
     {
@@ -194,6 +206,36 @@ function HelpTool({ scrollToToolId }) {
         '使用"清空"按钮清空所有生成结果',
         '每次生成成功会记录历史，可查看最近50条'
       ]
+    },
+    {
+      icon: '🔢',
+      name: '十六进制工具',
+      description: '文本与十六进制互转、格式转换以及 xxd 风格转储',
+      alfred: 'hex',
+      usage: [
+        '选择功能：文本转十六进制、十六进制转文本、格式转换或 xxd 转储',
+        '输出格式支持连续十六进制、空格分隔、冒号分隔、0x 前缀、\\x 转义和 C 数组',
+        '十六进制输入可以是以上任意格式，会自动识别',
+        '文本转十六进制和 xxd 转储支持选择文件，大文件转储按 4096 字节分页浏览',
+        '勾选"大写"输出大写十六进制',
+        '使用"复制"按钮复制结果',
+        '每次转换成功会记录历史，可查看最近50条'
+      ]
+    },
+    {
+      icon: '🗓️',
+      name: 'Cron 表达式工具',
+      description: '解释 cron 表达式、预览触发时间并通过表单生成表达式',
+      alfred: 'cron',
+      usage: [
+        '输入 cron 表达式，支持标准 5 段、带秒 6 段、Quartz 以及 @daily 等别名',
+        '方言默认自动识别，也可以手动指定',
+        '选择或输入时区，点击"解析"或按回车查看中英文说明和接下来的触发时间',
+        '落在夏令时空档或重复时段的触发会带有标记和说明',
+        '点击"生成器"按频率、时间和星期/日期生成表达式',
+        '使用"复制"按钮复制表达式',
+        '每次解析成功会记录历史，可查看最近50条'
+      ]
     }
   ]
 
@@ -11,9 +11,12 @@ function Base64Tool({ onShowHelp, isActive = true }) {
   const [input, setInput] = useState('')
   const [output, setOutput] = useState('')
   const [urlSafe, setUrlSafe] = useState(false)
+  const [lenient, setLenient] = useState(false)
+  const [payload, setPayload] = useState(null)
   const [api, setApi] = useState(null)
   const [error, setError] = useState('')
   const [showToast, setShowToast] = useState(false)
+  const [toastMessage, setToastMessage] = useState('已复制到剪贴板')
   const [historyRecords, setHistoryRecords] = useState([])
   const [isHistoryPanelOpen, setIsHistoryPanelOpen] = useState(false)
   const textareaRef = useRef(null)
@@ -86,6 +89,7 @@ function Base64Tool({ onShowHelp, isActive = true }) {
       }
       if (result) {
         setOutput(result)
+        setPayload(null)
         const { success, items } = await addBase64HistoryItem({
           id: createHistoryId(),
           action: urlSafe ? 'URL安全编码' : '标准编码',
@@ -122,6 +126,7 @@ function Base64Tool({ onShowHelp, isActive = true }) {
       }
       if (result) {
         setOutput(result)
+        setPayload(null)
         const { success, items } = await addBase64HistoryItem({
           id: createHistoryId(),
           action: urlSafe ? 'URL安全解码' : '标准解码',
@@ -165,9 +170,80 @@ function Base64Tool({ onShowHelp, isActive = true }) {
     }
   }
 
+  // 二进制解码：文本直接输出，非文本展示十六进制预览，图片额外提供预览
+  const handleDecodeBinary = async () => {
+    try {
+      setError('')
+      const wailsAPI = api || getWailsAPI()
+      if (!wailsAPI?.Base64) {
+        setError('后端 API 未加载，请稍候重试')
+        return
+      }
+      const result = await wailsAPI.Base64.DecodeBinary(urlSafe ? 'url' : 'std', input, lenient)
+      setPayload(result)
+      setOutput(result?.isText ? result.text : '')
+      const { success, items } = await addBase64HistoryItem({
+        id: createHistoryId(),
+        action: '二进制解码',
+        createdAt: Date.now(),
+        input: {
+          text: truncateText(input),
+        },
+        output: {
+          result: result?.isText ? truncateText(result.text) : `${result?.mimeType}，${result?.size} 字节`,
+        },
+      })
+      if (success) {
+        setHistoryRecords(items)
+      }
+    } catch (err) {
+      setPayload(null)
+      setError(err.message || '解码失败')
+    }
+  }
+
+  const handleDecodeToFile = async () => {
+    try {
+      setError('')
+      const wailsAPI = api || getWailsAPI()
+      if (!wailsAPI?.Base64) {
+        setError('后端 API 未加载，请稍候重试')
+        return
+      }
+      const filePath = await wailsAPI.Base64.DecodeToFileDialog(urlSafe ? 'url' : 'std', input, lenient)
+      if (filePath) {
+        setToastMessage(`已保存到 ${filePath}`)
+        setShowToast(true)
+      }
+    } catch (err) {
+      setError(err.message || '保存文件失败')
+    }
+  }
+
+  const handleEncodeFile = async () => {
+    try {
+      setError('')
+      const wailsAPI = api || getWailsAPI()
+      if (!wailsAPI?.Base64) {
+        setError('后端 API 未加载，请稍候重试')
+        return
+      }
+      const filePath = await wailsAPI.Base64.OpenFileDialog()
+      if (!filePath) {
+        return
+      }
+      const result = await wailsAPI.Base64.EncodeFile(urlSafe ? 'url' : 'std', filePath)
+      setPayload(null)
+      setOutput(result)
+    } catch (err) {
+      setError(err.message || '文件编码失败')
+    }
+  }
+
   const handleCopy = async () => {
     try {
       await navigator.clipboard.writeText(output)
+      setToastMessage('已复制到剪贴板')
       setShowToast(true)
     } catch (err) {
       setError('复制失败')
@@ -176,6 +252,7 @@ function Base64Tool({ onShowHelp, isActive = true }) {
 
   const handleClear = () => {
     setOutput('')
+    setPayload(null)
     setError('')
   }
 
@@ -217,6 +294,15 @@ function Base64Tool({ onShowHelp, isActive = true }) {
                 />
                 <span className="text-sm text-[var(--text-primary)] select-none">URL 安全</span>
               </label>
+              <label className="flex items-center space-x-2 select-none" title="忽略空白并容忍缺失填充（二进制解码与解码到文件）">
+                <input
+                  type="checkbox"
+                  checked={lenient}
+                  onChange={(e) => setLenient(e.target.checked)}
+                  className="rounded"
+                />
+                <span className="text-sm text-[var(--text-primary)] select-none">宽松解码</span>
+              </label>
             </div>
             {/* 右侧：操作按钮 */}
             <div className="flex items-center space-x-2 border-l border-border-input pl-6">
@@ -241,6 +327,28 @@ function Base64Tool({ onShowHelp, isActive = true }) {
             </div>
           </div>
         </div>
+        <div className="flex items-center justify-end space-x-2 mb-4">
+          <button
+            onClick={handleEncodeFile}
+            className="px-3 py-2 bg-button-secondary text-button-secondary-text rounded-lg hover:bg-[var(--button-secondary-hover)] transition-colors text-sm select-none"
+          >
+            从文件编码
+          </button>
+          <button
+            onClick={handleDecodeBinary}
+            disabled={!input}
+            className="px-3 py-2 bg-button-secondary text-button-secondary-text rounded-lg hover:bg-[var(--button-secondary-hover)] transition-colors text-sm select-none disabled:opacity-50 disabled:cursor-not-allowed"
+          >
+            二进制解码
+          </button>
+          <button
+            onClick={handleDecodeToFile}
+            disabled={!input}
+            className="px-3 py-2 bg-button-secondary text-button-secondary-text rounded-lg hover:bg-[var(--button-secondary-hover)] transition-colors text-sm select-none disabled:opacity-50 disabled:cursor-not-allowed"
+          >
+            解码到文件
+          </button>
+        </div>
         <textarea
           ref={textareaRef}
           value={input}
@@ -282,6 +390,24 @@ function Base64Tool({ onShowHelp, isActive = true }) {
               </button>
             </div>
           </div>
+        {payload && (
+          <div className="mb-4 space-y-3">
+            <div className="text-sm text-[var(--text-secondary)] select-none">
+              类型：{payload.mimeType}（{payload.extension || '无扩展名'}） ｜ 大小：{payload.size} 字节
+              {payload.truncated ? ' ｜ 仅展示前部内容' : ''}
+            </div>
+            {payload.previewUrl && (
+              <div className="p-3 bg-input-disabled border border-border-input rounded-lg flex justify-center">
+                <img src={payload.previewUrl} alt="解码图片预览" className="max-h-64 max-w-full object-contain" />
+              </div>
+            )}
+            {payload.hexDump && (
+              <pre className="max-h-64 overflow-auto p-3 bg-input-disabled border border-border-input rounded-lg font-mono text-xs text-[var(--text-input)]">
+                {payload.hexDump}
+              </pre>
+            )}
+          </div>
+        )}
         <textarea
           value={output}
           readOnly
@@ -291,7 +417,7 @@ function Base64Tool({ onShowHelp, isActive = true }) {
         />
       </div>
       <Toast
-        message="已复制到剪贴板"
+        message={toastMessage}
         show={showToast}
         onClose={() => setShowToast(false)}
       />
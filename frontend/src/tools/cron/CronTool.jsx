@@ -0,0 +1,519 @@
+import React, { useState, useEffect, useRef } from 'react'
+import { getWailsAPI, waitForWailsAPI } from '../../utils/api'
+import Toast from '../../components/Toast'
+import ToolHeader from '../../components/ToolHeader'
+import Select from '../../components/Select'
+import ToolHistoryDrawer from '../../components/ToolHistoryDrawer'
+import { useAutoFocus } from '../../hooks/useAutoFocus'
+import { addCronHistoryItem, loadCronHistory, MAX_CRON_HISTORY_ITEMS } from './cronHistoryStorage'
+import { createHistoryId } from '../../utils/toolHistoryStorage'
+
+const WEEKDAY_LABELS = ['日', '一', '二', '三', '四', '五', '六']
+
+function CronTool({ onShowHelp, isActive }) {
+  const [expression, setExpression] = useState('')
+  const [dialect, setDialect] = useState('auto')
+  const [timezone, setTimezone] = useState('Asia/Shanghai')
+  const [count, setCount] = useState('10')
+  const [result, setResult] = useState(null)
+  const [showBuilder, setShowBuilder] = useState(false)
+  const [buildOptions, setBuildOptions] = useState({
+    dialect: 'standard',
+    frequency: 'day',
+    interval: 1,
+    second: 0,
+    minute: 0,
+    hour: 9,
+    weekdays: [1, 2, 3, 4, 5],
+    monthDays: [1],
+    lastDayOfMonth: false,
+    months: [],
+  })
+  const [monthDaysText, setMonthDaysText] = useState('1')
+  const [api, setApi] = useState(null)
+  const [error, setError] = useState('')
+  const [showToast, setShowToast] = useState(false)
+  const [loading, setLoading] = useState(false)
+  const [buttonDisabledFeedback, setButtonDisabledFeedback] = useState(false)
+  const [historyRecords, setHistoryRecords] = useState([])
+  const [isHistoryPanelOpen, setIsHistoryPanelOpen] = useState(false)
+  const inputRef = useRef(null)
+  const historyPanelRef = useRef(null)
+  const historyToggleButtonRef = useRef(null)
+
+  const dialects = [
+    { value: 'auto', label: '自动识别' },
+    { value: 'standard', label: '标准（5 段）' },
+    { value: 'seconds', label: '带秒（6 段）' },
+    { value: 'quartz', label: 'Quartz' },
+  ]
+
+  const buildDialects = dialects.filter((d) => d.value !== 'auto')
+
+  const frequencies = [
+    { value: 'second', label: '每秒' },
+    { value: 'minute', label: '每分钟' },
+    { value: 'hour', label: '每小时' },
+    { value: 'day', label: '每天' },
+    { value: 'week', label: '每周' },
+    { value: 'month', label: '每月' },
+    { value: 'year', label: '每年' },
+  ]
+
+  const timezones = [
+    'UTC',
+    'Asia/Shanghai',
+    'Asia/Tokyo',
+    'Europe/London',
+    'Europe/Berlin',
+    'America/New_York',
+    'America/Los_Angeles',
+    'Australia/Sydney',
+  ]
+
+  useEffect(() => {
+    waitForWailsAPI()
+      .then((wailsAPI) => {
+        if (wailsAPI?.Cron) {
+          setApi(wailsAPI)
+        }
+      })
+      .catch(() => {
+        setError('后端 API 初始化失败')
+      })
+  }, [])
+
+  useEffect(() => {
+    if (!isHistoryPanelOpen) {
+      return undefined
+    }
+    const handleOutsideClick = (event) => {
+      const panelNode = historyPanelRef.current
+      const toggleButtonNode = historyToggleButtonRef.current
+      const target = event.target
+      if (panelNode?.contains(target) || toggleButtonNode?.contains(target)) {
+        return
+      }
+      setIsHistoryPanelOpen(false)
+    }
+
+    document.addEventListener('mousedown', handleOutsideClick)
+    return () => {
+      document.removeEventListener('mousedown', handleOutsideClick)
+    }
+  }, [isHistoryPanelOpen])
+
+  useEffect(() => {
+    let cancelled = false
+    const fetchHistory = async () => {
+      const records = await loadCronHistory()
+      if (!cancelled) {
+        setHistoryRecords(records)
+      }
+    }
+    fetchHistory()
+    return () => {
+      cancelled = true
+    }
+  }, [])
+
+  // 当 isActive 变为 true 时，自动聚焦输入框
+  useAutoFocus(inputRef, isActive, true, { maxAttempts: 15 })
+
+  const resolveAPI = () => {
+    const wailsAPI = api || getWailsAPI()
+    if (!wailsAPI?.Cron) {
+      setError('后端 API 未加载，请稍候重试')
+      return null
+    }
+    return wailsAPI
+  }
+
+  const handleParse = async (value = expression) => {
+    // 检查按钮是否应该被禁用
+    if (loading || !value.trim()) {
+      // 给用户反馈
+      setButtonDisabledFeedback(true)
+      setTimeout(() => {
+        setButtonDisabledFeedback(false)
+      }, 300)
+      return
+    }
+
+    try {
+      setError('')
+      setLoading(true)
+      const wailsAPI = resolveAPI()
+      if (!wailsAPI) {
+        return
+      }
+      const runCount = parseInt(count, 10) || 10
+      const next = await wailsAPI.Cron.NextRuns(value.trim(), dialect, '', runCount, 'DateTime', timezone)
+      setResult(next)
+      const { success, items } = await addCronHistoryItem({
+        id: createHistoryId(),
+        action: '解析表达式',
+        createdAt: Date.now(),
+        input: {
+          expression: value.trim(),
+          dialect: next?.dialect || dialect,
+          timezone,
+        },
+        output: {
+          chinese: next?.explanation?.chinese || '',
+        },
+      })
+      if (success) {
+        setHistoryRecords(items)
+      }
+    } catch (err) {
+      setResult(null)
+      setError(err.message || '解析失败')
+    } finally {
+      setLoading(false)
+    }
+  }
+
+  const handleBuild = async () => {
+    try {
+      setError('')
+      const wailsAPI = resolveAPI()
+      if (!wailsAPI) {
+        return
+      }
+      const monthDays = monthDaysText
+        .split(/[,，\s]+/)
+        .filter(Boolean)
+        .map((v) => parseInt(v, 10))
+        .filter((v) => Number.isInteger(v))
+      const built = await wailsAPI.Cron.Build({ ...buildOptions, monthDays })
+      if (built?.expression) {
+        setExpression(built.expression)
+        setDialect(buildOptions.dialect)
+        await handleParse(built.expression)
+      }
+    } catch (err) {
+      setError(err.message || '生成失败')
+    }
+  }
+
+  const updateBuildOption = (key, value) => {
+    setBuildOptions((prev) => ({ ...prev, [key]: value }))
+  }
+
+  const toggleWeekday = (day) => {
+    setBuildOptions((prev) => ({
+      ...prev,
+      weekdays: prev.weekdays.includes(day)
+        ? prev.weekdays.filter((d) => d !== day)
+        : [...prev.weekdays, day].sort((a, b) => a - b),
+    }))
+  }
+
+  const handleCopy = async () => {
+    try {
+      await navigator.clipboard.writeText(result?.expression || expression)
+      setShowToast(true)
+    } catch (err) {
+      setError('复制失败')
+    }
+  }
+
+  const handleClear = () => {
+    setExpression('')
+    setResult(null)
+    setError('')
+  }
+
+  const numberInput = (key, min, max) => (
+    <input
+      type="number"
+      min={min}
+      max={max}
+      value={buildOptions[key]}
+      onChange={(e) => updateBuildOption(key, parseInt(e.target.value, 10) || 0)}
+      className="w-20 px-2 py-1 border border-border-input rounded-lg text-sm text-[var(--text-input)] bg-input focus:outline-none focus:ring-2 focus:ring-blue-500"
+    />
+  )
+
+  const explanation = result?.explanation
+
+  return (
+    <div className="h-full flex flex-col relative overflow-hidden">
+      <div className="relative">
+        <ToolHeader
+          title="Cron 表达式工具"
+          description="解释 cron 表达式、预览触发时间并通过表单生成表达式"
+          toolId="cron"
+          onShowHelp={onShowHelp}
+        />
+        <button
+          ref={historyToggleButtonRef}
+          onClick={() => setIsHistoryPanelOpen((prev) => !prev)}
+          className={`absolute top-1 right-0 px-3 py-2 text-sm rounded-lg transition-colors select-none ${
+            isHistoryPanelOpen
+              ? 'bg-blue-500 text-white hover:bg-blue-600'
+              : 'bg-button-secondary text-button-secondary-text hover:bg-[var(--button-secondary-hover)]'
+          }`}
+        >
+          历史记录
+        </button>
+      </div>
+      <div className="flex-1 min-h-0 overflow-y-auto space-y-4">
+        <div className="bg-secondary rounded-lg shadow-sm border border-border-primary p-6">
+          <div className="flex items-center justify-between mb-4">
+            <h3 className="text-lg font-semibold text-[var(--text-primary)] select-none">表达式</h3>
+            <div className="flex items-center space-x-4">
+              <div className="flex items-center space-x-2">
+                <span className="text-sm font-medium text-[var(--text-primary)] select-none">方言：</span>
+                <Select value={dialect} onChange={setDialect} options={dialects} className="w-36" />
+              </div>
+              <div className="flex items-center space-x-2 border-l border-border-input pl-4">
+                <span className="text-sm font-medium text-[var(--text-primary)] select-none">时区：</span>
+                <input
+                  type="text"
+                  list="cron-timezones"
+                  value={timezone}
+                  onChange={(e) => setTimezone(e.target.value)}
+                  className="w-44 px-3 py-2 border border-border-input rounded-lg text-sm text-[var(--text-input)] bg-input focus:outline-none focus:ring-2 focus:ring-blue-500"
+                  autoComplete="off"
+                  spellCheck="false"
+                />
+                <datalist id="cron-timezones">
+                  {timezones.map((tz) => <option key={tz} value={tz} />)}
+                </datalist>
+              </div>
+              <div className="flex items-center space-x-2">
+                <span className="text-sm font-medium text-[var(--text-primary)] select-none">次数：</span>
+                <input
+                  type="text"
+                  value={count}
+                  onChange={(e) => setCount(e.target.value.replace(/\D/g, ''))}
+                  className="w-16 px-3 py-2 border border-border-input rounded-lg text-sm text-[var(--text-input)] bg-input focus:outline-none focus:ring-2 focus:ring-blue-500"
+                />
+              </div>
+            </div>
+          </div>
+
+          <div className="flex items-center space-x-2">
+            <input
+              ref={inputRef}
+              type="text"
+              value={expression}
+              onChange={(e) => setExpression(e.target.value)}
+              onKeyDown={(e) => {
+                if (e.key === 'Enter') {
+                  handleParse()
+                }
+              }}
+              className="flex-1 px-4 py-2 border border-border-input rounded-lg font-mono text-sm text-[var(--text-input)] bg-input focus:outline-none focus:ring-2 focus:ring-blue-500"
+              placeholder="例如：*/15 9-17 * * 1-5、0 0 12 ? * MON-FRI、@daily"
+              autoComplete="off"
+              autoCorrect="off"
+              autoCapitalize="off"
+              spellCheck="false"
+            />
+            <button
+              onClick={() => handleParse()}
+              disabled={loading || !expression.trim()}
+              className={`px-4 py-2 bg-blue-500 text-white rounded-lg text-sm font-medium select-none transition-all ${
+                loading || !expression.trim()
+                  ? 'opacity-50 cursor-not-allowed'
+                  : 'hover:bg-blue-600 active:bg-blue-700 active:scale-95 focus:outline-none focus:ring-2 focus:ring-blue-500 focus:ring-offset-2'
+              } ${
+                buttonDisabledFeedback ? 'animate-pulse' : ''
+              }`}
+            >
+              {loading ? '解析中...' : '解析'}
+            </button>
+            <button
+              onClick={() => setShowBuilder((prev) => !prev)}
+              className={`px-4 py-2 rounded-lg transition-colors text-sm select-none ${
+                showBuilder
+                  ? 'bg-blue-500 text-white'
+                  : 'bg-button-secondary text-button-secondary-text hover:bg-[var(--button-secondary-hover)]'
+              }`}
+            >
+              生成器
+            </button>
+            <button
+              onClick={handleClear}
+              disabled={!expression && !result}
+              className="p-2 bg-button-secondary text-button-secondary-text rounded-lg hover:bg-[var(--button-secondary-hover)] transition-colors select-none disabled:opacity-50 disabled:cursor-not-allowed"
+              title="清空"
+            >
+              <svg xmlns="http://www.w3.org/2000/svg" className="h-5 w-5" fill="none" viewBox="0 0 24 24" stroke="currentColor">
+                <path strokeLinecap="round" strokeLinejoin="round" strokeWidth={2} d="M6 18L18 6M6 6l12 12" />
+              </svg>
+            </button>
+            <button
+              onClick={handleCopy}
+              disabled={!expression}
+              className="p-2 bg-button-secondary text-button-secondary-text rounded-lg hover:bg-[var(--button-secondary-hover)] transition-colors select-none disabled:opacity-50 disabled:cursor-not-allowed"
+              title="复制表达式"
+            >
+              <svg xmlns="http://www.w3.org/2000/svg" className="h-5 w-5" fill="none" viewBox="0 0 24 24" stroke="currentColor">
+                <path strokeLinecap="round" strokeLinejoin="round" strokeWidth={2} d="M8 16H6a2 2 0 01-2-2V6a2 2 0 012-2h8a2 2 0 012 2v2m-6 12h8a2 2 0 002-2v-8a2 2 0 00-2-2h-8a2 2 0 00-2 2v8a2 2 0 002 2z" />
+              </svg>
+            </button>
+          </div>
+
+          {showBuilder && (
+            <div className="mt-4 p-4 border border-border-input rounded-lg space-y-3">
+              <div className="flex items-center flex-wrap gap-4">
+                <div className="flex items-center space-x-2">
+                  <span className="text-sm text-[var(--text-primary)] select-none">方言：</span>
+                  <Select value={buildOptions.dialect} onChange={(v) => updateBuildOption('dialect', v)} options={buildDialects} className="w-36" />
+                </div>
+                <div className="flex items-center space-x-2">
+                  <span className="text-sm text-[var(--text-primary)] select-none">频率：</span>
+                  <Select value={buildOptions.frequency} onChange={(v) => updateBuildOption('frequency', v)} options={frequencies} className="w-28" />
+                </div>
+                <div className="flex items-center space-x-2">
+                  <span className="text-sm text-[var(--text-primary)] select-none">间隔：</span>
+                  {numberInput('interval', 1, 59)}
+                </div>
+                <div className="flex items-center space-x-2">
+                  <span className="text-sm text-[var(--text-primary)] select-none">时间：</span>
+                  {numberInput('hour', 0, 23)}
+                  <span className="text-[var(--text-primary)]">:</span>
+                  {numberInput('minute', 0, 59)}
+                  {buildOptions.dialect !== 'standard' && (
+                    <>
+                      <span className="text-[var(--text-primary)]">:</span>
+                      {numberInput('second', 0, 59)}
+                    </>
+                  )}
+                </div>
+              </div>
+              {buildOptions.frequency === 'week' && (
+                <div className="flex items-center space-x-2">
+                  <span className="text-sm text-[var(--text-primary)] select-none">星期：</span>
+                  {WEEKDAY_LABELS.map((label, day) => (
+                    <button
+                      key={label}
+                      onClick={() => toggleWeekday(day)}
+                      className={`w-8 h-8 rounded-lg text-sm select-none transition-colors ${
+                        buildOptions.weekdays.includes(day)
+                          ? 'bg-blue-500 text-white'
+                          : 'bg-button-secondary text-button-secondary-text hover:bg-[var(--button-secondary-hover)]'
+                      }`}
+                    >
+                      {label}
+                    </button>
+                  ))}
+                </div>
+              )}
+              {(buildOptions.frequency === 'month' || buildOptions.frequency === 'year') && (
+                <div className="flex items-center space-x-4">
+                  <div className="flex items-center space-x-2">
+                    <span className="text-sm text-[var(--text-primary)] select-none">日期：</span>
+                    <input
+                      type="text"
+                      value={monthDaysText}
+                      onChange={(e) => setMonthDaysText(e.target.value)}
+                      disabled={buildOptions.lastDayOfMonth}
+                      className="w-40 px-3 py-1 border border-border-input rounded-lg text-sm text-[var(--text-input)] bg-input focus:outline-none focus:ring-2 focus:ring-blue-500 disabled:opacity-50"
+                      placeholder="如 1,15"
+                    />
+                  </div>
+                  {buildOptions.dialect !== 'standard' && (
+                    <label className="flex items-center space-x-2 text-sm text-[var(--text-primary)] select-none cursor-pointer">
+                      <input
+                        type="checkbox"
+                        checked={buildOptions.lastDayOfMonth}
+                        onChange={(e) => updateBuildOption('lastDayOfMonth', e.target.checked)}
+                        className="rounded"
+                      />
+                      <span>每月最后一天</span>
+                    </label>
+                  )}
+                </div>
+              )}
+              <button
+                onClick={handleBuild}
+                className="px-4 py-2 bg-blue-500 text-white rounded-lg hover:bg-blue-600 transition-colors text-sm font-medium select-none"
+              >
+                生成表达式
+              </button>
+            </div>
+          )}
+
+          {error && (
+            <div className="mt-4 p-3 rounded-lg bg-error-bg text-error-text select-none">
+              {error}
+            </div>
+          )}
+        </div>
+
+        {explanation && (
+          <div className="bg-secondary rounded-lg shadow-sm border border-border-primary p-6 space-y-4">
+            <h3 className="text-lg font-semibold text-[var(--text-primary)] select-none">说明</h3>
+            <div className="space-y-1">
+              <div className="text-[var(--text-primary)]">{explanation.chinese}</div>
+              <div className="text-sm text-[var(--text-secondary)]">{explanation.english}</div>
+            </div>
+            {explanation.fields?.length > 0 && (
+              <table className="w-full text-sm">
+                <tbody>
+                  {explanation.fields.map((field) => (
+                    <tr key={field.name} className="border-t border-border-input">
+                      <td className="py-2 pr-4 text-[var(--text-secondary)] whitespace-nowrap">{field.name}</td>
+                      <td className="py-2 pr-4 font-mono text-[var(--text-primary)]">{field.raw}</td>
+                      <td className="py-2 text-[var(--text-primary)]">{field.chinese}</td>
+                    </tr>
+                  ))}
+                </tbody>
+              </table>
+            )}
+            {explanation.warnings?.length > 0 && (
+              <ul className="p-3 rounded-lg bg-error-bg text-error-text text-sm space-y-1">
+                {explanation.warnings.map((warning) => (
+                  <li key={warning}>⚠️ {warning}</li>
+                ))}
+              </ul>
+            )}
+          </div>
+        )}
+
+        {result?.runs?.length > 0 && (
+          <div className="bg-secondary rounded-lg shadow-sm border border-border-primary p-6">
+            <h3 className="text-lg font-semibold text-[var(--text-primary)] select-none mb-4">
+              接下来的触发时间（{result.timezone}）
+            </h3>
+            <ul className="space-y-2">
+              {result.runs.map((run) => (
+                <li key={run.timestamp} className="text-sm">
+                  <div className="flex items-center space-x-3 font-mono">
+                    <span className="text-[var(--text-primary)]">{run.time}</span>
+                    <span className="text-[var(--text-secondary)]">{run.weekday}</span>
+                    <span className="text-[var(--text-secondary)]">UTC {run.utc}</span>
+                    {run.flag && (
+                      <span className="px-2 py-0.5 rounded bg-error-bg text-error-text text-xs">{run.flag}</span>
+                    )}
+                  </div>
+                  {run.note && <div className="mt-1 text-xs text-[var(--text-secondary)]">{run.note}</div>}
+                </li>
+              ))}
+            </ul>
+          </div>
+        )}
+        <Toast
+          message="已复制到剪贴板"
+          show={showToast}
+          onClose={() => setShowToast(false)}
+        />
+      </div>
+      <ToolHistoryDrawer
+        title="历史记录"
+        records={historyRecords}
+        maxItems={MAX_CRON_HISTORY_ITEMS}
+        isOpen={isHistoryPanelOpen}
+        onClose={() => setIsHistoryPanelOpen(false)}
+        panelRef={historyPanelRef}
+      />
+    </div>
+  )
+}
+
+export default CronTool
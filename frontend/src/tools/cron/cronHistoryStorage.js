@@ -0,0 +1,11 @@
+import { addToolHistoryItem, loadToolHistory, MAX_TOOL_HISTORY_ITEMS } from '../../utils/toolHistoryStorage'
+
+export const MAX_CRON_HISTORY_ITEMS = MAX_TOOL_HISTORY_ITEMS
+
+export function loadCronHistory() {
+  return loadToolHistory('Cron')
+}
+
+export function addCronHistoryItem(item) {
+  return addToolHistoryItem('Cron', { ...item, toolId: 'cron' })
+}
@@ -0,0 +1,485 @@
+import React, { useState, useEffect, useRef } from 'react'
+import { getWailsAPI, waitForWailsAPI } from '../../utils/api'
+import Toast from '../../components/Toast'
+import ToolHeader from '../../components/ToolHeader'
+import Select from '../../components/Select'
+import ToolHistoryDrawer from '../../components/ToolHistoryDrawer'
+import { useAutoFocus } from '../../hooks/useAutoFocus'
+import { addHexHistoryItem, loadHexHistory, MAX_HEX_HISTORY_ITEMS } from './hexHistoryStorage'
+import { createHistoryId, truncateText } from '../../utils/toolHistoryStorage'
+
+// 文件转储每页字节数
+const DUMP_PAGE_SIZE = 4096
+
+function HexTool({ onShowHelp, isActive }) {
+  const [input, setInput] = useState('')
+  const [output, setOutput] = useState('')
+  const [mode, setMode] = useState('encode') // 'encode' | 'decode' | 'reformat' | 'dump'
+  const [inputMode, setInputMode] = useState('text') // 'text' or 'file'
+  const [style, setStyle] = useState('plain')
+  const [uppercase, setUppercase] = useState(false)
+  const [bytesPerLine, setBytesPerLine] = useState('16')
+  const [filePath, setFilePath] = useState('')
+  const [dumpPage, setDumpPage] = useState(null)
+  const [outputNote, setOutputNote] = useState('')
+  const [api, setApi] = useState(null)
+  const [error, setError] = useState('')
+  const [showToast, setShowToast] = useState(false)
+  const [loading, setLoading] = useState(false)
+  const [buttonDisabledFeedback, setButtonDisabledFeedback] = useState(false)
+  const [historyRecords, setHistoryRecords] = useState([])
+  const [isHistoryPanelOpen, setIsHistoryPanelOpen] = useState(false)
+  const inputRef = useRef(null)
+  const historyPanelRef = useRef(null)
+  const historyToggleButtonRef = useRef(null)
+
+  const modes = [
+    { value: 'encode', label: '文本转十六进制' },
+    { value: 'decode', label: '十六进制转文本' },
+    { value: 'reformat', label: '格式转换' },
+    { value: 'dump', label: 'xxd 转储' },
+  ]
+
+  const styles = [
+    { value: 'plain', label: 'deadbeef' },
+    { value: 'spaced', label: 'de ad be ef' },
+    { value: 'colon', label: 'de:ad:be:ef' },
+    { value: 'prefix', label: '0xde, 0xad' },
+    { value: 'escaped', label: '\\xde\\xad' },
+    { value: 'c-array', label: 'C 数组' },
+  ]
+
+  const lineWidths = [
+    { value: '8', label: '8 字节/行' },
+    { value: '16', label: '16 字节/行' },
+    { value: '32', label: '32 字节/行' },
+  ]
+
+  // 文件输入只适用于编码和转储
+  const supportsFile = mode === 'encode' || mode === 'dump'
+  const usesStyle = mode === 'encode' || mode === 'reformat'
+  const isFileInput = supportsFile && inputMode === 'file'
+
+  useEffect(() => {
+    waitForWailsAPI()
+      .then((wailsAPI) => {
+        if (wailsAPI?.Hex) {
+          setApi(wailsAPI)
+        }
+      })
+      .catch(() => {
+        setError('后端 API 初始化失败')
+      })
+  }, [])
+
+  useEffect(() => {
+    if (!isHistoryPanelOpen) {
+      return undefined
+    }
+    const handleOutsideClick = (event) => {
+      const panelNode = historyPanelRef.current
+      const toggleButtonNode = historyToggleButtonRef.current
+      const target = event.target
+      if (panelNode?.contains(target) || toggleButtonNode?.contains(target)) {
+        return
+      }
+      setIsHistoryPanelOpen(false)
+    }
+
+    document.addEventListener('mousedown', handleOutsideClick)
+    return () => {
+      document.removeEventListener('mousedown', handleOutsideClick)
+    }
+  }, [isHistoryPanelOpen])
+
+  useEffect(() => {
+    let cancelled = false
+    const fetchHistory = async () => {
+      const records = await loadHexHistory()
+      if (!cancelled) {
+        setHistoryRecords(records)
+      }
+    }
+    fetchHistory()
+    return () => {
+      cancelled = true
+    }
+  }, [])
+
+  // 当 isActive 变为 true 时，自动聚焦输入框（仅在文本模式下）
+  useAutoFocus(inputRef, isActive, !isFileInput, { maxAttempts: 15 })
+
+  const dumpOptions = () => ({
+    bytesPerLine: parseInt(bytesPerLine, 10),
+    groupSize: 2,
+    uppercase,
+  })
+
+  const handleSelectFile = async () => {
+    try {
+      setError('')
+      setLoading(true)
+      const wailsAPI = api || getWailsAPI()
+      if (!wailsAPI?.Hex) {
+        setError('后端 API 未加载，请稍候重试')
+        return
+      }
+      const path = await wailsAPI.Hex.OpenFileDialog()
+      if (path && path.trim()) {
+        setFilePath(path)
+        setDumpPage(null)
+      }
+    } catch (err) {
+      // 只有当不是用户取消时才显示错误
+      if (err.message && !err.message.includes('cancelled') && !err.message.includes('取消')) {
+        setError(err.message || '选择文件失败')
+      }
+    } finally {
+      setLoading(false)
+    }
+  }
+
+  const loadFilePage = async (wailsAPI, offset) => {
+    const page = await wailsAPI.Hex.DumpFilePage(filePath, offset, DUMP_PAGE_SIZE, dumpOptions())
+    setDumpPage(page)
+    return page?.dump || ''
+  }
+
+  const handlePage = async (offset) => {
+    try {
+      setError('')
+      setLoading(true)
+      const wailsAPI = api || getWailsAPI()
+      setOutput(await loadFilePage(wailsAPI, offset))
+    } catch (err) {
+      setError(err.message || '读取文件失败')
+    } finally {
+      setLoading(false)
+    }
+  }
+
+  const handleConvert = async () => {
+    // 检查按钮是否应该被禁用
+    const isDisabled = loading || (isFileInput ? !filePath : !input.trim())
+    if (isDisabled) {
+      // 给用户反馈
+      setButtonDisabledFeedback(true)
+      setTimeout(() => {
+        setButtonDisabledFeedback(false)
+      }, 300)
+      return
+    }
+
+    try {
+      setError('')
+      setLoading(true)
+      const wailsAPI = api || getWailsAPI()
+      if (!wailsAPI?.Hex) {
+        setError('后端 API 未加载，请稍候重试')
+        return
+      }
+
+      let result = ''
+      let note = ''
+      if (mode === 'encode') {
+        result = isFileInput
+          ? await wailsAPI.Hex.FileToHex(filePath, style, uppercase)
+          : await wailsAPI.Hex.TextToHex(input, style, uppercase)
+      } else if (mode === 'decode') {
+        const decoded = await wailsAPI.Hex.HexToText(input)
+        result = decoded?.text || ''
+        note = `${decoded?.byteCount || 0} 字节${decoded?.isUtf8 ? '' : '，不是有效的 UTF-8 文本'}`
+      } else if (mode === 'reformat') {
+        result = await wailsAPI.Hex.Reformat(input, style, uppercase)
+      } else if (isFileInput) {
+        result = await loadFilePage(wailsAPI, 0)
+      } else {
+        setDumpPage(null)
+        result = await wailsAPI.Hex.DumpText(input, dumpOptions())
+      }
+
+      setOutput(result)
+      setOutputNote(note)
+      const { success, items } = await addHexHistoryItem({
+        id: createHistoryId(),
+        action: modes.find((m) => m.value === mode)?.label || mode,
+        createdAt: Date.now(),
+        input: {
+          source: isFileInput ? truncateText(filePath) : truncateText(input),
+          ...(usesStyle ? { style } : {}),
+        },
+        output: {
+          value: truncateText(result),
+          ...(note ? { note } : {}),
+        },
+      })
+      if (success) {
+        setHistoryRecords(items)
+      }
+    } catch (err) {
+      setError(err.message || '转换失败')
+    } finally {
+      setLoading(false)
+    }
+  }
+
+  const handleCopy = async () => {
+    try {
+      await navigator.clipboard.writeText(output)
+      setShowToast(true)
+    } catch (err) {
+      setError('复制失败')
+    }
+  }
+
+  const handleClear = () => {
+    setOutput('')
+    setOutputNote('')
+    setDumpPage(null)
+    setError('')
+  }
+
+  const handleModeChange = (value) => {
+    setMode(value)
+    setOutput('')
+    setOutputNote('')
+    setDumpPage(null)
+    setError('')
+    if (value !== 'encode' && value !== 'dump') {
+      setInputMode('text')
+    }
+  }
+
+  const handleInputModeChange = (value) => {
+    setInputMode(value)
+    setOutput('')
+    setDumpPage(null)
+    setError('')
+  }
+
+  const convertDisabled = loading || (isFileInput ? !filePath : !input.trim())
+
+  return (
+    <div className="h-full flex flex-col relative overflow-hidden">
+      <div className="relative">
+        <ToolHeader
+          title="十六进制工具"
+          description="文本与十六进制互转、格式转换以及 xxd 风格转储"
+          toolId="hex"
+          onShowHelp={onShowHelp}
+        />
+        <button
+          ref={historyToggleButtonRef}
+          onClick={() => setIsHistoryPanelOpen((prev) => !prev)}
+          className={`absolute top-1 right-0 px-3 py-2 text-sm rounded-lg transition-colors select-none ${
+            isHistoryPanelOpen
+              ? 'bg-blue-500 text-white hover:bg-blue-600'
+              : 'bg-button-secondary text-button-secondary-text hover:bg-[var(--button-secondary-hover)]'
+          }`}
+        >
+          历史记录
+        </button>
+      </div>
+      <div className="flex-1 min-h-0 overflow-y-auto space-y-4">
+        <div className="bg-secondary rounded-lg shadow-sm border border-border-primary p-6">
+          <div className="flex items-center justify-between mb-4">
+            <h3 className="text-lg font-semibold text-[var(--text-primary)] select-none">输入</h3>
+            <div className="flex items-center space-x-4">
+              <div className="flex items-center space-x-2">
+                <span className="text-sm font-medium text-[var(--text-primary)] select-none">功能：</span>
+                <Select value={mode} onChange={handleModeChange} options={modes} className="w-40" />
+              </div>
+              {supportsFile && (
+                <div className="flex items-center space-x-2 border-l border-border-input pl-4">
+                  <button
+                    onClick={() => handleInputModeChange('text')}
+                    className={`px-4 py-2 rounded-lg transition-colors text-sm select-none ${
+                      inputMode === 'text'
+                        ? 'bg-blue-500 text-white'
+                        : 'bg-button-secondary text-button-secondary-text hover:bg-[var(--button-secondary-hover)]'
+                    }`}
+                  >
+                    文本输入
+                  </button>
+                  <button
+                    onClick={() => handleInputModeChange('file')}
+                    className={`px-4 py-2 rounded-lg transition-colors text-sm select-none ${
+                      inputMode === 'file'
+                        ? 'bg-blue-500 text-white'
+                        : 'bg-button-secondary text-button-secondary-text hover:bg-[var(--button-secondary-hover)]'
+                    }`}
+                  >
+                    文件选择
+                  </button>
+                </div>
+              )}
+            </div>
+          </div>
+
+          <div className="flex items-center space-x-4 mb-4">
+            {usesStyle && (
+              <div className="flex items-center space-x-2">
+                <span className="text-sm font-medium text-[var(--text-primary)] select-none">输出格式：</span>
+                <Select value={style} onChange={setStyle} options={styles} className="w-40" />
+              </div>
+            )}
+            {mode === 'dump' && (
+              <div className="flex items-center space-x-2">
+                <span className="text-sm font-medium text-[var(--text-primary)] select-none">每行：</span>
+                <Select value={bytesPerLine} onChange={setBytesPerLine} options={lineWidths} className="w-36" />
+              </div>
+            )}
+            {mode !== 'decode' && (
+              <label className="flex items-center space-x-2 text-sm text-[var(--text-primary)] select-none cursor-pointer">
+                <input
+                  type="checkbox"
+                  checked={uppercase}
+                  onChange={(e) => setUppercase(e.target.checked)}
+                  className="rounded"
+                />
+                <span>大写</span>
+              </label>
+            )}
+          </div>
+
+          {isFileInput ? (
+            <div className="flex items-center space-x-4">
+              <button
+                onClick={handleSelectFile}
+                disabled={loading}
+                className="px-4 py-2 bg-blue-500 text-white rounded-lg hover:bg-blue-600 transition-colors text-sm font-medium select-none disabled:opacity-50 disabled:cursor-not-allowed flex-shrink-0"
+              >
+                {loading ? '选择中...' : '浏览文件'}
+              </button>
+              <input
+                type="text"
+                value={filePath}
+                onChange={(e) => {
+                  setFilePath(e.target.value)
+                  setDumpPage(null)
+                  setError('')
+                }}
+                className="flex-1 px-3 py-2 border border-border-input rounded-lg text-sm text-[var(--text-input)] bg-input focus:outline-none focus:ring-2 focus:ring-blue-500 font-mono"
+                placeholder="输入文件路径或点击浏览文件按钮选择文件..."
+                autoComplete="off"
+                autoCorrect="off"
+                autoCapitalize="off"
+                spellCheck="false"
+              />
+            </div>
+          ) : (
+            <textarea
+              ref={inputRef}
+              value={input}
+              onChange={(e) => setInput(e.target.value)}
+              className="w-full h-48 p-4 border border-border-input rounded-lg font-mono text-sm text-[var(--text-input)] bg-input focus:outline-none focus:ring-2 focus:ring-blue-500"
+              placeholder={mode === 'encode' || mode === 'dump'
+                ? '输入要转换的文本...'
+                : '输入十六进制，支持 deadbeef、de ad be ef、0xde, 0xad、\\xde\\xad 和 C 数组...'}
+              autoComplete="off"
+              autoCorrect="off"
+              autoCapitalize="off"
+              spellCheck="false"
+            />
+          )}
+
+          {error && (
+            <div className="mt-4 p-3 rounded-lg bg-error-bg text-error-text select-none">
+              {error}
+            </div>
+          )}
+        </div>
+
+        <div className="bg-secondary rounded-lg shadow-sm border border-border-primary p-6">
+          <div className="flex items-center justify-between mb-4">
+            <h3 className="text-lg font-semibold text-[var(--text-primary)] select-none">输出</h3>
+            <div className="flex items-center space-x-2">
+              <button
+                onClick={handleConvert}
+                disabled={convertDisabled}
+                className={`px-4 py-2 bg-blue-500 text-white rounded-lg text-sm font-medium select-none transition-all ${
+                  convertDisabled
+                    ? 'opacity-50 cursor-not-allowed'
+                    : 'hover:bg-blue-600 active:bg-blue-700 active:scale-95 focus:outline-none focus:ring-2 focus:ring-blue-500 focus:ring-offset-2'
+                } ${
+                  buttonDisabledFeedback ? 'animate-pulse' : ''
+                }`}
+              >
+                {loading ? '转换中...' : '转换'}
+              </button>
+              <button
+                onClick={handleClear}
+                disabled={!output}
+                className="p-2 bg-button-secondary text-button-secondary-text rounded-lg hover:bg-[var(--button-secondary-hover)] transition-colors select-none disabled:opacity-50 disabled:cursor-not-allowed"
+                title="清空"
+              >
+                <svg xmlns="http://www.w3.org/2000/svg" className="h-5 w-5" fill="none" viewBox="0 0 24 24" stroke="currentColor">
+                  <path strokeLinecap="round" strokeLinejoin="round" strokeWidth={2} d="M6 18L18 6M6 6l12 12" />
+                </svg>
+              </button>
+              <button
+                onClick={handleCopy}
+                disabled={!output}
+                className="p-2 bg-button-secondary text-button-secondary-text rounded-lg hover:bg-[var(--button-secondary-hover)] transition-colors select-none disabled:opacity-50 disabled:cursor-not-allowed"
+                title="复制"
+              >
+                <svg xmlns="http://www.w3.org/2000/svg" className="h-5 w-5" fill="none" viewBox="0 0 24 24" stroke="currentColor">
+                  <path strokeLinecap="round" strokeLinejoin="round" strokeWidth={2} d="M8 16H6a2 2 0 01-2-2V6a2 2 0 012-2h8a2 2 0 012 2v2m-6 12h8a2 2 0 002-2v-8a2 2 0 00-2-2h-8a2 2 0 00-2 2v8a2 2 0 002 2z" />
+                </svg>
+              </button>
+            </div>
+          </div>
+          <textarea
+            value={output}
+            readOnly
+            className="w-full h-64 p-4 border border-border-input rounded-lg font-mono text-sm bg-input-disabled text-[var(--text-input)] focus:outline-none whitespace-pre"
+            placeholder="转换结果将显示在这里..."
+            autoComplete="off"
+            spellCheck="false"
+          />
+          {outputNote && (
+            <div className="mt-3 text-sm text-[var(--text-secondary)] select-none">{outputNote}</div>
+          )}
+          {dumpPage && (
+            <div className="flex items-center justify-between mt-3 text-sm text-[var(--text-secondary)] select-none">
+              <span>
+                偏移 {dumpPage.offset} - {dumpPage.offset + dumpPage.length}，共 {dumpPage.totalSize} 字节
+              </span>
+              <div className="flex items-center space-x-2">
+                <button
+                  onClick={() => handlePage(Math.max(dumpPage.offset - DUMP_PAGE_SIZE, 0))}
+                  disabled={loading || dumpPage.offset === 0}
+                  className="px-3 py-1 bg-button-secondary text-button-secondary-text rounded-lg hover:bg-[var(--button-secondary-hover)] transition-colors disabled:opacity-50 disabled:cursor-not-allowed"
+                >
+                  上一页
+                </button>
+                <button
+                  onClick={() => handlePage(dumpPage.offset + dumpPage.length)}
+                  disabled={loading || !dumpPage.hasMore}
+                  className="px-3 py-1 bg-button-secondary text-button-secondary-text rounded-lg hover:bg-[var(--button-secondary-hover)] transition-colors disabled:opacity-50 disabled:cursor-not-allowed"
+                >
+                  下一页
+                </button>
+              </div>
+            </div>
+          )}
+        </div>
+        <Toast
+          message="已复制到剪贴板"
+          show={showToast}
+          onClose={() => setShowToast(false)}
+        />
+      </div>
+      <ToolHistoryDrawer
+        title="历史记录"
+        records={historyRecords}
+        maxItems={MAX_HEX_HISTORY_ITEMS}
+        isOpen={isHistoryPanelOpen}
+        onClose={() => setIsHistoryPanelOpen(false)}
+        panelRef={historyPanelRef}
+      />
+    </div>
+  )
+}
+
+export default HexTool
@@ -0,0 +1,11 @@
+import { addToolHistoryItem, loadToolHistory, MAX_TOOL_HISTORY_ITEMS } from '../../utils/toolHistoryStorage'
+
+export const MAX_HEX_HISTORY_ITEMS = MAX_TOOL_HISTORY_ITEMS
+
+export function loadHexHistory() {
+  return loadToolHistory('Hex')
+}
+
+export function addHexHistoryItem(item) {
+  return addToolHistoryItem('Hex', { ...item, toolId: 'hex' })
+}
@@ -0,0 +1,151 @@
+import React, { useState } from 'react'
+import { getWailsAPI } from '../../utils/api'
+import Select from '../../components/Select'
+
+const directionOptions = [
+  { value: 'to-time', label: '时间戳 → 时间' },
+  { value: 'to-epoch', label: '时间 → 时间戳' },
+]
+
+const unitOptions = [
+  { value: 'auto', label: '自动识别' },
+  { value: 's', label: '秒' },
+  { value: 'ms', label: '毫秒' },
+  { value: 'us', label: '微秒' },
+  { value: 'ns', label: '纳秒' },
+]
+
+// BatchConvertPanel 批量转换日志、CSV 等文本中的时间戳或时间字符串，左右对照预览替换结果
+function BatchConvertPanel({ api, formats, timezones, onError, onCopy }) {
+  const [text, setText] = useState('')
+  const [direction, setDirection] = useState('to-time')
+  const [format, setFormat] = useState('DateTime')
+  const [timezone, setTimezone] = useState('Asia/Shanghai')
+  const [unit, setUnit] = useState('auto')
+  const [result, setResult] = useState(null)
+  const [onlyChanged, setOnlyChanged] = useState(true)
+
+  const handleConvert = async () => {
+    try {
+      onError('')
+      const wailsAPI = api || getWailsAPI()
+      if (!wailsAPI?.Timestamp) {
+        onError('后端 API 未加载，请稍候重试')
+        return
+      }
+      const converted = await wailsAPI.Timestamp.BatchConvert(text, { direction, format, timezone, unit })
+      setResult(converted)
+    } catch (err) {
+      setResult(null)
+      onError(err.message || '批量转换失败')
+    }
+  }
+
+  const lines = (result?.lines || []).filter((line) => !onlyChanged || line.changed)
+
+  return (
+    <div className="bg-secondary rounded-lg shadow-sm border border-border-primary p-6">
+      <h3 className="text-lg font-semibold text-[var(--text-primary)] mb-4 select-none">批量转换</h3>
+      <div className="grid grid-cols-4 gap-4 mb-4">
+        <div className="min-w-0">
+          <label className="block text-sm font-medium text-[var(--text-primary)] mb-2 select-none">方向</label>
+          <Select value={direction} onChange={setDirection} options={directionOptions} className="w-full" />
+        </div>
+        <div className="min-w-0">
+          <label className="block text-sm font-medium text-[var(--text-primary)] mb-2 select-none">
+            {direction === 'to-time' ? '输入单位' : '输出单位'}
+          </label>
+          <Select value={unit} onChange={setUnit} options={unitOptions} className="w-full" />
+        </div>
+        <div className="min-w-0">
+          <label className="block text-sm font-medium text-[var(--text-primary)] mb-2 select-none">输出格式</label>
+          <Select
+            value={format}
+            onChange={setFormat}
+            options={formats}
+            className={`w-full ${direction === 'to-time' ? '' : 'opacity-50 pointer-events-none'}`}
+          />
+        </div>
+        <div className="min-w-0">
+          <label className="block text-sm font-medium text-[var(--text-primary)] mb-2 select-none">时区</label>
+          <Select value={timezone} onChange={setTimezone} options={timezones} className="w-full" />
+        </div>
+      </div>
+
+      <div className="grid grid-cols-2 gap-4">
+        <div className="min-w-0">
+          <label className="block text-sm font-medium text-[var(--text-primary)] mb-2 select-none">原始文本</label>
+          <textarea
+            value={text}
+            onChange={(e) => setText(e.target.value)}
+            className="w-full h-56 p-3 text-xs border border-border-input rounded-lg font-mono text-[var(--text-input)] bg-input resize-none focus:outline-none focus:ring-2 focus:ring-blue-500"
+            placeholder="粘贴日志、CSV 等文本，其中的时间戳或时间字符串会被原位替换"
+            spellCheck="false"
+          />
+        </div>
+        <div className="min-w-0">
+          <div className="flex items-center justify-between mb-2">
+            <label className="block text-sm font-medium text-[var(--text-primary)] select-none">
+              转换结果{result ? `（替换 ${result.count} 处，跳过 ${result.skipped} 处）` : ''}
+            </label>
+            {result?.output && (
+              <button
+                onClick={() => onCopy(result.output)}
+                className="px-2 py-1 text-xs bg-button-secondary text-button-secondary-text rounded hover:bg-[var(--button-secondary-hover)] transition-colors select-none"
+              >
+                复制结果
+              </button>
+            )}
+          </div>
+          <textarea
+            value={result?.output || ''}
+            readOnly
+            className="w-full h-56 p-3 text-xs border border-border-input rounded-lg font-mono text-[var(--text-input)] bg-input-disabled resize-none focus:outline-none"
+            placeholder="转换结果"
+            spellCheck="false"
+          />
+        </div>
+      </div>
+
+      <button
+        onClick={handleConvert}
+        disabled={!text.trim()}
+        className="w-full mt-4 px-4 py-2 bg-blue-500 text-white rounded-lg hover:bg-blue-600 transition-colors text-sm font-medium select-none disabled:opacity-50 disabled:cursor-not-allowed"
+      >
+        批量转换
+      </button>
+
+      {result && (
+        <div className="mt-4">
+          <div className="flex items-center justify-between mb-2">
+            <label className="block text-sm font-medium text-[var(--text-primary)] select-none">逐行对照</label>
+            <label className="flex items-center gap-2 text-sm text-[var(--text-secondary)] select-none">
+              <input type="checkbox" checked={onlyChanged} onChange={(e) => setOnlyChanged(e.target.checked)} />
+              仅显示有替换的行
+            </label>
+          </div>
+          <div className="max-h-72 overflow-y-auto border border-border-input rounded-lg">
+            {lines.length === 0 ? (
+              <div className="p-3 text-sm text-[var(--text-secondary)] select-none">没有可替换的内容</div>
+            ) : (
+              lines.map((line) => (
+                <div
+                  key={line.number}
+                  className="grid grid-cols-[3rem_1fr_1fr] gap-2 px-3 py-1 border-b border-border-primary last:border-b-0 font-mono text-xs"
+                >
+                  <span className="text-[var(--text-secondary)] select-none">{line.number}</span>
+                  <span className="text-[var(--text-input)] break-all">{line.original}</span>
+                  <span className={`break-all ${line.changed ? 'text-blue-500' : 'text-[var(--text-input)]'}`}>
+                    {line.converted}
+                  </span>
+                </div>
+              ))
+            )}
+          </div>
+        </div>
+      )}
+    </div>
+  )
+}
+
+export default BatchConvertPanel
@@ -0,0 +1,157 @@
+import React, { useState } from 'react'
+import { getWailsAPI } from '../../utils/api'
+import Select from '../../components/Select'
+
+const syntaxOptions = [
+  { value: 'auto', label: '自动识别' },
+  { value: 'go', label: 'Go' },
+  { value: 'strftime', label: 'strftime' },
+  { value: 'java', label: 'Java' },
+  { value: 'moment', label: 'moment.js' },
+]
+
+// FormatManager 自定义时间格式管理：预览翻译结果、保存命名格式、删除当前选中的自定义格式
+function FormatManager({ api, formats, selected, timezone, onFormatsChange, onSelect, onError }) {
+  const [name, setName] = useState('')
+  const [pattern, setPattern] = useState('')
+  const [syntax, setSyntax] = useState('auto')
+  const [translation, setTranslation] = useState(null)
+
+  const selectedFormat = formats.find((item) => item.name === selected)
+  const canDelete = selectedFormat && !selectedFormat.builtIn
+
+  const getTimestampAPI = () => {
+    const wailsAPI = api || getWailsAPI()
+    if (!wailsAPI?.Timestamp) {
+      onError('后端 API 未加载，请稍候重试')
+      return null
+    }
+    return wailsAPI.Timestamp
+  }
+
+  const handlePreview = async () => {
+    const timestampAPI = getTimestampAPI()
+    if (!timestampAPI) {
+      return
+    }
+    try {
+      onError('')
+      const result = await timestampAPI.TranslateLayout(pattern, syntax, timezone)
+      setTranslation(result)
+    } catch (err) {
+      setTranslation(null)
+      onError(err.message || '格式翻译失败')
+    }
+  }
+
+  const handleSave = async () => {
+    const timestampAPI = getTimestampAPI()
+    if (!timestampAPI) {
+      return
+    }
+    try {
+      onError('')
+      const list = await timestampAPI.SaveFormat(name, pattern, syntax)
+      onFormatsChange(list || [])
+      onSelect(name.trim())
+      setName('')
+      setPattern('')
+      setTranslation(null)
+    } catch (err) {
+      onError(err.message || '保存格式失败')
+    }
+  }
+
+  const handleDelete = async () => {
+    const timestampAPI = getTimestampAPI()
+    if (!timestampAPI || !canDelete) {
+      return
+    }
+    try {
+      onError('')
+      const list = await timestampAPI.DeleteFormat(selected)
+      onFormatsChange(list || [])
+      onSelect('DateTime')
+    } catch (err) {
+      onError(err.message || '删除格式失败')
+    }
+  }
+
+  return (
+    <div className="bg-secondary rounded-lg shadow-sm border border-border-primary p-6">
+      <div className="flex items-center justify-between mb-4">
+        <h3 className="text-lg font-semibold text-[var(--text-primary)] select-none">自定义格式</h3>
+        <button
+          onClick={handleDelete}
+          disabled={!canDelete}
+          className="px-3 py-2 text-sm bg-button-secondary text-button-secondary-text rounded-lg hover:bg-[var(--button-secondary-hover)] transition-colors select-none disabled:opacity-50 disabled:cursor-not-allowed"
+          title={canDelete ? `删除 ${selected}` : '内置格式不能删除'}
+        >
+          删除当前格式
+        </button>
+      </div>
+      <div className="grid grid-cols-4 gap-4">
+        <div className="min-w-0">
+          <label className="block text-sm font-medium text-[var(--text-primary)] mb-2 select-none">名称</label>
+          <input
+            type="text"
+            value={name}
+            onChange={(e) => setName(e.target.value)}
+            className="w-full p-2 text-sm border border-border-input rounded-lg text-[var(--text-input)] bg-input focus:outline-none focus:ring-2 focus:ring-blue-500"
+            placeholder="例如：应用日志"
+            spellCheck="false"
+          />
+        </div>
+        <div className="min-w-0 col-span-2">
+          <label className="block text-sm font-medium text-[var(--text-primary)] mb-2 select-none">格式</label>
+          <input
+            type="text"
+            value={pattern}
+            onChange={(e) => {
+              setPattern(e.target.value)
+              setTranslation(null)
+            }}
+            className="w-full p-2 text-sm border border-border-input rounded-lg font-mono text-[var(--text-input)] bg-input focus:outline-none focus:ring-2 focus:ring-blue-500"
+            placeholder="%Y-%m-%d %H:%M:%S、yyyy-MM-dd HH:mm:ss,SSS 或 Go 布局"
+            spellCheck="false"
+          />
+        </div>
+        <div className="min-w-0">
+          <label className="block text-sm font-medium text-[var(--text-primary)] mb-2 select-none">语法</label>
+          <Select value={syntax} onChange={setSyntax} options={syntaxOptions} className="w-full" />
+        </div>
+      </div>
+      <div className="flex justify-end gap-2 mt-4">
+        <button
+          onClick={handlePreview}
+          disabled={!pattern}
+          className="px-4 py-2 text-sm bg-button-secondary text-button-secondary-text rounded-lg hover:bg-[var(--button-secondary-hover)] transition-colors select-none disabled:opacity-50 disabled:cursor-not-allowed"
+        >
+          预览
+        </button>
+        <button
+          onClick={handleSave}
+          disabled={!name.trim() || !pattern}
+          className="px-4 py-2 text-sm bg-blue-500 text-white rounded-lg hover:bg-blue-600 transition-colors select-none disabled:opacity-50 disabled:cursor-not-allowed"
+        >
+          保存
+        </button>
+      </div>
+      {translation && (
+        <div className="mt-4 p-3 bg-input-disabled border border-border-input rounded-lg font-mono text-xs text-[var(--text-input)] space-y-1 break-all">
+          <div>识别语法：{translation.syntax}</div>
+          <div>Go：{translation.go}</div>
+          <div>strftime：{translation.strftime}</div>
+          <div>Java：{translation.java}</div>
+          <div>moment.js：{translation.moment}</div>
+          <div>示例：{translation.example}</div>
+          {(translation.warnings || []).map((warning) => (
+            <div key={warning} className="text-error-text">{warning}</div>
+          ))}
+        </div>
+      )}
+    </div>
+  )
+}
+
+export default FormatManager
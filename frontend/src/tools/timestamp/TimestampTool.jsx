@@ -9,6 +9,17 @@ import {
 import Toast from '../../components/Toast'
 import ToolHeader from '../../components/ToolHeader'
 import Select from '../../components/Select'
+import FormatManager from './FormatManager'
+import BatchConvertPanel from './BatchConvertPanel'
+import WorldClockPanel from './WorldClockPanel'
+
+// 后端格式列表加载前使用的默认格式
+const defaultFormats = [
+  { name: 'RFC3339', layout: '2006-01-02T15:04:05Z07:00', builtIn: true },
+  { name: 'DateTime', layout: '2006-01-02 15:04:05', builtIn: true },
+  { name: 'Date', layout: '2006-01-02', builtIn: true },
+  { name: 'Time', layout: '15:04:05', builtIn: true },
+]
 
 function TimestampTool({ onShowHelp }) {
   const [timestamp, setTimestamp] = useState('')
@@ -24,6 +35,7 @@ function TimestampTool({ onShowHelp }) {
   const [resultTimestampMilli, setResultTimestampMilli] = useState('')
   const [resultTimeString, setResultTimeString] = useState('')
   const [api, setApi] = useState(null)
+  const [formatList, setFormatList] = useState(defaultFormats)
   const [historyRecords, setHistoryRecords] = useState([])
   const [isHistoryPanelOpen, setIsHistoryPanelOpen] = useState(false)
   const [error, setError] = useState('')
@@ -36,6 +48,7 @@ function TimestampTool({ onShowHelp }) {
       .then((wailsAPI) => {
         if (wailsAPI?.Timestamp) {
           setApi(wailsAPI)
+          loadFormats(wailsAPI)
         }
       })
       .catch(() => {
@@ -81,12 +94,22 @@ function TimestampTool({ onShowHelp }) {
     }
   }, [isHistoryPanelOpen])
 
-  const formats = [
-    { value: 'RFC3339', label: 'RFC3339' },
-    { value: 'DateTime', label: 'YYYY-MM-DD HH:mm:ss' },
-    { value: 'Date', label: 'YYYY-MM-DD' },
-    { value: 'Time', label: 'HH:mm:ss' },
-  ]
+  const loadFormats = async (wailsAPI) => {
+    try {
+      const list = await wailsAPI.Timestamp.ListFormats?.()
+      if (Array.isArray(list) && list.length > 0) {
+        setFormatList(list)
+      }
+    } catch (err) {
+      console.error('Failed to load formats:', err)
+    }
+  }
+
+  // 内置格式显示布局，自定义格式额外标注
+  const formats = formatList.map((item) => ({
+    value: item.name,
+    label: item.builtIn ? `${item.name}（${item.layout}）` : `${item.name}（自定义：${item.pattern || item.layout}）`,
+  }))
 
   // 时区列表：按照 UTC+0, UTC+1, ..., UTC+12, UTC-1, UTC-2, ..., UTC-12 的顺序
   const timezones = [
@@ -561,6 +584,26 @@ function TimestampTool({ onShowHelp }) {
         </div>
       </div>
 
+      <FormatManager
+        api={api}
+        formats={formatList}
+        selected={format}
+        timezone={timeToTimestampTimezone}
+        onFormatsChange={setFormatList}
+        onSelect={setFormat}
+        onError={setError}
+      />
+
+      <BatchConvertPanel
+        api={api}
+        formats={formats}
+        timezones={timezones}
+        onError={setError}
+        onCopy={handleCopy}
+      />
+
+      <WorldClockPanel api={api} formats={formats} onError={setError} />
+
       {error && (
         <div className="bg-error-bg border border-red-200 rounded-lg p-4 text-error-text select-none">
           {error}
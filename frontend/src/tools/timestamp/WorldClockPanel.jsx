@@ -0,0 +1,280 @@
+import React, { useState, useEffect } from 'react'
+import { getWailsAPI } from '../../utils/api'
+import Select from '../../components/Select'
+
+// 与后端 DefaultWorldClockZones 保持一致
+const defaultZones = ['Asia/Shanghai', 'Europe/Berlin', 'America/Los_Angeles']
+
+const formatDayOffset = (dayOffset) => {
+  if (!dayOffset) {
+    return ''
+  }
+  return dayOffset > 0 ? ` (+${dayOffset}天)` : ` (${dayOffset}天)`
+}
+
+const todayString = () => {
+  const now = new Date()
+  const pad = (n) => String(n).padStart(2, '0')
+  return `${now.getFullYear()}-${pad(now.getMonth() + 1)}-${pad(now.getDate())}`
+}
+
+// WorldClockPanel 世界时钟与会议时间规划：同一时刻在多个时区的展示，以及按小时对照的工作时间重叠表
+function WorldClockPanel({ api, formats, onError }) {
+  const [zones, setZones] = useState(defaultZones)
+  const [zoneInput, setZoneInput] = useState('')
+  const [allZones, setAllZones] = useState([])
+  const [timeInput, setTimeInput] = useState('')
+  const [format, setFormat] = useState('DateTime')
+  const [clock, setClock] = useState([])
+  const [date, setDate] = useState(todayString())
+  const [workStart, setWorkStart] = useState(9)
+  const [workEnd, setWorkEnd] = useState(18)
+  const [plan, setPlan] = useState(null)
+
+  useEffect(() => {
+    const wailsAPI = api || getWailsAPI()
+    if (!wailsAPI?.Timestamp?.ListTimezones) {
+      return
+    }
+    Promise.resolve(wailsAPI.Timestamp.ListTimezones())
+      .then((list) => setAllZones(list || []))
+      .catch((err) => console.error('Failed to load timezones:', err))
+  }, [api])
+
+  const getTimestampAPI = () => {
+    const wailsAPI = api || getWailsAPI()
+    if (!wailsAPI?.Timestamp) {
+      onError('后端 API 未加载，请稍候重试')
+      return null
+    }
+    return wailsAPI.Timestamp
+  }
+
+  const handleAddZone = async () => {
+    const zone = zoneInput.trim()
+    if (!zone || zones.includes(zone)) {
+      setZoneInput('')
+      return
+    }
+    if (allZones.length > 0 && !allZones.includes(zone)) {
+      const timestampAPI = getTimestampAPI()
+      const suggestions = timestampAPI ? await timestampAPI.SuggestTimezones(zone) : []
+      onError(suggestions?.length ? `未知时区 ${zone}，是否要找：${suggestions.join('、')}` : `未知时区 ${zone}`)
+      return
+    }
+    onError('')
+    setZones([...zones, zone])
+    setZoneInput('')
+  }
+
+  const handleRemoveZone = (zone) => {
+    setZones(zones.filter((item) => item !== zone))
+  }
+
+  const handleShowClock = async () => {
+    const timestampAPI = getTimestampAPI()
+    if (!timestampAPI) {
+      return
+    }
+    try {
+      onError('')
+      const result = await timestampAPI.WorldClock(timeInput.trim(), format, zones)
+      setClock(result || [])
+    } catch (err) {
+      setClock([])
+      onError(err.message || '世界时钟获取失败')
+    }
+  }
+
+  const handlePlan = async () => {
+    const timestampAPI = getTimestampAPI()
+    if (!timestampAPI) {
+      return
+    }
+    try {
+      onError('')
+      const result = await timestampAPI.MeetingPlanner(date, zones, Number(workStart), Number(workEnd))
+      setPlan(result)
+    } catch (err) {
+      setPlan(null)
+      onError(err.message || '会议规划失败')
+    }
+  }
+
+  const overlapSlots = new Set(plan?.overlapSlots || [])
+
+  return (
+    <div className="bg-secondary rounded-lg shadow-sm border border-border-primary p-6">
+      <h3 className="text-lg font-semibold text-[var(--text-primary)] mb-4 select-none">世界时钟与会议规划</h3>
+
+      <div className="mb-4">
+        <label className="block text-sm font-medium text-[var(--text-primary)] mb-2 select-none">
+          时区（第一个时区作为基准）
+        </label>
+        <div className="flex flex-wrap gap-2 mb-2">
+          {zones.map((zone, index) => (
+            <span
+              key={zone}
+              className={`inline-flex items-center gap-1 px-2 py-1 text-xs rounded-lg border border-border-primary ${
+                index === 0 ? 'bg-blue-500 text-white' : 'bg-button-secondary text-button-secondary-text'
+              }`}
+            >
+              {zone}
+              <button onClick={() => handleRemoveZone(zone)} className="ml-1 select-none" title="移除">
+                ×
+              </button>
+            </span>
+          ))}
+        </div>
+        <div className="flex space-x-2">
+          <input
+            type="text"
+            list="world-clock-zones"
+            value={zoneInput}
+            onChange={(e) => setZoneInput(e.target.value)}
+            onKeyDown={(e) => e.key === 'Enter' && handleAddZone()}
+            className="flex-1 p-2 text-sm border border-border-input rounded-lg font-mono text-[var(--text-input)] bg-input focus:outline-none focus:ring-2 focus:ring-blue-500"
+            placeholder="输入 IANA 时区，例如 Asia/Tokyo"
+            spellCheck="false"
+          />
+          <datalist id="world-clock-zones">
+            {allZones.map((zone) => (
+              <option key={zone} value={zone} />
+            ))}
+          </datalist>
+          <button
+            onClick={handleAddZone}
+            className="px-4 py-2 text-sm bg-button-secondary text-button-secondary-text rounded-lg hover:bg-[var(--button-secondary-hover)] transition-colors select-none"
+          >
+            添加
+          </button>
+        </div>
+      </div>
+
+      <div className="grid grid-cols-2 gap-6">
+        {/* 世界时钟 */}
+        <div className="space-y-3 min-w-0">
+          <div className="grid grid-cols-2 gap-2">
+            <input
+              type="text"
+              value={timeInput}
+              onChange={(e) => setTimeInput(e.target.value)}
+              className="p-2 text-sm border border-border-input rounded-lg font-mono text-[var(--text-input)] bg-input focus:outline-none focus:ring-2 focus:ring-blue-500"
+              placeholder="时间（留空为当前时间）"
+              spellCheck="false"
+            />
+            <Select value={format} onChange={setFormat} options={formats} className="w-full" />
+          </div>
+          <button
+            onClick={handleShowClock}
+            disabled={zones.length === 0}
+            className="w-full px-4 py-2 bg-blue-500 text-white rounded-lg hover:bg-blue-600 transition-colors text-sm font-medium select-none disabled:opacity-50 disabled:cursor-not-allowed"
+          >
+            显示各时区时间
+          </button>
+          {clock.length > 0 && (
+            <div className="border border-border-input rounded-lg">
+              {clock.map((item) => (
+                <div
+                  key={item.zone}
+                  className="flex items-center justify-between px-3 py-2 border-b border-border-primary last:border-b-0 text-sm"
+                >
+                  <span className="text-[var(--text-primary)]">
+                    {item.zone}
+                    <span className="ml-2 text-xs text-[var(--text-secondary)]">
+                      {item.abbreviation} UTC{item.offset}{item.isDst ? ' 夏令时' : ''}
+                    </span>
+                  </span>
+                  <span className="font-mono text-[var(--text-input)]">
+                    {item.time}{formatDayOffset(item.dayOffset)}
+                  </span>
+                </div>
+              ))}
+            </div>
+          )}
+        </div>
+
+        {/* 会议规划 */}
+        <div className="space-y-3 min-w-0">
+          <div className="grid grid-cols-3 gap-2">
+            <input
+              type="date"
+              value={date}
+              onChange={(e) => setDate(e.target.value)}
+              className="p-2 text-sm border border-border-input rounded-lg text-[var(--text-input)] bg-input focus:outline-none focus:ring-2 focus:ring-blue-500"
+            />
+            <input
+              type="number"
+              min="0"
+              max="23"
+              value={workStart}
+              onChange={(e) => setWorkStart(e.target.value)}
+              className="p-2 text-sm border border-border-input rounded-lg text-[var(--text-input)] bg-input focus:outline-none focus:ring-2 focus:ring-blue-500"
+              title="工作开始（点）"
+            />
+            <input
+              type="number"
+              min="1"
+              max="24"
+              value={workEnd}
+              onChange={(e) => setWorkEnd(e.target.value)}
+              className="p-2 text-sm border border-border-input rounded-lg text-[var(--text-input)] bg-input focus:outline-none focus:ring-2 focus:ring-blue-500"
+              title="工作结束（点）"
+            />
+          </div>
+          <button
+            onClick={handlePlan}
+            disabled={zones.length === 0 || !date}
+            className="w-full px-4 py-2 bg-blue-500 text-white rounded-lg hover:bg-blue-600 transition-colors text-sm font-medium select-none disabled:opacity-50 disabled:cursor-not-allowed"
+          >
+            生成会议规划
+          </button>
+          {plan && (
+            <div className="text-xs text-[var(--text-secondary)] select-none">
+              {plan.overlapSlots.length > 0
+                ? `共有 ${plan.overlapSlots.length} 个整点所有时区都在工作时间内（高亮显示）`
+                : '当天没有所有时区都在工作时间内的时段'}
+            </div>
+          )}
+        </div>
+      </div>
+
+      {plan && (
+        <div className="mt-4 max-h-96 overflow-auto border border-border-input rounded-lg">
+          <table className="w-full text-xs font-mono">
+            <thead className="sticky top-0 bg-secondary">
+              <tr>
+                {plan.zones.map((zone) => (
+                  <th key={zone.zone} className="px-3 py-2 text-left font-medium text-[var(--text-primary)] border-b border-border-primary">
+                    {zone.zone}
+                    <div className="text-[var(--text-secondary)] font-normal">
+                      {zone.abbreviation} UTC{zone.offset}
+                    </div>
+                  </th>
+                ))}
+              </tr>
+            </thead>
+            <tbody>
+              {plan.slots.map((slot, index) => (
+                <tr key={slot.utc} className={overlapSlots.has(index) ? 'bg-blue-500/20' : ''}>
+                  {slot.cells.map((cell) => (
+                    <td
+                      key={cell.zone}
+                      className={`px-3 py-1 border-b border-border-primary ${
+                        cell.isWorking ? 'text-[var(--text-primary)]' : 'text-[var(--text-secondary)] opacity-60'
+                      }`}
+                    >
+                      {cell.time}{formatDayOffset(cell.dayOffset)}
+                    </td>
+                  ))}
+                </tr>
+              ))}
+            </tbody>
+          </table>
+        </div>
+      )}
+    </div>
+  )
+}
+
+export default WorldClockPanel
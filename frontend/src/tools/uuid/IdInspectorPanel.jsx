@@ -0,0 +1,151 @@
+import React, { useState, useEffect } from 'react'
+import { getWailsAPI } from '../../utils/api'
+import Select from '../../components/Select'
+
+const kindOptions = [
+  { value: 'auto', label: '自动识别' },
+  { value: 'snowflake', label: 'Snowflake' },
+  { value: 'objectid', label: 'MongoDB ObjectID' },
+  { value: 'ulid', label: 'ULID' },
+  { value: 'ksuid', label: 'KSUID' },
+  { value: 'uuid', label: 'UUID（v1/v6/v7）' },
+]
+
+// IdInspectorPanel 解析 Snowflake、ObjectID、ULID、KSUID 与时间型 UUID 的嵌入时间和组成部分
+function IdInspectorPanel({ api, onError, onCopy }) {
+  const [input, setInput] = useState('')
+  const [kind, setKind] = useState('auto')
+  const [presets, setPresets] = useState([])
+  const [preset, setPreset] = useState('twitter')
+  const [timezone, setTimezone] = useState('Asia/Shanghai')
+  const [result, setResult] = useState(null)
+
+  useEffect(() => {
+    const wailsAPI = api || getWailsAPI()
+    if (!wailsAPI?.UUID?.ListSnowflakePresets) {
+      return
+    }
+    Promise.resolve(wailsAPI.UUID.ListSnowflakePresets())
+      .then((list) => {
+        setPresets(list || [])
+        if (list?.length && !list.some((item) => item.name === 'twitter')) {
+          setPreset(list[0].name)
+        }
+      })
+      .catch((err) => console.error('Failed to load snowflake presets:', err))
+  }, [api])
+
+  const handleInspect = async () => {
+    try {
+      onError('')
+      const wailsAPI = api || getWailsAPI()
+      if (!wailsAPI?.UUID) {
+        onError('后端 API 未加载，请稍候重试')
+        return
+      }
+      const opts = { kind, snowflake: { name: preset } }
+      const inspection = await wailsAPI.UUID.InspectID(input.trim(), opts, 'DateTime', timezone)
+      setResult(inspection)
+    } catch (err) {
+      setResult(null)
+      onError(err.message || '解析失败')
+    }
+  }
+
+  const presetOptions = presets.map((item) => ({
+    value: item.name,
+    label: `${item.name}（纪元 ${item.epoch}）`,
+  }))
+
+  return (
+    <div className="bg-secondary rounded-lg shadow-sm border border-border-primary p-6">
+      <h3 className="text-lg font-semibold text-[var(--text-primary)] mb-4 select-none">ID 解析</h3>
+      <div className="space-y-4">
+        <div className="grid grid-cols-3 gap-4">
+          <div className="min-w-0">
+            <label className="block text-sm font-medium text-[var(--text-primary)] mb-2 select-none">类型</label>
+            <Select value={kind} onChange={setKind} options={kindOptions} className="w-full" />
+          </div>
+          <div className="min-w-0">
+            <label className="block text-sm font-medium text-[var(--text-primary)] mb-2 select-none">Snowflake 布局</label>
+            <Select
+              value={preset}
+              onChange={setPreset}
+              options={presetOptions.length > 0 ? presetOptions : [{ value: 'twitter', label: 'twitter' }]}
+              className={`w-full ${kind === 'auto' || kind === 'snowflake' ? '' : 'opacity-50 pointer-events-none'}`}
+            />
+          </div>
+          <div className="min-w-0">
+            <label className="block text-sm font-medium text-[var(--text-primary)] mb-2 select-none">时区</label>
+            <input
+              type="text"
+              value={timezone}
+              onChange={(e) => setTimezone(e.target.value)}
+              className="w-full p-2 text-sm border border-border-input rounded-lg font-mono text-[var(--text-input)] bg-input focus:outline-none focus:ring-2 focus:ring-blue-500"
+              spellCheck="false"
+            />
+          </div>
+        </div>
+        <div className="flex space-x-2">
+          <input
+            type="text"
+            value={input}
+            onChange={(e) => setInput(e.target.value)}
+            onKeyDown={(e) => e.key === 'Enter' && handleInspect()}
+            className="flex-1 p-3 text-sm border border-border-input rounded-lg font-mono text-[var(--text-input)] bg-input focus:outline-none focus:ring-2 focus:ring-blue-500"
+            placeholder="输入 Snowflake、ObjectID、ULID、KSUID 或 UUID"
+            spellCheck="false"
+          />
+          <button
+            onClick={handleInspect}
+            disabled={!input.trim()}
+            className="px-4 py-2 bg-blue-500 text-white rounded-lg hover:bg-blue-600 transition-colors text-sm font-medium select-none disabled:opacity-50 disabled:cursor-not-allowed"
+          >
+            解析
+          </button>
+        </div>
+        {result && (
+          <div className="space-y-3">
+            <div className="grid grid-cols-2 gap-2 text-sm">
+              <div className="p-3 bg-input-disabled border border-border-input rounded-lg text-[var(--text-input)]">
+                类型：{result.kind}{result.layout ? `（${result.layout}）` : ''}
+              </div>
+              <div
+                className="p-3 bg-input-disabled border border-border-input rounded-lg font-mono text-[var(--text-input)] break-all cursor-pointer"
+                onClick={() => onCopy(result.normalized)}
+                title="点击复制"
+              >
+                {result.normalized}
+              </div>
+            </div>
+            {result.hasTime && (
+              <div className="p-3 bg-input-disabled border border-border-input rounded-lg font-mono text-sm text-[var(--text-input)] space-y-1">
+                <div>时间：{result.time}</div>
+                <div>UTC：{result.utc}</div>
+                <div>毫秒时间戳：{result.unixMilli}</div>
+              </div>
+            )}
+            {(result.components || []).length > 0 && (
+              <div className="border border-border-input rounded-lg">
+                {result.components.map((component) => (
+                  <div
+                    key={component.name}
+                    className="grid grid-cols-[10rem_1fr_4rem] gap-2 px-3 py-2 border-b border-border-primary last:border-b-0 text-sm"
+                  >
+                    <span className="text-[var(--text-secondary)]">{component.name}</span>
+                    <span className="font-mono text-[var(--text-input)] break-all">{component.value}</span>
+                    <span className="text-xs text-[var(--text-secondary)] text-right">
+                      {component.bits ? `${component.bits} 位` : ''}
+                    </span>
+                  </div>
+                ))}
+              </div>
+            )}
+          </div>
+        )}
+      </div>
+    </div>
+  )
+}
+
+export default IdInspectorPanel
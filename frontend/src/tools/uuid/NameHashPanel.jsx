@@ -0,0 +1,131 @@
+import React, { useState, useEffect } from 'react'
+import { getWailsAPI } from '../../utils/api'
+import Select from '../../components/Select'
+
+const versionOptions = [
+  { value: 'v5', label: 'v5（SHA-1）' },
+  { value: 'v3', label: 'v3（MD5）' },
+]
+
+const exportOptions = [
+  { value: 'csv', label: 'CSV' },
+  { value: 'json', label: 'JSON' },
+]
+
+// NameHashPanel 按名称批量生成确定的 v3/v5 UUID，并导出为 CSV 或 JSON
+function NameHashPanel({ api, onError, onCopy }) {
+  const [version, setVersion] = useState('v5')
+  const [namespaces, setNamespaces] = useState([])
+  const [namespaceChoice, setNamespaceChoice] = useState('dns')
+  const [customNamespace, setCustomNamespace] = useState('')
+  const [names, setNames] = useState('')
+  const [exportFormat, setExportFormat] = useState('csv')
+  const [result, setResult] = useState(null)
+
+  useEffect(() => {
+    const wailsAPI = api || getWailsAPI()
+    if (!wailsAPI?.UUID?.ListNamespaces) {
+      return
+    }
+    Promise.resolve(wailsAPI.UUID.ListNamespaces())
+      .then((list) => setNamespaces(list || []))
+      .catch((err) => console.error('Failed to load namespaces:', err))
+  }, [api])
+
+  const handleHash = async () => {
+    try {
+      onError('')
+      const wailsAPI = api || getWailsAPI()
+      if (!wailsAPI?.UUID) {
+        onError('后端 API 未加载，请稍候重试')
+        return
+      }
+      const hashed = await wailsAPI.UUID.HashNames(version, namespace, names, exportFormat)
+      setResult(hashed)
+    } catch (err) {
+      setResult(null)
+      onError(err.message || '生成失败')
+    }
+  }
+
+  const isCustom = namespaceChoice === 'custom'
+  const namespace = isCustom ? customNamespace.trim() : namespaceChoice
+  const namespaceOptions = [
+    ...namespaces.map((item) => ({ value: item.name, label: `${item.name.toUpperCase()}（${item.uuid}）` })),
+    { value: 'custom', label: '自定义 UUID' },
+  ]
+
+  return (
+    <div className="bg-secondary rounded-lg shadow-sm border border-border-primary p-6">
+      <h3 className="text-lg font-semibold text-[var(--text-primary)] mb-4 select-none">按名称批量生成</h3>
+      <div className="space-y-4">
+        <div className="grid grid-cols-3 gap-4">
+          <div className="min-w-0">
+            <label className="block text-sm font-medium text-[var(--text-primary)] mb-2 select-none">版本</label>
+            <Select value={version} onChange={setVersion} options={versionOptions} className="w-full" />
+          </div>
+          <div className="min-w-0">
+            <label className="block text-sm font-medium text-[var(--text-primary)] mb-2 select-none">命名空间</label>
+            <Select value={namespaceChoice} onChange={setNamespaceChoice} options={namespaceOptions} className="w-full" />
+          </div>
+          <div className="min-w-0">
+            <label className="block text-sm font-medium text-[var(--text-primary)] mb-2 select-none">导出格式</label>
+            <Select value={exportFormat} onChange={setExportFormat} options={exportOptions} className="w-full" />
+          </div>
+        </div>
+        {isCustom && (
+          <input
+            type="text"
+            value={customNamespace}
+            onChange={(e) => setCustomNamespace(e.target.value)}
+            placeholder="输入命名空间 UUID"
+            className="w-full p-3 border border-border-input rounded-lg font-mono text-sm text-[var(--text-input)] bg-input focus:outline-none focus:ring-2 focus:ring-blue-500"
+            spellCheck="false"
+          />
+        )}
+        <div className="grid grid-cols-2 gap-4">
+          <div className="min-w-0">
+            <label className="block text-sm font-medium text-[var(--text-primary)] mb-2 select-none">名称（每行一个）</label>
+            <textarea
+              value={names}
+              onChange={(e) => setNames(e.target.value)}
+              className="w-full h-48 p-3 text-sm border border-border-input rounded-lg font-mono text-[var(--text-input)] bg-input resize-none focus:outline-none focus:ring-2 focus:ring-blue-500"
+              placeholder={'example.com\nexample.org'}
+              spellCheck="false"
+            />
+          </div>
+          <div className="min-w-0">
+            <div className="flex items-center justify-between mb-2">
+              <label className="block text-sm font-medium text-[var(--text-primary)] select-none">
+                导出结果{result ? `（${result.items?.length || 0} 条）` : ''}
+              </label>
+              {result?.output && (
+                <button
+                  onClick={() => onCopy(result.output)}
+                  className="px-2 py-1 text-xs bg-button-secondary text-button-secondary-text rounded hover:bg-[var(--button-secondary-hover)] transition-colors select-none"
+                >
+                  复制
+                </button>
+              )}
+            </div>
+            <textarea
+              value={result?.output || ''}
+              readOnly
+              className="w-full h-48 p-3 text-sm border border-border-input rounded-lg font-mono text-[var(--text-input)] bg-input-disabled resize-none focus:outline-none"
+              spellCheck="false"
+            />
+          </div>
+        </div>
+        <button
+          onClick={handleHash}
+          disabled={!names.trim() || !namespace}
+          className="w-full px-4 py-2 bg-blue-500 text-white rounded-lg hover:bg-blue-600 transition-colors text-sm font-medium select-none disabled:opacity-50 disabled:cursor-not-allowed"
+        >
+          批量生成
+        </button>
+      </div>
+    </div>
+  )
+}
+
+export default NameHashPanel
@@ -0,0 +1,129 @@
+import React, { useState } from 'react'
+import { getWailsAPI } from '../../utils/api'
+import Select from '../../components/Select'
+
+const byteOrderOptions = [
+  { value: 'rfc', label: 'RFC 9562（大端）' },
+  { value: 'guid', label: 'Microsoft GUID（混合字节序）' },
+]
+
+// UuidParserPanel 规范化并解析各种写法的 UUID，展示版本、变体、时间戳与常用写法
+function UuidParserPanel({ api, onError, onCopy }) {
+  const [input, setInput] = useState('')
+  const [byteOrder, setByteOrder] = useState('rfc')
+  const [timezone, setTimezone] = useState('Asia/Shanghai')
+  const [result, setResult] = useState(null)
+
+  const handleParse = async () => {
+    try {
+      onError('')
+      const wailsAPI = api || getWailsAPI()
+      if (!wailsAPI?.UUID) {
+        onError('后端 API 未加载，请稍候重试')
+        return
+      }
+      const parsed = await wailsAPI.UUID.ParseUUID(input.trim(), byteOrder, 'DateTime', timezone)
+      setResult(parsed)
+    } catch (err) {
+      setResult(null)
+      onError(err.message || '解析失败')
+    }
+  }
+
+  const notations = result
+    ? [
+        { label: '标准', value: result.canonical },
+        { label: '大写', value: result.uppercase },
+        { label: '花括号', value: result.braces },
+        { label: 'URN', value: result.urn },
+        { label: '十六进制', value: result.hex },
+        { label: 'Base64', value: result.base64 },
+        { label: 'Base64 URL', value: result.base64Url },
+        { label: '字节数组', value: result.bytes },
+        { label: 'GUID 字节', value: result.guidBytes },
+        { label: 'GUID Base64', value: result.guidBase64 },
+      ]
+    : []
+
+  return (
+    <div className="bg-secondary rounded-lg shadow-sm border border-border-primary p-6">
+      <h3 className="text-lg font-semibold text-[var(--text-primary)] mb-4 select-none">UUID 解析</h3>
+      <div className="space-y-4">
+        <div className="grid grid-cols-2 gap-4">
+          <div className="min-w-0">
+            <label className="block text-sm font-medium text-[var(--text-primary)] mb-2 select-none">字节序（Base64 / 字节数组输入）</label>
+            <Select value={byteOrder} onChange={setByteOrder} options={byteOrderOptions} className="w-full" />
+          </div>
+          <div className="min-w-0">
+            <label className="block text-sm font-medium text-[var(--text-primary)] mb-2 select-none">时区</label>
+            <input
+              type="text"
+              value={timezone}
+              onChange={(e) => setTimezone(e.target.value)}
+              className="w-full p-2 text-sm border border-border-input rounded-lg font-mono text-[var(--text-input)] bg-input focus:outline-none focus:ring-2 focus:ring-blue-500"
+              spellCheck="false"
+            />
+          </div>
+        </div>
+        <div className="flex space-x-2">
+          <input
+            type="text"
+            value={input}
+            onChange={(e) => setInput(e.target.value)}
+            onKeyDown={(e) => e.key === 'Enter' && handleParse()}
+            className="flex-1 p-3 text-sm border border-border-input rounded-lg font-mono text-[var(--text-input)] bg-input focus:outline-none focus:ring-2 focus:ring-blue-500"
+            placeholder="支持花括号、URN、大写、无连字符、Base64 与字节数组写法"
+            spellCheck="false"
+          />
+          <button
+            onClick={handleParse}
+            disabled={!input.trim()}
+            className="px-4 py-2 bg-blue-500 text-white rounded-lg hover:bg-blue-600 transition-colors text-sm font-medium select-none disabled:opacity-50 disabled:cursor-not-allowed"
+          >
+            解析
+          </button>
+        </div>
+        {result && (
+          <div className="space-y-3">
+            <div className="p-3 bg-input-disabled border border-border-input rounded-lg text-sm text-[var(--text-input)] space-y-1">
+              <div>输入形式：{result.format} ｜ 字节序：{result.byteOrder}</div>
+              <div>版本：v{result.version}（{result.versionName}） ｜ 变体：{result.variant}</div>
+              {result.hasTime && (
+                <div className="font-mono">时间：{result.time} ｜ UTC：{result.utc} ｜ 毫秒：{result.unixMilli}</div>
+              )}
+              {result.clockSequence >= 0 && (
+                <div className="font-mono">
+                  时钟序列：{result.clockSequence} ｜ 节点：{result.node}{result.multicast ? '（随机节点）' : ''}
+                </div>
+              )}
+            </div>
+            {(result.warnings || []).map((warning) => (
+              <div key={warning} className="p-2 rounded-lg bg-error-bg text-error-text text-xs select-none">
+                {warning}
+              </div>
+            ))}
+            <div className="border border-border-input rounded-lg">
+              {notations.map((item) => (
+                <div
+                  key={item.label}
+                  className="grid grid-cols-[7rem_1fr_auto] gap-2 items-center px-3 py-2 border-b border-border-primary last:border-b-0 text-sm"
+                >
+                  <span className="text-[var(--text-secondary)] select-none">{item.label}</span>
+                  <span className="font-mono text-[var(--text-input)] break-all">{item.value}</span>
+                  <button
+                    onClick={() => onCopy(item.value)}
+                    className="px-2 py-1 text-xs bg-button-secondary text-button-secondary-text rounded hover:bg-[var(--button-secondary-hover)] transition-colors select-none"
+                  >
+                    复制
+                  </button>
+                </div>
+              ))}
+            </div>
+          </div>
+        )}
+      </div>
+    </div>
+  )
+}
+
+export default UuidParserPanel
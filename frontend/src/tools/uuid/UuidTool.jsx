@@ -6,6 +6,9 @@ import Select from '../../components/Select'
 import ToolHistoryDrawer from '../../components/ToolHistoryDrawer'
 import { addUUIDHistoryItem, loadUUIDHistory, MAX_UUID_HISTORY_ITEMS } from './uuidHistoryStorage'
 import { createHistoryId, truncateText } from '../../utils/toolHistoryStorage'
+import IdInspectorPanel from './IdInspectorPanel'
+import UuidParserPanel from './UuidParserPanel'
+import NameHashPanel from './NameHashPanel'
 
 function UuidTool({ onShowHelp }) {
   const [version, setVersion] = useState('v4')
@@ -14,7 +17,14 @@ function UuidTool({ onShowHelp }) {
   const [name, setName] = useState('')
   const [formatCase, setFormatCase] = useState('lowercase') // 'lowercase' | 'uppercase'
   const [formatHyphens, setFormatHyphens] = useState(true) // true = 带连字符, false = 无连字符
+  const [formatWrap, setFormatWrap] = useState('none') // 'none' | 'braces' | 'urn'
+  const [formatQuote, setFormatQuote] = useState('')
+  const [formatList, setFormatList] = useState('lines') // 'lines' | 'json' | 'sql'
+  const [payload, setPayload] = useState('')
+  const [nanoAlphabet, setNanoAlphabet] = useState('')
+  const [nanoLength, setNanoLength] = useState(21)
   const [results, setResults] = useState([])
+  const [outputText, setOutputText] = useState('')
   const [api, setApi] = useState(null)
   const [error, setError] = useState('')
   const [showToast, setShowToast] = useState(false)
@@ -85,52 +95,38 @@ function UuidTool({ onShowHelp }) {
         return
       }
 
-      // 验证 namespace 格式（如果提供）
-      if (namespace && !isValidUUID(namespace)) {
-        setError('namespace 必须是有效的 UUID 格式')
-        return
-      }
-
-      let generatedResults = []
-      if (count === 1) {
-        // 单个生成
-        let result
-        switch (version) {
-          case 'v1':
-            result = await wailsAPI.UUID.GenerateV1()
-            break
-          case 'v3':
-            result = await wailsAPI.UUID.GenerateV3(namespace, name)
-            break
-          case 'v4':
-            result = await wailsAPI.UUID.GenerateV4()
-            break
-          case 'v7':
-            result = await wailsAPI.UUID.GenerateV7()
-            break
-          case 'v5':
-            result = await wailsAPI.UUID.GenerateV5(namespace, name)
-            break
-          default:
-            setError('不支持的 UUID 版本')
-            return
+      const result = await wailsAPI.UUID.GenerateWithOptions(
+        {
+          version,
+          count,
+          namespace,
+          name,
+          payload,
+          alphabet: nanoAlphabet,
+          length: Number(nanoLength) || 0,
+        },
+        {
+          uppercase: formatCase === 'uppercase',
+          noDashes: !formatHyphens,
+          braces: formatWrap === 'braces',
+          urn: formatWrap === 'urn',
+          quote: formatQuote,
+          list: formatList,
         }
-        generatedResults = [result]
-      } else {
-        // 批量生成
-        generatedResults = await wailsAPI.UUID.GenerateBatch(version, count, namespace || '', name || '')
-      }
-
-      // 应用格式化
-      const formattedResults = generatedResults.map(uuid => formatUUID(uuid, formatCase, formatHyphens))
+      )
+      const output = result?.output || ''
+      // 每行一个时逐条展示，JSON 与 SQL 列表整体展示
+      const formattedResults = formatList === 'lines' ? output.split('\n').filter(Boolean) : []
       setResults(formattedResults)
+      setOutputText(output)
+      const historyIds = formattedResults.length > 0 ? formattedResults : result?.ids || []
       const outputMap = {}
-      formattedResults.forEach((uuid, index) => {
+      historyIds.forEach((uuid, index) => {
         outputMap[`uuid_${index + 1}`] = uuid
       })
       const { success, items } = await addUUIDHistoryItem({
         id: createHistoryId(),
-        action: `生成${version.toUpperCase()}（${count}条）`,
+        action: `生成${version.toUpperCase()}（${historyIds.length}条）`,
         createdAt: Date.now(),
         input: {
           version,
@@ -139,6 +135,7 @@ function UuidTool({ onShowHelp }) {
           name: truncateText(name),
           case: formatCase,
           hyphens: formatHyphens ? 'with' : 'without',
+          list: formatList,
         },
         output: outputMap,
       })
@@ -150,30 +147,6 @@ function UuidTool({ onShowHelp }) {
     }
   }
 
-  const isValidUUID = (str) => {
-    const uuidRegex = /^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$/i
-    return uuidRegex.test(str)
-  }
-
-  // 格式化 UUID
-  const formatUUID = (uuid, caseFormat, includeHyphens) => {
-    let formatted = uuid
-    
-    // 处理大小写
-    if (caseFormat === 'uppercase') {
-      formatted = formatted.toUpperCase()
-    } else {
-      formatted = formatted.toLowerCase()
-    }
-    
-    // 处理连字符
-    if (!includeHyphens) {
-      formatted = formatted.replace(/-/g, '')
-    }
-    
-    return formatted
-  }
-
   const handleCopy = async (text) => {
     try {
       await navigator.clipboard.writeText(text)
@@ -185,8 +158,7 @@ function UuidTool({ onShowHelp }) {
 
   const handleCopyAll = async () => {
     try {
-      const allText = results.join('\n')
-      await navigator.clipboard.writeText(allText)
+      await navigator.clipboard.writeText(outputText)
       setShowToast(true)
     } catch (err) {
       setError('复制失败')
@@ -195,9 +167,17 @@ function UuidTool({ onShowHelp }) {
 
   const handleClear = () => {
     setResults([])
+    setOutputText('')
     setError('')
   }
 
+  const versionLabels = {
+    nil: 'Nil',
+    max: 'Max',
+    ulid: 'ULID',
+    nanoid: 'NanoID',
+  }
+
   const predefinedNamespaces = [
     { label: 'DNS', value: '6ba7b810-9dad-11d1-80b4-00c04fd430c8' },
     { label: 'URL', value: '6ba7b811-9dad-11d1-80b4-00c04fd430c8' },
@@ -235,7 +215,7 @@ function UuidTool({ onShowHelp }) {
           <div>
             <label className="block text-sm font-medium text-[var(--text-primary)] mb-2 select-none">UUID 版本</label>
             <div className="flex flex-wrap gap-2">
-              {['v1', 'v3', 'v4', 'v5', 'v7'].map((v) => (
+              {['v1', 'v3', 'v4', 'v5', 'v6', 'v7', 'v8', 'nil', 'max', 'ulid', 'nanoid'].map((v) => (
                 <button
                   key={v}
                   onClick={() => setVersion(v)}
@@ -245,7 +225,7 @@ function UuidTool({ onShowHelp }) {
                       : 'bg-button-secondary text-button-secondary-text hover:bg-[var(--button-secondary-hover)]'
                   }`}
                 >
-                  {v.toUpperCase()}
+                  {versionLabels[v] || v.toUpperCase()}
                 </button>
               ))}
             </div>
@@ -254,7 +234,13 @@ function UuidTool({ onShowHelp }) {
               {version === 'v3' && '基于命名空间和名称的 MD5（确定性：相同输入生成相同 UUID）'}
               {version === 'v4' && '随机生成（推荐）'}
               {version === 'v5' && '基于命名空间和名称的 SHA-1（确定性：相同输入生成相同 UUID）'}
+              {version === 'v6' && '字段重排的 v1，按时间排序'}
               {version === 'v7' && 'Unix 毫秒时间序 + 随机（大致按生成时间排序，适合作为主键）'}
+              {version === 'v8' && '自定义内容：留空随机，32 位十六进制按原样使用，其他文本取 SHA-256 摘要'}
+              {version === 'nil' && '全 0 的 Nil UUID'}
+              {version === 'max' && '全 F 的 Max UUID'}
+              {version === 'ulid' && '26 位 Crockford Base32，按毫秒时间排序'}
+              {version === 'nanoid' && '短随机 ID，默认 21 位 URL 安全字母表'}
             </p>
           </div>
 
@@ -321,8 +307,91 @@ function UuidTool({ onShowHelp }) {
                 </div>
               </div>
             </div>
+            <div className="grid grid-cols-3 gap-4 mt-3">
+              <div className="min-w-0">
+                <span className="block text-sm text-[var(--text-secondary)] mb-1 select-none">包裹：</span>
+                <Select
+                  value={formatWrap}
+                  onChange={setFormatWrap}
+                  options={[
+                    { value: 'none', label: '无' },
+                    { value: 'braces', label: '花括号 {…}' },
+                    { value: 'urn', label: 'URN（urn:uuid:）' },
+                  ]}
+                  className="w-full"
+                />
+              </div>
+              <div className="min-w-0">
+                <span className="block text-sm text-[var(--text-secondary)] mb-1 select-none">引号：</span>
+                <Select
+                  value={formatQuote}
+                  onChange={setFormatQuote}
+                  options={[
+                    { value: '', label: '无' },
+                    { value: "'", label: "单引号 '" },
+                    { value: '"', label: '双引号 "' },
+                  ]}
+                  className="w-full"
+                />
+              </div>
+              <div className="min-w-0">
+                <span className="block text-sm text-[var(--text-secondary)] mb-1 select-none">列表形式：</span>
+                <Select
+                  value={formatList}
+                  onChange={setFormatList}
+                  options={[
+                    { value: 'lines', label: '每行一个' },
+                    { value: 'json', label: 'JSON 数组' },
+                    { value: 'sql', label: 'SQL IN (...)' },
+                  ]}
+                  className="w-full"
+                />
+              </div>
+            </div>
           </div>
 
+          {/* v8 的自定义内容 */}
+          {version === 'v8' && (
+            <div>
+              <label className="block text-sm font-medium text-[var(--text-primary)] mb-2 select-none">自定义内容</label>
+              <input
+                type="text"
+                value={payload}
+                onChange={(e) => setPayload(e.target.value)}
+                placeholder="留空随机生成；32 位十六进制按原样使用；其他文本取 SHA-256 摘要"
+                className="w-full p-3 border border-border-input rounded-lg font-mono text-sm text-[var(--text-input)] bg-input focus:outline-none focus:ring-2 focus:ring-blue-500"
+                spellCheck="false"
+              />
+            </div>
+          )}
+
+          {/* NanoID 的字母表和长度 */}
+          {version === 'nanoid' && (
+            <div className="grid grid-cols-3 gap-4">
+              <div className="col-span-2">
+                <label className="block text-sm font-medium text-[var(--text-primary)] mb-2 select-none">字母表</label>
+                <input
+                  type="text"
+                  value={nanoAlphabet}
+                  onChange={(e) => setNanoAlphabet(e.target.value)}
+                  placeholder="留空使用 URL 安全字母表（A-Za-z0-9_-）"
+                  className="w-full p-3 border border-border-input rounded-lg font-mono text-sm text-[var(--text-input)] bg-input focus:outline-none focus:ring-2 focus:ring-blue-500"
+                  spellCheck="false"
+                />
+              </div>
+              <div>
+                <label className="block text-sm font-medium text-[var(--text-primary)] mb-2 select-none">长度</label>
+                <input
+                  type="number"
+                  min="1"
+                  value={nanoLength}
+                  onChange={(e) => setNanoLength(e.target.value)}
+                  className="w-full p-3 border border-border-input rounded-lg text-sm text-[var(--text-input)] bg-input focus:outline-none focus:ring-2 focus:ring-blue-500"
+                />
+              </div>
+            </div>
+          )}
+
           {/* v3/v5 的 namespace 和 name */}
           {(version === 'v3' || version === 'v5') && (
             <>
@@ -369,23 +438,24 @@ function UuidTool({ onShowHelp }) {
             onClick={handleGenerate}
             className="w-full px-4 py-2 bg-blue-500 text-white rounded-lg hover:bg-blue-600 transition-colors text-sm font-medium select-none"
           >
-            生成 UUID
+            生成
           </button>
         </div>
-        {error && (
-          <div className="mt-4 p-3 rounded-lg bg-error-bg text-error-text text-sm select-none">
-            {error}
-          </div>
-        )}
       </div>
 
+      {error && (
+        <div className="p-3 rounded-lg bg-error-bg text-error-text text-sm select-none">
+          {error}
+        </div>
+      )}
+
       {/* 结果显示 */}
-      {results.length > 0 && (
+      {outputText && (
         <div className="bg-secondary rounded-lg shadow-sm border border-border-primary p-6">
           <div className="flex items-center justify-between mb-4">
             <h3 className="text-lg font-semibold text-[var(--text-primary)] select-none">生成结果</h3>
             <div className="flex items-center space-x-2">
-              {results.length > 1 && (
+              {(results.length > 1 || formatList !== 'lines') && (
                 <button
                   onClick={handleCopyAll}
                   className="px-3 py-1.5 bg-button-secondary text-button-secondary-text rounded-lg hover:bg-[var(--button-secondary-hover)] transition-colors text-sm select-none"
@@ -404,6 +474,11 @@ function UuidTool({ onShowHelp }) {
               </button>
             </div>
           </div>
+          {formatList !== 'lines' && (
+            <pre className="p-3 bg-input-disabled border border-border-input rounded-lg font-mono text-sm text-[var(--text-input)] whitespace-pre-wrap break-all">
+              {outputText}
+            </pre>
+          )}
           <div className="space-y-2">
             {results.map((uuid, index) => (
               <div
@@ -425,6 +500,12 @@ function UuidTool({ onShowHelp }) {
           </div>
         </div>
       )}
+      <NameHashPanel api={api} onError={setError} onCopy={handleCopy} />
+
+      <UuidParserPanel api={api} onError={setError} onCopy={handleCopy} />
+
+      <IdInspectorPanel api={api} onError={setError} onCopy={handleCopy} />
+
       <Toast
         message="已复制到剪贴板"
         show={showToast}
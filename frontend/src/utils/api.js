@@ -19,6 +19,8 @@ export function waitForWailsAPI(timeout = 5000) {
       const translateHandler = window.go?.handlers?.TranslateHandler
       const hashHandler = window.go?.handlers?.HashHandler
       const randomStringHandler = window.go?.handlers?.RandomStringHandler
+      const hexHandler = window.go?.handlers?.HexHandler
+      const cronHandler = window.go?.handlers?.CronHandler
       
       if (appAPI) {
         const result = {
@@ -37,6 +39,26 @@ export function waitForWailsAPI(timeout = 5000) {
             DecodeURLSafe: base64Handler.DecodeURLSafe?.bind(base64Handler),
             Validate: base64Handler.Validate?.bind(base64Handler),
             ValidateURLSafe: base64Handler.ValidateURLSafe?.bind(base64Handler),
+            ListVariants: base64Handler.ListVariants?.bind(base64Handler),
+            EncodeVariant: base64Handler.EncodeVariant?.bind(base64Handler),
+            DecodeVariant: base64Handler.DecodeVariant?.bind(base64Handler),
+            DetectVariant: base64Handler.DetectVariant?.bind(base64Handler),
+            EncodeVariantWithAlphabet: base64Handler.EncodeVariantWithAlphabet?.bind(base64Handler),
+            DecodeVariantWithAlphabet: base64Handler.DecodeVariantWithAlphabet?.bind(base64Handler),
+            DetectVariantWithAlphabet: base64Handler.DetectVariantWithAlphabet?.bind(base64Handler),
+            ValidateAlphabet: base64Handler.ValidateAlphabet?.bind(base64Handler),
+            DecodeBinary: base64Handler.DecodeBinary?.bind(base64Handler),
+            OpenFileDialog: base64Handler.OpenFileDialog?.bind(base64Handler),
+            EncodeFile: base64Handler.EncodeFile?.bind(base64Handler),
+            DecodeToFileDialog: base64Handler.DecodeToFileDialog?.bind(base64Handler),
+            BuildDataURI: base64Handler.BuildDataURI?.bind(base64Handler),
+            BuildDataURIFromFile: base64Handler.BuildDataURIFromFile?.bind(base64Handler),
+            ParseDataURI: base64Handler.ParseDataURI?.bind(base64Handler),
+            SaveDataURIDialog: base64Handler.SaveDataURIDialog?.bind(base64Handler),
+            Compress: base64Handler.Compress?.bind(base64Handler),
+            Decompress: base64Handler.Decompress?.bind(base64Handler),
+            DecompressFile: base64Handler.DecompressFile?.bind(base64Handler),
+            CompressToFileDialog: base64Handler.CompressToFileDialog?.bind(base64Handler),
             ListHistory: base64Handler.ListHistory?.bind(base64Handler),
             AddHistory: base64Handler.AddHistory?.bind(base64Handler),
             ClearHistory: base64Handler.ClearHistory?.bind(base64Handler),
@@ -49,6 +71,23 @@ export function waitForWailsAPI(timeout = 5000) {
             FormatNow: timestampHandler.FormatNow?.bind(timestampHandler),
             GetCurrentTimestamp: timestampHandler.GetCurrentTimestamp?.bind(timestampHandler),
             GetCurrentTimestampMilli: timestampHandler.GetCurrentTimestampMilli?.bind(timestampHandler),
+            ConvertTimestamp: timestampHandler.ConvertTimestamp?.bind(timestampHandler),
+            TimeStringToTimestamps: timestampHandler.TimeStringToTimestamps?.bind(timestampHandler),
+            SmartParse: timestampHandler.SmartParse?.bind(timestampHandler),
+            WorldClock: timestampHandler.WorldClock?.bind(timestampHandler),
+            MeetingPlanner: timestampHandler.MeetingPlanner?.bind(timestampHandler),
+            SuggestTimezones: timestampHandler.SuggestTimezones?.bind(timestampHandler),
+            ListTimezones: timestampHandler.ListTimezones?.bind(timestampHandler),
+            AddPeriod: timestampHandler.AddPeriod?.bind(timestampHandler),
+            Difference: timestampHandler.Difference?.bind(timestampHandler),
+            BusinessDays: timestampHandler.BusinessDays?.bind(timestampHandler),
+            AddBusinessDays: timestampHandler.AddBusinessDays?.bind(timestampHandler),
+            ParseDuration: timestampHandler.ParseDuration?.bind(timestampHandler),
+            TranslateLayout: timestampHandler.TranslateLayout?.bind(timestampHandler),
+            ListFormats: timestampHandler.ListFormats?.bind(timestampHandler),
+            SaveFormat: timestampHandler.SaveFormat?.bind(timestampHandler),
+            DeleteFormat: timestampHandler.DeleteFormat?.bind(timestampHandler),
+            BatchConvert: timestampHandler.BatchConvert?.bind(timestampHandler),
             ListTimestampHistory: timestampHandler.ListTimestampHistory?.bind(timestampHandler),
             AddTimestampHistory: timestampHandler.AddTimestampHistory?.bind(timestampHandler),
             ClearTimestampHistory: timestampHandler.ClearTimestampHistory?.bind(timestampHandler),
@@ -60,6 +99,16 @@ export function waitForWailsAPI(timeout = 5000) {
             GenerateV5: uuidHandler.GenerateV5?.bind(uuidHandler),
             GenerateV7: uuidHandler.GenerateV7?.bind(uuidHandler),
             GenerateBatch: uuidHandler.GenerateBatch?.bind(uuidHandler),
+            ParseUUID: uuidHandler.ParseUUID?.bind(uuidHandler),
+            GenerateV6: uuidHandler.GenerateV6?.bind(uuidHandler),
+            GenerateV8: uuidHandler.GenerateV8?.bind(uuidHandler),
+            GenerateULID: uuidHandler.GenerateULID?.bind(uuidHandler),
+            GenerateNanoID: uuidHandler.GenerateNanoID?.bind(uuidHandler),
+            GenerateWithOptions: uuidHandler.GenerateWithOptions?.bind(uuidHandler),
+            ListNamespaces: uuidHandler.ListNamespaces?.bind(uuidHandler),
+            HashNames: uuidHandler.HashNames?.bind(uuidHandler),
+            InspectID: uuidHandler.InspectID?.bind(uuidHandler),
+            ListSnowflakePresets: uuidHandler.ListSnowflakePresets?.bind(uuidHandler),
             ListHistory: uuidHandler.ListHistory?.bind(uuidHandler),
             AddHistory: uuidHandler.AddHistory?.bind(uuidHandler),
             ClearHistory: uuidHandler.ClearHistory?.bind(uuidHandler),
@@ -67,6 +116,20 @@ export function waitForWailsAPI(timeout = 5000) {
           URL: urlHandler ? {
             Encode: urlHandler.Encode?.bind(urlHandler),
             Decode: urlHandler.Decode?.bind(urlHandler),
+            Parse: urlHandler.Parse?.bind(urlHandler),
+            Build: urlHandler.Build?.bind(urlHandler),
+            ListModes: urlHandler.ListModes?.bind(urlHandler),
+            EncodeMode: urlHandler.EncodeMode?.bind(urlHandler),
+            DecodeMode: urlHandler.DecodeMode?.bind(urlHandler),
+            DecodeRecursive: urlHandler.DecodeRecursive?.bind(urlHandler),
+            ListConventions: urlHandler.ListConventions?.bind(urlHandler),
+            QueryToJSON: urlHandler.QueryToJSON?.bind(urlHandler),
+            JSONToQuery: urlHandler.JSONToQuery?.bind(urlHandler),
+            ConvertIDN: urlHandler.ConvertIDN?.bind(urlHandler),
+            AnalyzeScripts: urlHandler.AnalyzeScripts?.bind(urlHandler),
+            Normalize: urlHandler.Normalize?.bind(urlHandler),
+            ParseCurl: urlHandler.ParseCurl?.bind(urlHandler),
+            ConvertCurl: urlHandler.ConvertCurl?.bind(urlHandler),
             ListHistory: urlHandler.ListHistory?.bind(urlHandler),
             AddHistory: urlHandler.AddHistory?.bind(urlHandler),
             ClearHistory: urlHandler.ClearHistory?.bind(urlHandler),
@@ -100,6 +163,14 @@ export function waitForWailsAPI(timeout = 5000) {
             HashText: hashHandler.HashText?.bind(hashHandler),
             HashFile: hashHandler.HashFile?.bind(hashHandler),
             OpenFileDialog: hashHandler.OpenFileDialog?.bind(hashHandler),
+            OpenDirectoryDialog: hashHandler.OpenDirectoryDialog?.bind(hashHandler),
+            HashDirectory: hashHandler.HashDirectory?.bind(hashHandler),
+            VerifyManifest: hashHandler.VerifyManifest?.bind(hashHandler),
+            VerifyManifestFile: hashHandler.VerifyManifestFile?.bind(hashHandler),
+            SaveManifestDialog: hashHandler.SaveManifestDialog?.bind(hashHandler),
+            HashPassword: hashHandler.HashPassword?.bind(hashHandler),
+            VerifyPassword: hashHandler.VerifyPassword?.bind(hashHandler),
+            InspectPasswordHash: hashHandler.InspectPasswordHash?.bind(hashHandler),
             ListHistory: hashHandler.ListHistory?.bind(hashHandler),
             AddHistory: hashHandler.AddHistory?.bind(hashHandler),
             ClearHistory: hashHandler.ClearHistory?.bind(hashHandler),
@@ -107,10 +178,34 @@ export function waitForWailsAPI(timeout = 5000) {
           RandomString: randomStringHandler ? {
             Generate: randomStringHandler.Generate?.bind(randomStringHandler),
             GenerateBatch: randomStringHandler.GenerateBatch?.bind(randomStringHandler),
+            GeneratePassword: randomStringHandler.GeneratePassword?.bind(randomStringHandler),
+            GeneratePassphrase: randomStringHandler.GeneratePassphrase?.bind(randomStringHandler),
+            AnalyzeStrength: randomStringHandler.AnalyzeStrength?.bind(randomStringHandler),
             ListHistory: randomStringHandler.ListHistory?.bind(randomStringHandler),
             AddHistory: randomStringHandler.AddHistory?.bind(randomStringHandler),
             ClearHistory: randomStringHandler.ClearHistory?.bind(randomStringHandler),
           } : null,
+          Hex: hexHandler ? {
+            TextToHex: hexHandler.TextToHex?.bind(hexHandler),
+            HexToText: hexHandler.HexToText?.bind(hexHandler),
+            Reformat: hexHandler.Reformat?.bind(hexHandler),
+            DumpText: hexHandler.DumpText?.bind(hexHandler),
+            DumpHex: hexHandler.DumpHex?.bind(hexHandler),
+            OpenFileDialog: hexHandler.OpenFileDialog?.bind(hexHandler),
+            DumpFilePage: hexHandler.DumpFilePage?.bind(hexHandler),
+            FileToHex: hexHandler.FileToHex?.bind(hexHandler),
+            ListHistory: hexHandler.ListHistory?.bind(hexHandler),
+            AddHistory: hexHandler.AddHistory?.bind(hexHandler),
+            ClearHistory: hexHandler.ClearHistory?.bind(hexHandler),
+          } : null,
+          Cron: cronHandler ? {
+            Explain: cronHandler.Explain?.bind(cronHandler),
+            NextRuns: cronHandler.NextRuns?.bind(cronHandler),
+            Build: cronHandler.Build?.bind(cronHandler),
+            ListHistory: cronHandler.ListHistory?.bind(cronHandler),
+            AddHistory: cronHandler.AddHistory?.bind(cronHandler),
+            ClearHistory: cronHandler.ClearHistory?.bind(cronHandler),
+          } : null,
           GetVersion: appAPI.GetVersion?.bind(appAPI),
           GetInitialTool: appAPI.GetInitialTool?.bind(appAPI),
           ClearInitialTool: appAPI.ClearInitialTool?.bind(appAPI),
@@ -160,6 +255,8 @@ export function getWailsAPI() {
   const translateHandler = window.go?.handlers?.TranslateHandler
   const hashHandler = window.go?.handlers?.HashHandler
   const randomStringHandler = window.go?.handlers?.RandomStringHandler
+  const hexHandler = window.go?.handlers?.HexHandler
+  const cronHandler = window.go?.handlers?.CronHandler
   
   if (appAPI) {
     return {
@@ -179,6 +276,26 @@ export function getWailsAPI() {
         DecodeURLSafe: base64Handler.DecodeURLSafe?.bind(base64Handler),
         Validate: base64Handler.Validate?.bind(base64Handler),
         ValidateURLSafe: base64Handler.ValidateURLSafe?.bind(base64Handler),
+        ListVariants: base64Handler.ListVariants?.bind(base64Handler),
+        EncodeVariant: base64Handler.EncodeVariant?.bind(base64Handler),
+        DecodeVariant: base64Handler.DecodeVariant?.bind(base64Handler),
+        DetectVariant: base64Handler.DetectVariant?.bind(base64Handler),
+        EncodeVariantWithAlphabet: base64Handler.EncodeVariantWithAlphabet?.bind(base64Handler),
+        DecodeVariantWithAlphabet: base64Handler.DecodeVariantWithAlphabet?.bind(base64Handler),
+        DetectVariantWithAlphabet: base64Handler.DetectVariantWithAlphabet?.bind(base64Handler),
+        ValidateAlphabet: base64Handler.ValidateAlphabet?.bind(base64Handler),
+        DecodeBinary: base64Handler.DecodeBinary?.bind(base64Handler),
+        OpenFileDialog: base64Handler.OpenFileDialog?.bind(base64Handler),
+        EncodeFile: base64Handler.EncodeFile?.bind(base64Handler),
+        DecodeToFileDialog: base64Handler.DecodeToFileDialog?.bind(base64Handler),
+        BuildDataURI: base64Handler.BuildDataURI?.bind(base64Handler),
+        BuildDataURIFromFile: base64Handler.BuildDataURIFromFile?.bind(base64Handler),
+        ParseDataURI: base64Handler.ParseDataURI?.bind(base64Handler),
+        SaveDataURIDialog: base64Handler.SaveDataURIDialog?.bind(base64Handler),
+        Compress: base64Handler.Compress?.bind(base64Handler),
+        Decompress: base64Handler.Decompress?.bind(base64Handler),
+        DecompressFile: base64Handler.DecompressFile?.bind(base64Handler),
+        CompressToFileDialog: base64Handler.CompressToFileDialog?.bind(base64Handler),
         ListHistory: base64Handler.ListHistory?.bind(base64Handler),
         AddHistory: base64Handler.AddHistory?.bind(base64Handler),
         ClearHistory: base64Handler.ClearHistory?.bind(base64Handler),
@@ -191,6 +308,23 @@ export function getWailsAPI() {
         FormatNow: timestampHandler.FormatNow?.bind(timestampHandler),
         GetCurrentTimestamp: timestampHandler.GetCurrentTimestamp?.bind(timestampHandler),
         GetCurrentTimestampMilli: timestampHandler.GetCurrentTimestampMilli?.bind(timestampHandler),
+        ConvertTimestamp: timestampHandler.ConvertTimestamp?.bind(timestampHandler),
+        TimeStringToTimestamps: timestampHandler.TimeStringToTimestamps?.bind(timestampHandler),
+        SmartParse: timestampHandler.SmartParse?.bind(timestampHandler),
+        WorldClock: timestampHandler.WorldClock?.bind(timestampHandler),
+        MeetingPlanner: timestampHandler.MeetingPlanner?.bind(timestampHandler),
+        SuggestTimezones: timestampHandler.SuggestTimezones?.bind(timestampHandler),
+        ListTimezones: timestampHandler.ListTimezones?.bind(timestampHandler),
+        AddPeriod: timestampHandler.AddPeriod?.bind(timestampHandler),
+        Difference: timestampHandler.Difference?.bind(timestampHandler),
+        BusinessDays: timestampHandler.BusinessDays?.bind(timestampHandler),
+        AddBusinessDays: timestampHandler.AddBusinessDays?.bind(timestampHandler),
+        ParseDuration: timestampHandler.ParseDuration?.bind(timestampHandler),
+        TranslateLayout: timestampHandler.TranslateLayout?.bind(timestampHandler),
+        ListFormats: timestampHandler.ListFormats?.bind(timestampHandler),
+        SaveFormat: timestampHandler.SaveFormat?.bind(timestampHandler),
+        DeleteFormat: timestampHandler.DeleteFormat?.bind(timestampHandler),
+        BatchConvert: timestampHandler.BatchConvert?.bind(timestampHandler),
         ListTimestampHistory: timestampHandler.ListTimestampHistory?.bind(timestampHandler),
         AddTimestampHistory: timestampHandler.AddTimestampHistory?.bind(timestampHandler),
         ClearTimestampHistory: timestampHandler.ClearTimestampHistory?.bind(timestampHandler),
@@ -202,6 +336,16 @@ export function getWailsAPI() {
         GenerateV5: uuidHandler.GenerateV5?.bind(uuidHandler),
         GenerateV7: uuidHandler.GenerateV7?.bind(uuidHandler),
         GenerateBatch: uuidHandler.GenerateBatch?.bind(uuidHandler),
+        ParseUUID: uuidHandler.ParseUUID?.bind(uuidHandler),
+        GenerateV6: uuidHandler.GenerateV6?.bind(uuidHandler),
+        GenerateV8: uuidHandler.GenerateV8?.bind(uuidHandler),
+        GenerateULID: uuidHandler.GenerateULID?.bind(uuidHandler),
+        GenerateNanoID: uuidHandler.GenerateNanoID?.bind(uuidHandler),
+        GenerateWithOptions: uuidHandler.GenerateWithOptions?.bind(uuidHandler),
+        ListNamespaces: uuidHandler.ListNamespaces?.bind(uuidHandler),
+        HashNames: uuidHandler.HashNames?.bind(uuidHandler),
+        InspectID: uuidHandler.InspectID?.bind(uuidHandler),
+        ListSnowflakePresets: uuidHandler.ListSnowflakePresets?.bind(uuidHandler),
         ListHistory: uuidHandler.ListHistory?.bind(uuidHandler),
         AddHistory: uuidHandler.AddHistory?.bind(uuidHandler),
         ClearHistory: uuidHandler.ClearHistory?.bind(uuidHandler),
@@ -209,6 +353,20 @@ export function getWailsAPI() {
       URL: urlHandler ? {
         Encode: urlHandler.Encode?.bind(urlHandler),
         Decode: urlHandler.Decode?.bind(urlHandler),
+        Parse: urlHandler.Parse?.bind(urlHandler),
+        Build: urlHandler.Build?.bind(urlHandler),
+        ListModes: urlHandler.ListModes?.bind(urlHandler),
+        EncodeMode: urlHandler.EncodeMode?.bind(urlHandler),
+        DecodeMode: urlHandler.DecodeMode?.bind(urlHandler),
+        DecodeRecursive: urlHandler.DecodeRecursive?.bind(urlHandler),
+        ListConventions: urlHandler.ListConventions?.bind(urlHandler),
+        QueryToJSON: urlHandler.QueryToJSON?.bind(urlHandler),
+        JSONToQuery: urlHandler.JSONToQuery?.bind(urlHandler),
+        ConvertIDN: urlHandler.ConvertIDN?.bind(urlHandler),
+        AnalyzeScripts: urlHandler.AnalyzeScripts?.bind(urlHandler),
+        Normalize: urlHandler.Normalize?.bind(urlHandler),
+        ParseCurl: urlHandler.ParseCurl?.bind(urlHandler),
+        ConvertCurl: urlHandler.ConvertCurl?.bind(urlHandler),
         ListHistory: urlHandler.ListHistory?.bind(urlHandler),
         AddHistory: urlHandler.AddHistory?.bind(urlHandler),
         ClearHistory: urlHandler.ClearHistory?.bind(urlHandler),
@@ -242,6 +400,14 @@ export function getWailsAPI() {
         HashText: hashHandler.HashText?.bind(hashHandler),
         HashFile: hashHandler.HashFile?.bind(hashHandler),
         OpenFileDialog: hashHandler.OpenFileDialog?.bind(hashHandler),
+        OpenDirectoryDialog: hashHandler.OpenDirectoryDialog?.bind(hashHandler),
+        HashDirectory: hashHandler.HashDirectory?.bind(hashHandler),
+        VerifyManifest: hashHandler.VerifyManifest?.bind(hashHandler),
+        VerifyManifestFile: hashHandler.VerifyManifestFile?.bind(hashHandler),
+        SaveManifestDialog: hashHandler.SaveManifestDialog?.bind(hashHandler),
+        HashPassword: hashHandler.HashPassword?.bind(hashHandler),
+        VerifyPassword: hashHandler.VerifyPassword?.bind(hashHandler),
+        InspectPasswordHash: hashHandler.InspectPasswordHash?.bind(hashHandler),
         ListHistory: hashHandler.ListHistory?.bind(hashHandler),
         AddHistory: hashHandler.AddHistory?.bind(hashHandler),
         ClearHistory: hashHandler.ClearHistory?.bind(hashHandler),
@@ -249,10 +415,34 @@ export function getWailsAPI() {
       RandomString: randomStringHandler ? {
         Generate: randomStringHandler.Generate?.bind(randomStringHandler),
         GenerateBatch: randomStringHandler.GenerateBatch?.bind(randomStringHandler),
+        GeneratePassword: randomStringHandler.GeneratePassword?.bind(randomStringHandler),
+        GeneratePassphrase: randomStringHandler.GeneratePassphrase?.bind(randomStringHandler),
+        AnalyzeStrength: randomStringHandler.AnalyzeStrength?.bind(randomStringHandler),
         ListHistory: randomStringHandler.ListHistory?.bind(randomStringHandler),
         AddHistory: randomStringHandler.AddHistory?.bind(randomStringHandler),
         ClearHistory: randomStringHandler.ClearHistory?.bind(randomStringHandler),
       } : null,
+      Hex: hexHandler ? {
+        TextToHex: hexHandler.TextToHex?.bind(hexHandler),
+        HexToText: hexHandler.HexToText?.bind(hexHandler),
+        Reformat: hexHandler.Reformat?.bind(hexHandler),
+        DumpText: hexHandler.DumpText?.bind(hexHandler),
+        DumpHex: hexHandler.DumpHex?.bind(hexHandler),
+        OpenFileDialog: hexHandler.OpenFileDialog?.bind(hexHandler),
+        DumpFilePage: hexHandler.DumpFilePage?.bind(hexHandler),
+        FileToHex: hexHandler.FileToHex?.bind(hexHandler),
+        ListHistory: hexHandler.ListHistory?.bind(hexHandler),
+        AddHistory: hexHandler.AddHistory?.bind(hexHandler),
+        ClearHistory: hexHandler.ClearHistory?.bind(hexHandler),
+      } : null,
+      Cron: cronHandler ? {
+        Explain: cronHandler.Explain?.bind(cronHandler),
+        NextRuns: cronHandler.NextRuns?.bind(cronHandler),
+        Build: cronHandler.Build?.bind(cronHandler),
+        ListHistory: cronHandler.ListHistory?.bind(cronHandler),
+        AddHistory: cronHandler.AddHistory?.bind(cronHandler),
+        ClearHistory: cronHandler.ClearHistory?.bind(cronHandler),
+      } : null,
       GetVersion: appAPI.GetVersion?.bind(appAPI),
       GetInitialTool: appAPI.GetInitialTool?.bind(appAPI),
       ClearInitialTool: appAPI.ClearInitialTool?.bind(appAPI),
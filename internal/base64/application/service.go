@@ -3,6 +3,7 @@ package application
 import (
 	"github.com/cyrnicolase/dev-tools/internal/base64/domain"
 	historydomain "github.com/cyrnicolase/dev-tools/internal/history/domain"
+	jsondomain "github.com/cyrnicolase/dev-tools/internal/json/domain"
 	"github.com/pkg/errors"
 )
 
@@ -11,12 +12,35 @@ type Service struct {
 	encoder        *domain.Encoder
 	decoder        *domain.Decoder
 	validator      *domain.Validator
+	detector       *domain.Detector
+	inspector      *domain.Inspector
+	dataURICodec   *domain.DataURICodec
+	compressor     *domain.Compressor
+	decompressor   *domain.Decompressor
+	jsonFormatter  *jsondomain.Formatter
 	historyStore   *historydomain.ToolHistoryStore
 	historyInitErr error
 }
 
 const base64ToolID = "base64"
 
+// CompressResult 压缩结果
+type CompressResult struct {
+	Output         string `json:"output"`
+	OriginalSize   int    `json:"originalSize"`
+	CompressedSize int    `json:"compressedSize"`
+}
+
+// DecompressResult 解压结果
+type DecompressResult struct {
+	Codec          string                 `json:"codec"`
+	Wrap           string                 `json:"wrap"`
+	CompressedSize int                    `json:"compressedSize"`
+	Payload        *domain.DecodedPayload `json:"payload"`
+	IsJSON         bool                   `json:"isJson"`
+	FormattedJSON  string                 `json:"formattedJson,omitempty"`
+}
+
 // NewService 创建新的 Service 实例
 func NewService() *Service {
 	historyStore, historyErr := historydomain.NewToolHistoryStore()
@@ -24,6 +48,12 @@ func NewService() *Service {
 		encoder:        domain.NewEncoder(),
 		decoder:        domain.NewDecoder(),
 		validator:      domain.NewValidator(),
+		detector:       domain.NewDetector(),
+		inspector:      domain.NewInspector(),
+		dataURICodec:   domain.NewDataURICodec(),
+		compressor:     domain.NewCompressor(),
+		decompressor:   domain.NewDecompressor(),
+		jsonFormatter:  jsondomain.NewFormatter(),
 		historyStore:   historyStore,
 		historyInitErr: historyErr,
 	}
@@ -59,6 +89,156 @@ func (s *Service) ValidateURLSafe(input string) bool {
 	return s.validator.ValidateURLSafe(input)
 }
 
+// ListVariants 获取支持的编码变体
+func (s *Service) ListVariants() []domain.VariantInfo {
+	return domain.ListVariants()
+}
+
+// EncodeVariant 使用指定编码变体编码文本
+func (s *Service) EncodeVariant(variant, input string) (string, error) {
+	return s.encoder.EncodeVariant(variant, []byte(input))
+}
+
+// DecodeVariant 使用指定编码变体解码为文本
+func (s *Service) DecodeVariant(variant, input string, lenient bool) (string, error) {
+	decoded, err := s.decoder.DecodeVariant(variant, input, lenient)
+	if err != nil {
+		return "", err
+	}
+	return string(decoded), nil
+}
+
+// DetectVariant 自动识别输入的编码变体
+func (s *Service) DetectVariant(input string) ([]domain.DetectResult, error) {
+	return s.detector.Detect(input)
+}
+
+// EncodeVariantWithAlphabet 使用指定编码变体和自定义字母表编码文本
+func (s *Service) EncodeVariantWithAlphabet(variant, alphabet, input string) (string, error) {
+	return s.encoder.EncodeVariantWithAlphabet(variant, alphabet, []byte(input))
+}
+
+// DecodeVariantWithAlphabet 使用指定编码变体和自定义字母表解码为文本
+func (s *Service) DecodeVariantWithAlphabet(variant, alphabet, input string, lenient bool) (string, error) {
+	decoded, err := s.decoder.DecodeVariantWithAlphabet(variant, alphabet, input, lenient)
+	if err != nil {
+		return "", err
+	}
+	return string(decoded), nil
+}
+
+// DetectVariantWithAlphabet 使用自定义字母表识别输入的编码变体
+func (s *Service) DetectVariantWithAlphabet(input, alphabet string) ([]domain.DetectResult, error) {
+	return s.detector.DetectWithAlphabet(input, alphabet)
+}
+
+// ValidateAlphabet 校验自定义字母表是否适用于指定编码变体
+func (s *Service) ValidateAlphabet(variant, alphabet string) error {
+	return domain.ValidateAlphabet(variant, alphabet)
+}
+
+// EncodeBytes 使用指定编码变体编码字节序列（文件等二进制输入）
+func (s *Service) EncodeBytes(variant string, data []byte) (string, error) {
+	return s.encoder.EncodeVariant(variant, data)
+}
+
+// DecodeBytes 使用指定编码变体解码为原始字节
+func (s *Service) DecodeBytes(variant, input string, lenient bool) ([]byte, error) {
+	return s.decoder.DecodeVariant(variant, input, lenient)
+}
+
+// DecodeBinary 二进制安全解码，返回文本内容或十六进制预览以及识别出的 MIME 类型
+func (s *Service) DecodeBinary(variant, input string, lenient bool) (*domain.DecodedPayload, error) {
+	decoded, err := s.decoder.DecodeVariant(variant, input, lenient)
+	if err != nil {
+		return nil, err
+	}
+	return s.inspector.Inspect(decoded), nil
+}
+
+// DetectMIMEType 根据文件签名识别 MIME 类型
+func (s *Service) DetectMIMEType(data []byte) string {
+	return s.inspector.DetectMIMEType(data)
+}
+
+// BuildDataURI 将字节序列构建为 data URI，mediaType 为空时自动识别
+func (s *Service) BuildDataURI(data []byte, mediaType string, useBase64 bool) string {
+	return s.dataURICodec.Build(data, mediaType, useBase64)
+}
+
+// ParseDataURI 解析 data URI 的媒体类型、参数与内容
+func (s *Service) ParseDataURI(uri string) (*domain.DataURIInfo, error) {
+	return s.dataURICodec.Inspect(uri)
+}
+
+// DecodeDataURI 解析 data URI 并返回原始数据与媒体类型
+func (s *Service) DecodeDataURI(uri string) ([]byte, string, error) {
+	parsed, err := s.dataURICodec.Parse(uri)
+	if err != nil {
+		return nil, "", err
+	}
+	return parsed.Data, parsed.MediaType, nil
+}
+
+// CompressBytes 使用指定算法压缩字节序列
+func (s *Service) CompressBytes(codec string, data []byte, level int) ([]byte, error) {
+	return s.compressor.Compress(codec, data, level)
+}
+
+// Compress 压缩文本并使用十六进制或 Base64 变体包装结果
+func (s *Service) Compress(codec, wrap, input string, level int) (*CompressResult, error) {
+	compressed, err := s.compressor.Compress(codec, []byte(input), level)
+	if err != nil {
+		return nil, err
+	}
+	output, err := s.encoder.Wrap(wrap, compressed)
+	if err != nil {
+		return nil, err
+	}
+	return &CompressResult{
+		Output:         output,
+		OriginalSize:   len(input),
+		CompressedSize: len(compressed),
+	}, nil
+}
+
+// Decompress 解除文本包装并解压，codec/wrap 为 "auto" 时自动识别
+// formatJSON 为 true 且解压结果为 JSON 时，同时返回格式化后的 JSON
+func (s *Service) Decompress(codec, wrap, input string, formatJSON bool) (*DecompressResult, error) {
+	data, actualWrap, err := s.decoder.Unwrap(wrap, input)
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.DecompressBytes(codec, data, formatJSON)
+	if err != nil {
+		return nil, err
+	}
+	result.Wrap = actualWrap
+	return result, nil
+}
+
+// DecompressBytes 解压原始字节序列（文件等二进制输入）
+func (s *Service) DecompressBytes(codec string, data []byte, formatJSON bool) (*DecompressResult, error) {
+	decompressed, actualCodec, err := s.decompressor.Decompress(codec, data)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &DecompressResult{
+		Codec:          actualCodec,
+		Wrap:           domain.WrapNone,
+		CompressedSize: len(data),
+		Payload:        s.inspector.Inspect(decompressed),
+	}
+	if formatJSON && result.Payload.IsText {
+		if formatted, err := s.jsonFormatter.Format(result.Payload.Text); err == nil {
+			result.IsJSON = true
+			result.FormattedJSON = formatted
+		}
+	}
+	return result, nil
+}
+
 // ListHistory 获取历史记录
 func (s *Service) ListHistory() ([]historydomain.ToolHistoryRecord, error) {
 	if s.historyInitErr != nil || s.historyStore == nil {
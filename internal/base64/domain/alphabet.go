@@ -0,0 +1,180 @@
+package domain
+
+import (
+	"encoding/ascii85"
+	"encoding/base32"
+	"encoding/base64"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	base64StdAlphabet    = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789+/"
+	base64URLAlphabet    = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789-_"
+	base32StdAlphabet    = "ABCDEFGHIJKLMNOPQRSTUVWXYZ234567"
+	base32HexAlphabet    = "0123456789ABCDEFGHIJKLMNOPQRSTUV"
+	ascii85Alphabet      = "!\"#$%&'()*+,-./0123456789:;<=>?@ABCDEFGHIJKLMNOPQRSTUVWXYZ[\\]^_`abcdefghijklmnopqrstu"
+	ascii85AlphabetStart = '!'
+)
+
+// defaultAlphabets 各变体的默认字母表，长度即自定义字母表必须满足的长度
+var defaultAlphabets = map[string]string{
+	VariantStd:       base64StdAlphabet,
+	VariantStdRaw:    base64StdAlphabet,
+	VariantURL:       base64URLAlphabet,
+	VariantURLRaw:    base64URLAlphabet,
+	VariantMIME:      base64StdAlphabet,
+	VariantBase32:    base32StdAlphabet,
+	VariantBase32Hex: base32HexAlphabet,
+	VariantBase58:    base58Alphabet,
+	VariantBase62:    base62Alphabet,
+	VariantAscii85:   ascii85Alphabet,
+	VariantZ85:       z85Alphabet,
+	VariantBase45:    base45Alphabet,
+}
+
+// alphabetIndexes 构建字符到下标的反查表，不属于字母表的字符为 -1
+func alphabetIndexes(alphabet string) [256]int {
+	var indexes [256]int
+	for i := range indexes {
+		indexes[i] = -1
+	}
+	for i := 0; i < len(alphabet); i++ {
+		indexes[alphabet[i]] = i
+	}
+	return indexes
+}
+
+// ValidateAlphabet 校验自定义字母表：长度与变体一致、字符为不重复的可打印 ASCII
+// Base64/Base32 类变体的字母表不能包含填充字符 =，只有 Base45 允许包含空格
+func ValidateAlphabet(variant, alphabet string) error {
+	standard, ok := defaultAlphabets[variant]
+	if !ok {
+		return errors.Wrapf(ErrUnsupportedVariant, "不支持的编码变体: %s", variant)
+	}
+	if len(alphabet) != len(standard) {
+		return errors.Wrapf(ErrInvalidAlphabet, "%s 字母表应包含 %d 个字符，当前 %d 个", variant, len(standard), len(alphabet))
+	}
+
+	_, isBase64 := base64Encoding(variant)
+	_, isBase32 := base32Encoding(variant)
+	var seen [256]bool
+	for i := 0; i < len(alphabet); i++ {
+		c := alphabet[i]
+		switch {
+		case c == ' ' && variant == VariantBase45:
+		case c <= ' ' || c > '~':
+			return errors.Wrapf(ErrInvalidAlphabet, "位置 %d 的字符不是可打印 ASCII", i)
+		case c == '=' && (isBase64 || isBase32):
+			return errors.Wrapf(ErrInvalidAlphabet, "字母表不能包含填充字符 =")
+		}
+		if seen[c] {
+			return errors.Wrapf(ErrInvalidAlphabet, "字符 %q 重复", c)
+		}
+		seen[c] = true
+	}
+	return nil
+}
+
+// encodeWithAlphabet 使用自定义字母表编码
+func encodeWithAlphabet(variant, alphabet string, data []byte) (string, error) {
+	if err := ValidateAlphabet(variant, alphabet); err != nil {
+		return "", err
+	}
+	if enc, ok := base64Encoding(variant); ok {
+		encoded := base64.NewEncoding(alphabet).WithPadding(padding(enc)).EncodeToString(data)
+		if variant == VariantMIME {
+			encoded = wrapLines(encoded, mimeLineLength)
+		}
+		return encoded, nil
+	}
+	if _, ok := base32Encoding(variant); ok {
+		return base32.NewEncoding(alphabet).EncodeToString(data), nil
+	}
+
+	switch variant {
+	case VariantBase58, VariantBase62:
+		return newBaseXCodec(alphabet).Encode(data), nil
+	case VariantAscii85:
+		// 自定义字母表没有 z 缩写，全零分组按普通分组编码
+		buf := make([]byte, ascii85.MaxEncodedLen(len(data)))
+		n := ascii85.Encode(buf, data)
+		standard := strings.ReplaceAll(string(buf[:n]), "z", "!!!!!")
+		return strings.Map(func(r rune) rune {
+			return rune(alphabet[r-ascii85AlphabetStart])
+		}, standard), nil
+	case VariantZ85:
+		return encodeZ85(data, alphabet)
+	default:
+		return encodeBase45(data, alphabet), nil
+	}
+}
+
+// decodeWithAlphabet 使用自定义字母表解码，lenient 的含义与 DecodeVariant 一致（自定义字母表不做大小写转换）
+func decodeWithAlphabet(variant, alphabet, input string, lenient bool) ([]byte, error) {
+	if err := ValidateAlphabet(variant, alphabet); err != nil {
+		return nil, err
+	}
+	if lenient {
+		input = stripWhitespace(input, variant == VariantBase45)
+	}
+
+	if enc, ok := base64Encoding(variant); ok {
+		custom := base64.NewEncoding(alphabet).WithPadding(padding(enc))
+		if lenient {
+			custom = custom.WithPadding(base64.NoPadding)
+			input = strings.TrimRight(input, "=")
+		}
+		decoded, err := custom.DecodeString(input)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to decode %s", variant)
+		}
+		return decoded, nil
+	}
+	if _, ok := base32Encoding(variant); ok {
+		custom := base32.NewEncoding(alphabet)
+		if lenient {
+			custom = custom.WithPadding(base32.NoPadding)
+			input = strings.TrimRight(input, "=")
+		}
+		decoded, err := custom.DecodeString(input)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to decode %s", variant)
+		}
+		return decoded, nil
+	}
+
+	switch variant {
+	case VariantBase58, VariantBase62:
+		return newBaseXCodec(alphabet).Decode(input)
+	case VariantAscii85:
+		indexes := alphabetIndexes(alphabet)
+		standard := make([]byte, len(input))
+		for i := 0; i < len(input); i++ {
+			index := indexes[input[i]]
+			if index < 0 {
+				return nil, errors.Wrapf(ErrInvalidCharacter, "位置 %d 的字符 %q 不属于字母表", i, input[i])
+			}
+			standard[i] = byte(ascii85AlphabetStart + index)
+		}
+		buf := make([]byte, 4*len(standard))
+		n, _, err := ascii85.Decode(buf, standard, true)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to decode ascii85")
+		}
+		return buf[:n], nil
+	case VariantZ85:
+		return decodeZ85(input, alphabet)
+	default:
+		return decodeBase45(input, alphabet)
+	}
+}
+
+// padding 返回 Base64 编码使用的填充字符
+func padding(enc *base64.Encoding) rune {
+	if enc == base64.RawStdEncoding || enc == base64.RawURLEncoding {
+		return base64.NoPadding
+	}
+	return base64.StdPadding
+}
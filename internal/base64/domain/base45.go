@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"github.com/pkg/errors"
+)
+
+// base45Alphabet RFC 9285 字母表
+const base45Alphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ $%*+-./:"
+
+// encodeBase45 按 RFC 9285 编码：每 2 字节编码为 3 个字符，末尾单字节编码为 2 个字符
+func encodeBase45(data []byte, alphabet string) string {
+	result := make([]byte, 0, (len(data)+1)/2*3)
+	for i := 0; i+1 < len(data); i += 2 {
+		n := int(data[i])<<8 | int(data[i+1])
+		result = append(result, alphabet[n%45], alphabet[n/45%45], alphabet[n/2025])
+	}
+	if len(data)%2 == 1 {
+		n := int(data[len(data)-1])
+		result = append(result, alphabet[n%45], alphabet[n/45])
+	}
+	return string(result)
+}
+
+// decodeBase45 按 RFC 9285 解码
+func decodeBase45(input, alphabet string) ([]byte, error) {
+	if len(input)%3 == 1 {
+		return nil, errors.Wrapf(ErrInvalidLength, "Base45 输入长度不能为 3n+1，当前 %d 字符", len(input))
+	}
+
+	indexes := alphabetIndexes(alphabet)
+	values := make([]int, len(input))
+	for i := 0; i < len(input); i++ {
+		values[i] = indexes[input[i]]
+		if values[i] < 0 {
+			return nil, errors.Wrapf(ErrInvalidCharacter, "位置 %d 的字符 %q 不属于 Base45 字母表", i, input[i])
+		}
+	}
+
+	result := make([]byte, 0, len(input)/3*2+1)
+	for i := 0; i < len(values); i += 3 {
+		if i+2 < len(values) {
+			n := values[i] + values[i+1]*45 + values[i+2]*2025
+			if n > 0xffff {
+				return nil, errors.Wrapf(ErrValueOverflow, "位置 %d 的分组超出 16 位范围", i)
+			}
+			result = append(result, byte(n>>8), byte(n))
+			continue
+		}
+		n := values[i] + values[i+1]*45
+		if n > 0xff {
+			return nil, errors.Wrapf(ErrValueOverflow, "位置 %d 的分组超出 8 位范围", i)
+		}
+		result = append(result, byte(n))
+	}
+	return result, nil
+}
@@ -0,0 +1,90 @@
+package domain
+
+import (
+	"github.com/pkg/errors"
+)
+
+// baseXCodec 基于任意字母表的大数进制编解码（Base58、Base62 等）
+// 前导零字节编码为字母表首字符，与 Bitcoin Base58 及 base-x 库的约定一致
+type baseXCodec struct {
+	alphabet string
+	indexes  [256]int
+}
+
+// newBaseXCodec 创建字母表编解码器
+func newBaseXCodec(alphabet string) *baseXCodec {
+	return &baseXCodec{alphabet: alphabet, indexes: alphabetIndexes(alphabet)}
+}
+
+var (
+	base58Codec = newBaseXCodec(base58Alphabet)
+	base62Codec = newBaseXCodec(base62Alphabet)
+)
+
+// Encode 编码字节序列
+func (c *baseXCodec) Encode(data []byte) string {
+	base := len(c.alphabet)
+
+	zeros := 0
+	for zeros < len(data) && data[zeros] == 0 {
+		zeros++
+	}
+
+	// 逐字节将 256 进制转换为目标进制（小端存放）
+	digits := make([]int, 0, len(data)*138/100+1)
+	for _, b := range data[zeros:] {
+		carry := int(b)
+		for i := range digits {
+			carry += digits[i] << 8
+			digits[i] = carry % base
+			carry /= base
+		}
+		for carry > 0 {
+			digits = append(digits, carry%base)
+			carry /= base
+		}
+	}
+
+	result := make([]byte, zeros+len(digits))
+	for i := 0; i < zeros; i++ {
+		result[i] = c.alphabet[0]
+	}
+	for i := range digits {
+		result[zeros+i] = c.alphabet[digits[len(digits)-1-i]]
+	}
+	return string(result)
+}
+
+// Decode 解码字符串
+func (c *baseXCodec) Decode(input string) ([]byte, error) {
+	base := len(c.alphabet)
+
+	zeros := 0
+	for zeros < len(input) && input[zeros] == c.alphabet[0] {
+		zeros++
+	}
+
+	bytes := make([]byte, 0, len(input))
+	for i := zeros; i < len(input); i++ {
+		value := c.indexes[input[i]]
+		if value < 0 {
+			return nil, errors.Wrapf(ErrInvalidCharacter, "位置 %d 的字符 %q 不属于字母表", i, input[i])
+		}
+		carry := value
+		for j := range bytes {
+			carry += int(bytes[j]) * base
+			bytes[j] = byte(carry & 0xff)
+			carry >>= 8
+		}
+		for carry > 0 {
+			bytes = append(bytes, byte(carry&0xff))
+			carry >>= 8
+		}
+	}
+
+	result := make([]byte, zeros+len(bytes))
+	for i := range bytes {
+		result[zeros+i] = bytes[len(bytes)-1-i]
+	}
+	return result, nil
+}
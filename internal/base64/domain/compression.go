@@ -0,0 +1,254 @@
+package domain
+
+import (
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
+	"encoding/hex"
+	"io"
+	"strings"
+
+	"github.com/andybalholm/brotli"
+	"github.com/klauspost/compress/zstd"
+	"github.com/pkg/errors"
+)
+
+// 支持的压缩算法
+const (
+	CodecAuto    = "auto"
+	CodecGzip    = "gzip"
+	CodecZlib    = "zlib"
+	CodecDeflate = "deflate" // 原始 DEFLATE（RFC 1951，无头部）
+	CodecBrotli  = "brotli"
+	CodecZstd    = "zstd"
+)
+
+// 压缩数据的文本包装方式（同时支持任意 Base64 编码变体名称，如 "std"、"url-raw"）
+const (
+	WrapNone = "none"
+	WrapAuto = "auto"
+	WrapHex  = "hex"
+)
+
+const (
+	// MaxDecompressedSize 解压结果的最大字节数，防止压缩炸弹耗尽内存
+	MaxDecompressedSize = 100 * 1024 * 1024
+	// DefaultCompressionLevel 使用各算法的默认压缩级别
+	DefaultCompressionLevel = -1
+)
+
+// Compressor 提供数据压缩功能
+type Compressor struct{}
+
+// NewCompressor 创建新的 Compressor 实例
+func NewCompressor() *Compressor {
+	return &Compressor{}
+}
+
+// Compress 使用指定算法压缩数据
+// level 取值范围与算法相关（gzip/zlib/deflate 为 1-9，brotli 为 0-11，zstd 为 1-4），
+// 传入 DefaultCompressionLevel 时使用算法默认级别
+func (c *Compressor) Compress(codec string, data []byte, level int) ([]byte, error) {
+	var buf bytes.Buffer
+	var writer io.WriteCloser
+	var err error
+
+	switch codec {
+	case CodecGzip:
+		writer, err = gzip.NewWriterLevel(&buf, flateLevel(level))
+	case CodecZlib:
+		writer, err = zlib.NewWriterLevel(&buf, flateLevel(level))
+	case CodecDeflate:
+		writer, err = flate.NewWriter(&buf, flateLevel(level))
+	case CodecBrotli:
+		if level < brotli.BestSpeed || level > brotli.BestCompression {
+			level = brotli.DefaultCompression
+		}
+		writer = brotli.NewWriterLevel(&buf, level)
+	case CodecZstd:
+		encoderLevel := zstd.SpeedDefault
+		if level >= int(zstd.SpeedFastest) && level <= int(zstd.SpeedBestCompression) {
+			encoderLevel = zstd.EncoderLevel(level)
+		}
+		writer, err = zstd.NewWriter(&buf, zstd.WithEncoderLevel(encoderLevel))
+	default:
+		return nil, errors.Wrapf(ErrUnsupportedCodec, "不支持的压缩算法: %s", codec)
+	}
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	if _, err := writer.Write(data); err != nil {
+		_ = writer.Close()
+		return nil, errors.Wrapf(err, "failed to compress %s", codec)
+	}
+	if err := writer.Close(); err != nil {
+		return nil, errors.Wrapf(err, "failed to compress %s", codec)
+	}
+	return buf.Bytes(), nil
+}
+
+// flateLevel 规范化 DEFLATE 系算法的压缩级别
+func flateLevel(level int) int {
+	if level < flate.BestSpeed || level > flate.BestCompression {
+		return flate.DefaultCompression
+	}
+	return level
+}
+
+// Decompressor 提供数据解压功能
+type Decompressor struct{}
+
+// NewDecompressor 创建新的 Decompressor 实例
+func NewDecompressor() *Decompressor {
+	return &Decompressor{}
+}
+
+// Decompress 使用指定算法解压数据，codec 为 CodecAuto 时根据魔数自动识别
+// 返回解压结果以及实际使用的算法
+func (d *Decompressor) Decompress(codec string, data []byte) ([]byte, string, error) {
+	if codec == "" || codec == CodecAuto {
+		codec = DetectCompression(data)
+		if codec == "" {
+			// brotli 与原始 DEFLATE 没有魔数，依次尝试
+			for _, candidate := range []string{CodecBrotli, CodecDeflate} {
+				if result, err := d.decompress(candidate, data); err == nil && len(result) > 0 {
+					return result, candidate, nil
+				}
+			}
+			return nil, "", errors.WithStack(ErrCodecNotDetected)
+		}
+	}
+
+	result, err := d.decompress(codec, data)
+	if err != nil {
+		return nil, codec, err
+	}
+	return result, codec, nil
+}
+
+// decompress 使用指定算法解压，并限制解压后的大小
+func (d *Decompressor) decompress(codec string, data []byte) ([]byte, error) {
+	var reader io.Reader
+	var closer func()
+
+	switch codec {
+	case CodecGzip:
+		r, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to decompress gzip")
+		}
+		reader, closer = r, func() { _ = r.Close() }
+	case CodecZlib:
+		r, err := zlib.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to decompress zlib")
+		}
+		reader, closer = r, func() { _ = r.Close() }
+	case CodecDeflate:
+		r := flate.NewReader(bytes.NewReader(data))
+		reader, closer = r, func() { _ = r.Close() }
+	case CodecBrotli:
+		reader = brotli.NewReader(bytes.NewReader(data))
+	case CodecZstd:
+		r, err := zstd.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to decompress zstd")
+		}
+		reader, closer = r, r.Close
+	default:
+		return nil, errors.Wrapf(ErrUnsupportedCodec, "不支持的压缩算法: %s", codec)
+	}
+	if closer != nil {
+		defer closer()
+	}
+
+	result, err := io.ReadAll(io.LimitReader(reader, MaxDecompressedSize+1))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to decompress %s", codec)
+	}
+	if len(result) > MaxDecompressedSize {
+		return nil, errors.Wrapf(ErrDecompressedTooLarge, "解压结果超过 %d MB", MaxDecompressedSize/1024/1024)
+	}
+	return result, nil
+}
+
+// DetectCompression 根据魔数识别压缩算法，无法识别时返回空字符串
+func DetectCompression(data []byte) string {
+	switch {
+	case len(data) >= 2 && data[0] == 0x1f && data[1] == 0x8b:
+		return CodecGzip
+	case len(data) >= 4 && bytes.Equal(data[:4], []byte{0x28, 0xB5, 0x2F, 0xFD}):
+		return CodecZstd
+	case len(data) >= 2 && data[0]&0x0f == 8 && data[0]>>4 <= 7 && (uint16(data[0])<<8|uint16(data[1]))%31 == 0:
+		// zlib 头部：CM=8（deflate），CINFO<=7，且 CMF*256+FLG 为 31 的倍数
+		return CodecZlib
+	default:
+		return ""
+	}
+}
+
+// Wrap 将二进制数据包装为文本（十六进制或任意 Base64 编码变体）
+func (e *Encoder) Wrap(wrap string, data []byte) (string, error) {
+	switch wrap {
+	case WrapHex:
+		return hex.EncodeToString(data), nil
+	case WrapNone, "":
+		return "", errors.Wrapf(ErrUnsupportedVariant, "二进制结果需要选择文本包装方式")
+	default:
+		return e.EncodeVariant(wrap, data)
+	}
+}
+
+// Unwrap 将文本还原为二进制数据，wrap 为 WrapAuto 时依次尝试十六进制与 Base64
+// 返回数据以及实际使用的包装方式
+func (d *Decoder) Unwrap(wrap, input string) ([]byte, string, error) {
+	switch wrap {
+	case WrapNone, "":
+		return []byte(input), WrapNone, nil
+	case WrapHex:
+		data, err := decodeHexLenient(input)
+		return data, WrapHex, err
+	case WrapAuto:
+		// 多种包装方式都能解码时，优先选择解码结果带有压缩魔数的一种
+		var fallback []byte
+		fallbackWrap := ""
+		candidates := []string{WrapHex, VariantStd, VariantURL}
+		for _, candidate := range candidates {
+			var data []byte
+			var err error
+			if candidate == WrapHex {
+				data, err = decodeHexLenient(input)
+			} else {
+				data, err = d.DecodeVariant(candidate, input, true)
+			}
+			if err != nil || len(data) == 0 {
+				continue
+			}
+			if DetectCompression(data) != "" {
+				return data, candidate, nil
+			}
+			if fallbackWrap == "" {
+				fallback, fallbackWrap = data, candidate
+			}
+		}
+		if fallbackWrap == "" {
+			return nil, "", errors.WithStack(ErrVariantNotDetected)
+		}
+		return fallback, fallbackWrap, nil
+	default:
+		data, err := d.DecodeVariant(wrap, input, true)
+		return data, wrap, err
+	}
+}
+
+// decodeHexLenient 解码十六进制，忽略空白、":" 分隔符与 "0x" 前缀
+func decodeHexLenient(input string) ([]byte, error) {
+	cleaned := strings.NewReplacer("0x", "", "0X", "", ":", "").Replace(stripWhitespace(input, false))
+	data, err := hex.DecodeString(cleaned)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to decode hex")
+	}
+	return data, nil
+}
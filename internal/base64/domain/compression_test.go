@@ -0,0 +1,52 @@
+package domain
+
+import (
+	"testing"
+)
+
+func TestCompression_RoundTripAllCodecs(t *testing.T) {
+	compressor := NewCompressor()
+	decompressor := NewDecompressor()
+	input := []byte(`{"user":"alice","roles":["admin","dev"],"padding":"aaaaaaaaaaaaaaaaaaaaaaaaaaaa"}`)
+
+	for _, codec := range []string{CodecGzip, CodecZlib, CodecDeflate, CodecBrotli, CodecZstd} {
+		t.Run(codec, func(t *testing.T) {
+			compressed, err := compressor.Compress(codec, input, DefaultCompressionLevel)
+			if err != nil {
+				t.Fatalf("Compress() error = %v", err)
+			}
+
+			decompressed, detected, err := decompressor.Decompress(CodecAuto, compressed)
+			if err != nil {
+				t.Fatalf("Decompress(auto) error = %v", err)
+			}
+			if detected != codec {
+				t.Errorf("Decompress(auto) codec = %s, want %s", detected, codec)
+			}
+			if string(decompressed) != string(input) {
+				t.Errorf("Decompress(auto) = %s", decompressed)
+			}
+		})
+	}
+}
+
+func TestDecoder_UnwrapAutoPrefersCompressedPayload(t *testing.T) {
+	compressed, err := NewCompressor().Compress(CodecGzip, []byte("hello"), DefaultCompressionLevel)
+	if err != nil {
+		t.Fatalf("Compress() error = %v", err)
+	}
+
+	for _, wrap := range []string{WrapHex, VariantStd, VariantURLRaw} {
+		wrapped, err := NewEncoder().Wrap(wrap, compressed)
+		if err != nil {
+			t.Fatalf("Wrap(%s) error = %v", wrap, err)
+		}
+		data, _, err := NewDecoder().Unwrap(WrapAuto, wrapped)
+		if err != nil {
+			t.Fatalf("Unwrap(%s) error = %v", wrap, err)
+		}
+		if DetectCompression(data) != CodecGzip {
+			t.Errorf("Unwrap(%s) did not recover gzip payload", wrap)
+		}
+	}
+}
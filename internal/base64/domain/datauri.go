@@ -0,0 +1,169 @@
+package domain
+
+import (
+	"encoding/base64"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// dataURIScheme data URI 前缀
+	dataURIScheme = "data:"
+	// defaultDataURIMediaType RFC 2397 规定的缺省媒体类型
+	defaultDataURIMediaType = "text/plain"
+)
+
+// DataURIParam data URI 媒体类型参数
+type DataURIParam struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// DataURI 解析后的 data URI（RFC 2397）
+type DataURI struct {
+	MediaType string         `json:"mediaType"`
+	Params    []DataURIParam `json:"params"`
+	IsBase64  bool           `json:"isBase64"`
+	Data      []byte         `json:"-"`
+}
+
+// DataURIInfo data URI 解析结果（供前端展示）
+type DataURIInfo struct {
+	MediaType string          `json:"mediaType"`
+	Params    []DataURIParam  `json:"params"`
+	IsBase64  bool            `json:"isBase64"`
+	Payload   *DecodedPayload `json:"payload"`
+}
+
+// DataURICodec 提供 data URI 的构建与解析功能
+type DataURICodec struct {
+	inspector *Inspector
+}
+
+// NewDataURICodec 创建新的 DataURICodec 实例
+func NewDataURICodec() *DataURICodec {
+	return &DataURICodec{
+		inspector: NewInspector(),
+	}
+}
+
+// Build 将字节序列构建为 data URI
+// mediaType 为空时根据内容自动识别；useBase64 为 false 时对内容做百分号编码
+func (c *DataURICodec) Build(data []byte, mediaType string, useBase64 bool) string {
+	mediaType = strings.TrimSpace(mediaType)
+	if mediaType == "" {
+		mediaType = c.inspector.DetectMIMEType(data)
+		if isPrintableText(data) && !strings.Contains(mediaType, "charset=") {
+			mediaType += ";charset=utf-8"
+		}
+	}
+
+	var sb strings.Builder
+	sb.WriteString(dataURIScheme)
+	sb.WriteString(mediaType)
+	if useBase64 {
+		sb.WriteString(";base64,")
+		sb.WriteString(base64.StdEncoding.EncodeToString(data))
+	} else {
+		sb.WriteString(",")
+		sb.WriteString(escapeDataURIPayload(data))
+	}
+	return sb.String()
+}
+
+// Parse 解析 data URI，拆分媒体类型、参数与解码后的数据
+func (c *DataURICodec) Parse(uri string) (*DataURI, error) {
+	uri = strings.TrimSpace(uri)
+	if len(uri) < len(dataURIScheme) || !strings.EqualFold(uri[:len(dataURIScheme)], dataURIScheme) {
+		return nil, errors.Wrapf(ErrInvalidDataURI, "缺少 data: 前缀")
+	}
+
+	header, payload, found := strings.Cut(uri[len(dataURIScheme):], ",")
+	if !found {
+		return nil, errors.Wrapf(ErrInvalidDataURI, "缺少数据分隔符 ','")
+	}
+
+	result := &DataURI{MediaType: defaultDataURIMediaType}
+	segments := strings.Split(header, ";")
+	if mediaType := strings.TrimSpace(segments[0]); mediaType != "" {
+		result.MediaType = strings.ToLower(mediaType)
+	}
+	for _, segment := range segments[1:] {
+		segment = strings.TrimSpace(segment)
+		if strings.EqualFold(segment, "base64") {
+			result.IsBase64 = true
+			continue
+		}
+		name, value, _ := strings.Cut(segment, "=")
+		if unescaped, err := url.PathUnescape(value); err == nil {
+			value = unescaped
+		}
+		result.Params = append(result.Params, DataURIParam{Name: strings.ToLower(name), Value: value})
+	}
+	if segments[0] == "" && len(result.Params) == 0 {
+		result.Params = []DataURIParam{{Name: "charset", Value: "US-ASCII"}}
+	}
+
+	if result.IsBase64 {
+		// 兼容 URL 安全字母表、百分号编码以及缺失的填充
+		if unescaped, err := url.PathUnescape(payload); err == nil {
+			payload = unescaped
+		}
+		payload = strings.NewReplacer("-", "+", "_", "/").Replace(stripWhitespace(payload, false))
+		data, err := base64.RawStdEncoding.DecodeString(strings.TrimRight(payload, "="))
+		if err != nil {
+			return nil, errors.Wrapf(ErrInvalidDataURI, "Base64 数据无效: %v", err)
+		}
+		result.Data = data
+		return result, nil
+	}
+
+	data, err := url.PathUnescape(payload)
+	if err != nil {
+		return nil, errors.Wrapf(ErrInvalidDataURI, "百分号编码无效: %v", err)
+	}
+	result.Data = []byte(data)
+	return result, nil
+}
+
+// Inspect 解析 data URI 并生成前端可展示的结果
+func (c *DataURICodec) Inspect(uri string) (*DataURIInfo, error) {
+	parsed, err := c.Parse(uri)
+	if err != nil {
+		return nil, err
+	}
+	return &DataURIInfo{
+		MediaType: parsed.MediaType,
+		Params:    parsed.Params,
+		IsBase64:  parsed.IsBase64,
+		Payload:   c.inspector.Inspect(parsed.Data),
+	}, nil
+}
+
+// escapeDataURIPayload 对非 Base64 的 data URI 内容进行百分号编码
+// 仅保留 RFC 3986 非保留字符及少量安全符号，保证结果可直接用于 HTML/CSS
+func escapeDataURIPayload(data []byte) string {
+	const hexDigits = "0123456789ABCDEF"
+	var sb strings.Builder
+	for _, b := range data {
+		if isDataURISafe(b) {
+			sb.WriteByte(b)
+			continue
+		}
+		sb.WriteByte('%')
+		sb.WriteByte(hexDigits[b>>4])
+		sb.WriteByte(hexDigits[b&0x0f])
+	}
+	return sb.String()
+}
+
+// isDataURISafe 判断字节在 data URI 中是否无需编码
+func isDataURISafe(b byte) bool {
+	switch {
+	case 'a' <= b && b <= 'z', 'A' <= b && b <= 'Z', '0' <= b && b <= '9':
+		return true
+	}
+	return strings.IndexByte("-_.~!$&'()*+,;=:@/?", b) >= 0
+}
@@ -0,0 +1,52 @@
+package domain
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestDataURICodec_BuildAndParse(t *testing.T) {
+	codec := NewDataURICodec()
+
+	png := append([]byte("\x89PNG\r\n\x1a\n"), 0, 1, 2)
+	uri := codec.Build(png, "", true)
+	if uri != "data:image/png;base64,iVBORw0KGgoAAQI=" {
+		t.Errorf("Build() = %s", uri)
+	}
+
+	parsed, err := codec.Parse(uri)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if parsed.MediaType != "image/png" || !parsed.IsBase64 || !bytes.Equal(parsed.Data, png) {
+		t.Errorf("Parse() = %+v", parsed)
+	}
+
+	if got := codec.Build([]byte("a b"), "text/plain", false); got != "data:text/plain,a%20b" {
+		t.Errorf("Build(percent) = %s", got)
+	}
+}
+
+func TestDataURICodec_ParseParamsAndDefaults(t *testing.T) {
+	codec := NewDataURICodec()
+
+	parsed, err := codec.Parse("data:text/html;charset=utf-8;name=a%20b.html,%3Ch1%3Ehi%3C%2Fh1%3E")
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if string(parsed.Data) != "<h1>hi</h1>" || len(parsed.Params) != 2 || parsed.Params[1].Value != "a b.html" {
+		t.Errorf("Parse() = %+v", parsed)
+	}
+
+	parsed, err = codec.Parse("data:,hello")
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if parsed.MediaType != "text/plain" || parsed.Params[0].Value != "US-ASCII" {
+		t.Errorf("Parse(default) = %+v", parsed)
+	}
+
+	if _, err := codec.Parse("http://example.com"); err == nil {
+		t.Errorf("Parse() should reject non data URIs")
+	}
+}
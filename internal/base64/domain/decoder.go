@@ -1,7 +1,11 @@
 package domain
 
 import (
+	"encoding/ascii85"
+	"encoding/base32"
 	"encoding/base64"
+	"strings"
+	"unicode"
 
 	"github.com/pkg/errors"
 )
@@ -31,3 +35,86 @@ func (d *Decoder) DecodeURLSafe(input string) (string, error) {
 	}
 	return string(decoded), nil
 }
+
+// DecodeVariant 使用指定的编码变体解码
+// lenient 为 true 时忽略空白字符并容忍缺失的填充
+func (d *Decoder) DecodeVariant(variant, input string, lenient bool) ([]byte, error) {
+	if lenient {
+		input = stripWhitespace(input, variant == VariantBase45)
+	}
+
+	if enc, ok := base64Encoding(variant); ok {
+		if lenient {
+			enc = enc.WithPadding(base64.NoPadding)
+			input = strings.TrimRight(input, "=")
+		}
+		decoded, err := enc.DecodeString(input)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to decode %s", variant)
+		}
+		return decoded, nil
+	}
+
+	if enc, ok := base32Encoding(variant); ok {
+		if lenient {
+			enc = enc.WithPadding(base32.NoPadding)
+			input = strings.ToUpper(strings.TrimRight(input, "="))
+		}
+		decoded, err := enc.DecodeString(input)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to decode %s", variant)
+		}
+		return decoded, nil
+	}
+
+	switch variant {
+	case VariantBase58:
+		return base58Codec.Decode(input)
+	case VariantBase62:
+		return base62Codec.Decode(input)
+	case VariantAscii85:
+		return decodeAscii85(input)
+	case VariantZ85:
+		return decodeZ85(input, z85Alphabet)
+	case VariantBase45:
+		return decodeBase45(input, base45Alphabet)
+	default:
+		return nil, errors.Wrapf(ErrUnsupportedVariant, "不支持的编码变体: %s", variant)
+	}
+}
+
+// DecodeVariantWithAlphabet 使用指定变体与自定义字母表解码，alphabet 为空时使用变体的默认字母表
+func (d *Decoder) DecodeVariantWithAlphabet(variant, alphabet, input string, lenient bool) ([]byte, error) {
+	if alphabet == "" {
+		return d.DecodeVariant(variant, input, lenient)
+	}
+	return decodeWithAlphabet(variant, alphabet, input, lenient)
+}
+
+// decodeAscii85 解码 Ascii85，兼容 Adobe 的 <~ ~> 定界符
+func decodeAscii85(input string) ([]byte, error) {
+	input = strings.TrimSpace(input)
+	input = strings.TrimPrefix(input, "<~")
+	input = strings.TrimSuffix(input, "~>")
+
+	buf := make([]byte, 4*len(input))
+	n, _, err := ascii85.Decode(buf, []byte(input), true)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to decode ascii85")
+	}
+	return buf[:n], nil
+}
+
+// stripWhitespace 去除空白字符
+// keepSpace 为 true 时保留空格（Base45 字母表包含空格）
+func stripWhitespace(s string, keepSpace bool) string {
+	return strings.Map(func(r rune) rune {
+		if r == ' ' && keepSpace {
+			return r
+		}
+		if unicode.IsSpace(r) {
+			return -1
+		}
+		return r
+	}, s)
+}
@@ -0,0 +1,113 @@
+package domain
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+)
+
+// DetectResult 编码变体识别结果
+type DetectResult struct {
+	Variant   string `json:"variant"`
+	Label     string `json:"label"`
+	Decoded   string `json:"decoded"`
+	IsText    bool   `json:"isText"`
+	ByteCount int    `json:"byteCount"`
+}
+
+// Detector 提供编码变体自动识别功能
+type Detector struct {
+	decoder *Decoder
+}
+
+// NewDetector 创建新的 Detector 实例
+func NewDetector() *Detector {
+	return &Detector{
+		decoder: NewDecoder(),
+	}
+}
+
+// Detect 尝试使用所有变体解码输入，返回可成功解码的候选列表
+// 候选按“解码结果是否为可读文本”以及变体优先级排序，第一个即为最可能的变体
+func (d *Detector) Detect(input string) ([]DetectResult, error) {
+	return d.DetectWithAlphabet(input, "")
+}
+
+// DetectWithAlphabet 使用自定义字母表识别编码变体，只尝试字母表长度相符的变体
+// alphabet 为空时等同于 Detect
+func (d *Detector) DetectWithAlphabet(input, alphabet string) ([]DetectResult, error) {
+	trimmed := strings.TrimSpace(input)
+	if trimmed == "" {
+		return nil, errors.WithStack(ErrVariantNotDetected)
+	}
+
+	var textual, binary []DetectResult
+	for _, info := range variants {
+		if alphabet != "" {
+			// 自定义字母表的字符特征未知，只按换行区分 MIME
+			if ValidateAlphabet(info.Name, alphabet) != nil || (info.Name == VariantMIME) != strings.ContainsAny(trimmed, "\r\n") {
+				continue
+			}
+		} else if !d.plausible(info.Name, trimmed) {
+			continue
+		}
+		decoded, err := d.decoder.DecodeVariantWithAlphabet(info.Name, alphabet, trimmed, info.Name == VariantMIME)
+		if err != nil || len(decoded) == 0 {
+			continue
+		}
+		result := DetectResult{
+			Variant:   info.Name,
+			Label:     info.Label,
+			IsText:    isPrintableText(decoded),
+			ByteCount: len(decoded),
+		}
+		if result.IsText {
+			result.Decoded = string(decoded)
+			textual = append(textual, result)
+		} else {
+			binary = append(binary, result)
+		}
+	}
+
+	results := append(textual, binary...)
+	if len(results) == 0 {
+		return nil, errors.WithStack(ErrVariantNotDetected)
+	}
+	return results, nil
+}
+
+// plausible 根据字符特征快速排除不可能的变体，减少误判
+func (d *Detector) plausible(variant, input string) bool {
+	hasLineBreak := strings.ContainsAny(input, "\r\n")
+	switch variant {
+	case VariantMIME:
+		return hasLineBreak
+	case VariantStd, VariantStdRaw:
+		return !hasLineBreak && !strings.ContainsAny(input, "-_")
+	case VariantURL, VariantURLRaw:
+		return !hasLineBreak && !strings.ContainsAny(input, "+/")
+	case VariantBase58, VariantBase62:
+		// 纯数字或过短的输入几乎总能被解码，避免误判
+		return len(input) >= 4 && strings.IndexFunc(input, unicode.IsLetter) >= 0
+	default:
+		return !hasLineBreak
+	}
+}
+
+// isPrintableText 判断字节序列是否为可读的 UTF-8 文本
+func isPrintableText(data []byte) bool {
+	if !utf8.Valid(data) {
+		return false
+	}
+	for _, r := range string(data) {
+		if r == '\n' || r == '\r' || r == '\t' {
+			continue
+		}
+		if !unicode.IsPrint(r) {
+			return false
+		}
+	}
+	return true
+}
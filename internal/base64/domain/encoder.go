@@ -1,6 +1,12 @@
 package domain
 
-import "encoding/base64"
+import (
+	"encoding/ascii85"
+	"encoding/base64"
+	"strings"
+
+	"github.com/pkg/errors"
+)
 
 // Encoder 提供 Base64 编码功能
 type Encoder struct{}
@@ -19,3 +25,60 @@ func (e *Encoder) Encode(input string) string {
 func (e *Encoder) EncodeURLSafe(input string) string {
 	return base64.URLEncoding.EncodeToString([]byte(input))
 }
+
+// EncodeVariant 使用指定的编码变体编码字节序列
+func (e *Encoder) EncodeVariant(variant string, data []byte) (string, error) {
+	if variant == VariantMIME {
+		return wrapLines(base64.StdEncoding.EncodeToString(data), mimeLineLength), nil
+	}
+	if enc, ok := base64Encoding(variant); ok {
+		return enc.EncodeToString(data), nil
+	}
+	if enc, ok := base32Encoding(variant); ok {
+		return enc.EncodeToString(data), nil
+	}
+
+	switch variant {
+	case VariantBase58:
+		return base58Codec.Encode(data), nil
+	case VariantBase62:
+		return base62Codec.Encode(data), nil
+	case VariantAscii85:
+		buf := make([]byte, ascii85.MaxEncodedLen(len(data)))
+		n := ascii85.Encode(buf, data)
+		return string(buf[:n]), nil
+	case VariantZ85:
+		return encodeZ85(data, z85Alphabet)
+	case VariantBase45:
+		return encodeBase45(data, base45Alphabet), nil
+	default:
+		return "", errors.Wrapf(ErrUnsupportedVariant, "不支持的编码变体: %s", variant)
+	}
+}
+
+// EncodeVariantWithAlphabet 使用指定变体与自定义字母表编码，alphabet 为空时使用变体的默认字母表
+func (e *Encoder) EncodeVariantWithAlphabet(variant, alphabet string, data []byte) (string, error) {
+	if alphabet == "" {
+		return e.EncodeVariant(variant, data)
+	}
+	return encodeWithAlphabet(variant, alphabet, data)
+}
+
+// wrapLines 按固定列宽使用 CRLF 换行
+func wrapLines(s string, width int) string {
+	if len(s) <= width {
+		return s
+	}
+	var sb strings.Builder
+	for i := 0; i < len(s); i += width {
+		end := i + width
+		if end > len(s) {
+			end = len(s)
+		}
+		if i > 0 {
+			sb.WriteString("\r\n")
+		}
+		sb.WriteString(s[i:end])
+	}
+	return sb.String()
+}
@@ -0,0 +1,35 @@
+package domain
+
+// Base64Error Base64 工具错误类型
+type Base64Error struct {
+	Errmsg string
+}
+
+// Error 实现 error 接口
+func (e Base64Error) Error() string {
+	return e.Errmsg
+}
+
+// 预定义的错误
+var (
+	// ErrUnsupportedVariant 不支持的编码变体
+	ErrUnsupportedVariant = Base64Error{Errmsg: "不支持的编码变体"}
+	// ErrInvalidCharacter 输入包含字母表外的字符
+	ErrInvalidCharacter = Base64Error{Errmsg: "输入包含非法字符"}
+	// ErrInvalidAlphabet 自定义字母表无效
+	ErrInvalidAlphabet = Base64Error{Errmsg: "无效的自定义字母表"}
+	// ErrInvalidLength 输入长度不符合编码要求
+	ErrInvalidLength = Base64Error{Errmsg: "输入长度无效"}
+	// ErrValueOverflow 解码结果超出取值范围
+	ErrValueOverflow = Base64Error{Errmsg: "解码数值溢出"}
+	// ErrVariantNotDetected 无法识别编码变体
+	ErrVariantNotDetected = Base64Error{Errmsg: "无法识别编码变体"}
+	// ErrInvalidDataURI 无效的 data URI
+	ErrInvalidDataURI = Base64Error{Errmsg: "无效的 data URI"}
+	// ErrUnsupportedCodec 不支持的压缩算法
+	ErrUnsupportedCodec = Base64Error{Errmsg: "不支持的压缩算法"}
+	// ErrCodecNotDetected 无法识别压缩算法
+	ErrCodecNotDetected = Base64Error{Errmsg: "无法识别压缩算法"}
+	// ErrDecompressedTooLarge 解压结果过大
+	ErrDecompressedTooLarge = Base64Error{Errmsg: "解压结果过大"}
+)
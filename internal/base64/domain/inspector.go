@@ -0,0 +1,138 @@
+package domain
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+const (
+	// MaxHexDumpBytes 十六进制预览的最大字节数，避免向前端传输过大的文本
+	MaxHexDumpBytes = 4096
+	// MaxImagePreviewBytes 图片预览的最大字节数
+	MaxImagePreviewBytes = 10 * 1024 * 1024
+)
+
+// magicSignatures http.DetectContentType 未覆盖的常见文件签名
+var magicSignatures = []struct {
+	magic    []byte
+	mimeType string
+}{
+	{[]byte{0x28, 0xB5, 0x2F, 0xFD}, "application/zstd"},
+	{[]byte("BZh"), "application/x-bzip2"},
+	{[]byte{0xFD, '7', 'z', 'X', 'Z', 0x00}, "application/x-xz"},
+	{[]byte{'7', 'z', 0xBC, 0xAF, 0x27, 0x1C}, "application/x-7z-compressed"},
+	{[]byte("SQLite format 3\x00"), "application/vnd.sqlite3"},
+	{[]byte{0x7F, 'E', 'L', 'F'}, "application/x-elf"},
+	{[]byte{0x30, 0x82}, "application/pkix-cert"},
+}
+
+// mimeExtensions MIME 类型与常用扩展名的对应关系
+var mimeExtensions = map[string]string{
+	"image/png":                    ".png",
+	"image/jpeg":                   ".jpg",
+	"image/gif":                    ".gif",
+	"image/webp":                   ".webp",
+	"image/bmp":                    ".bmp",
+	"image/x-icon":                 ".ico",
+	"image/svg+xml":                ".svg",
+	"application/pdf":              ".pdf",
+	"application/zip":              ".zip",
+	"application/x-gzip":           ".gz",
+	"application/zstd":             ".zst",
+	"application/x-bzip2":          ".bz2",
+	"application/x-xz":             ".xz",
+	"application/x-7z-compressed":  ".7z",
+	"application/x-rar-compressed": ".rar",
+	"application/vnd.sqlite3":      ".sqlite",
+	"application/wasm":             ".wasm",
+	"application/json":             ".json",
+	"application/pkix-cert":        ".der",
+	"audio/mpeg":                   ".mp3",
+	"audio/wave":                   ".wav",
+	"video/mp4":                    ".mp4",
+	"video/webm":                   ".webm",
+	"font/woff":                    ".woff",
+	"font/woff2":                   ".woff2",
+	"text/html":                    ".html",
+	"text/xml":                     ".xml",
+	"text/plain":                   ".txt",
+}
+
+// DecodedPayload 解码结果的二进制安全描述
+type DecodedPayload struct {
+	Size       int    `json:"size"`
+	IsText     bool   `json:"isText"`
+	Text       string `json:"text,omitempty"`
+	MIMEType   string `json:"mimeType"`
+	Extension  string `json:"extension"`
+	HexDump    string `json:"hexDump,omitempty"`
+	Truncated  bool   `json:"truncated"`
+	PreviewURL string `json:"previewUrl,omitempty"`
+}
+
+// Inspector 提供二进制数据的类型识别与预览功能
+type Inspector struct{}
+
+// NewInspector 创建新的 Inspector 实例
+func NewInspector() *Inspector {
+	return &Inspector{}
+}
+
+// Inspect 分析字节序列：文本直接返回内容，二进制返回十六进制预览，图片额外提供 data URI 预览
+func (i *Inspector) Inspect(data []byte) *DecodedPayload {
+	mimeType := i.DetectMIMEType(data)
+	payload := &DecodedPayload{
+		Size:      len(data),
+		MIMEType:  mimeType,
+		Extension: ExtensionForMIMEType(mimeType),
+	}
+
+	if isPrintableText(data) {
+		payload.IsText = true
+		payload.Text = string(data)
+		return payload
+	}
+
+	dumpData := data
+	if len(dumpData) > MaxHexDumpBytes {
+		dumpData = dumpData[:MaxHexDumpBytes]
+		payload.Truncated = true
+	}
+	payload.HexDump = hex.Dump(dumpData)
+
+	if strings.HasPrefix(mimeType, "image/") && len(data) <= MaxImagePreviewBytes {
+		payload.PreviewURL = "data:" + mimeType + ";base64," + base64.StdEncoding.EncodeToString(data)
+	}
+	return payload
+}
+
+// DetectMIMEType 根据文件签名识别 MIME 类型
+func (i *Inspector) DetectMIMEType(data []byte) string {
+	for _, sig := range magicSignatures {
+		if bytes.HasPrefix(data, sig.magic) {
+			return sig.mimeType
+		}
+	}
+
+	mimeType := http.DetectContentType(data)
+	// 去除 "; charset=utf-8" 等参数
+	if idx := strings.Index(mimeType, ";"); idx >= 0 {
+		mimeType = strings.TrimSpace(mimeType[:idx])
+	}
+	if mimeType == "text/plain" && json.Valid(data) {
+		return "application/json"
+	}
+	return mimeType
+}
+
+// ExtensionForMIMEType 返回 MIME 类型对应的常用扩展名，未知类型返回 ".bin"
+func ExtensionForMIMEType(mimeType string) string {
+	if ext, ok := mimeExtensions[mimeType]; ok {
+		return ext
+	}
+	return ".bin"
+}
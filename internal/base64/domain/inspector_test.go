@@ -0,0 +1,32 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestInspector_Inspect(t *testing.T) {
+	inspector := NewInspector()
+
+	text := inspector.Inspect([]byte("hello\nworld"))
+	if !text.IsText || text.Text != "hello\nworld" || text.HexDump != "" {
+		t.Errorf("Inspect(text) = %+v", text)
+	}
+
+	png := append([]byte("\x89PNG\r\n\x1a\n"), make([]byte, 16)...)
+	image := inspector.Inspect(png)
+	if image.IsText || image.MIMEType != "image/png" || image.Extension != ".png" {
+		t.Errorf("Inspect(png) = %+v", image)
+	}
+	if !strings.HasPrefix(image.PreviewURL, "data:image/png;base64,") {
+		t.Errorf("Inspect(png) preview = %q", image.PreviewURL)
+	}
+	if !strings.HasPrefix(image.HexDump, "00000000  89 50 4e 47") {
+		t.Errorf("Inspect(png) hex dump = %q", image.HexDump)
+	}
+
+	gzip := inspector.Inspect([]byte{0x1f, 0x8b, 0x08, 0x00, 0xff})
+	if gzip.MIMEType != "application/x-gzip" || gzip.PreviewURL != "" {
+		t.Errorf("Inspect(gzip) = %+v", gzip)
+	}
+}
@@ -0,0 +1,95 @@
+package domain
+
+import (
+	"encoding/base32"
+	"encoding/base64"
+)
+
+// 支持的编码变体
+const (
+	VariantStd       = "std"       // 标准 Base64（RFC 4648，带填充）
+	VariantStdRaw    = "std-raw"   // 标准 Base64，无填充
+	VariantURL       = "url"       // URL 安全 Base64（带填充）
+	VariantURLRaw    = "url-raw"   // URL 安全 Base64，无填充（JWT 等场景）
+	VariantMIME      = "mime"      // MIME Base64（RFC 2045，每 76 列换行）
+	VariantBase32    = "base32"    // 标准 Base32（RFC 4648）
+	VariantBase32Hex = "base32hex" // Base32 扩展十六进制字母表（RFC 4648 §7）
+	VariantBase58    = "base58"    // Base58（Bitcoin 字母表）
+	VariantBase62    = "base62"    // Base62（0-9A-Za-z）
+	VariantAscii85   = "ascii85"   // Ascii85（Adobe/btoa）
+	VariantZ85       = "z85"       // Z85（ZeroMQ）
+	VariantBase45    = "base45"    // Base45（RFC 9285）
+)
+
+const (
+	// mimeLineLength MIME Base64 每行最大字符数
+	mimeLineLength = 76
+)
+
+const (
+	base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+	base62Alphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+)
+
+// VariantInfo 编码变体描述
+type VariantInfo struct {
+	Name        string `json:"name"`
+	Label       string `json:"label"`
+	Description string `json:"description"`
+	// Alphabet 默认字母表，自定义字母表须与其长度相同
+	Alphabet string `json:"alphabet"`
+}
+
+// variants 变体列表，同时决定自动识别时的优先级
+var variants = []VariantInfo{
+	{Name: VariantStd, Label: "Base64", Description: "标准字母表，带 = 填充"},
+	{Name: VariantStdRaw, Label: "Base64 (无填充)", Description: "标准字母表，省略 = 填充"},
+	{Name: VariantURL, Label: "Base64URL", Description: "URL 安全字母表（-_），带 = 填充"},
+	{Name: VariantURLRaw, Label: "Base64URL (无填充)", Description: "URL 安全字母表，省略填充，常见于 JWT"},
+	{Name: VariantMIME, Label: "Base64 MIME", Description: "标准字母表，每 76 列使用 CRLF 换行"},
+	{Name: VariantBase32, Label: "Base32", Description: "RFC 4648 标准字母表（A-Z2-7）"},
+	{Name: VariantBase32Hex, Label: "Base32 Hex", Description: "RFC 4648 扩展十六进制字母表（0-9A-V）"},
+	{Name: VariantBase58, Label: "Base58", Description: "Bitcoin 字母表，去除 0OIl"},
+	{Name: VariantBase62, Label: "Base62", Description: "0-9A-Za-z 字母表"},
+	{Name: VariantAscii85, Label: "Ascii85", Description: "Adobe Ascii85，可带 <~ ~> 定界符"},
+	{Name: VariantZ85, Label: "Z85", Description: "ZeroMQ Z85，输入长度须为 4 的倍数"},
+	{Name: VariantBase45, Label: "Base45", Description: "RFC 9285，常见于二维码载荷"},
+}
+
+// ListVariants 返回所有支持的编码变体
+func ListVariants() []VariantInfo {
+	result := make([]VariantInfo, len(variants))
+	copy(result, variants)
+	for i := range result {
+		result[i].Alphabet = defaultAlphabets[result[i].Name]
+	}
+	return result
+}
+
+// base64Encoding 返回 Base64 类变体对应的标准库编码
+func base64Encoding(variant string) (*base64.Encoding, bool) {
+	switch variant {
+	case VariantStd, VariantMIME:
+		return base64.StdEncoding, true
+	case VariantStdRaw:
+		return base64.RawStdEncoding, true
+	case VariantURL:
+		return base64.URLEncoding, true
+	case VariantURLRaw:
+		return base64.RawURLEncoding, true
+	default:
+		return nil, false
+	}
+}
+
+// base32Encoding 返回 Base32 类变体对应的标准库编码
+func base32Encoding(variant string) (*base32.Encoding, bool) {
+	switch variant {
+	case VariantBase32:
+		return base32.StdEncoding, true
+	case VariantBase32Hex:
+		return base32.HexEncoding, true
+	default:
+		return nil, false
+	}
+}
@@ -0,0 +1,184 @@
+package domain
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestEncoder_EncodeVariant_KnownVectors(t *testing.T) {
+	encoder := NewEncoder()
+
+	tests := []struct {
+		variant string
+		input   []byte
+		want    string
+	}{
+		{VariantStdRaw, []byte("hi"), "aGk"},
+		{VariantURLRaw, []byte{0xfb, 0xff}, "-_8"},
+		{VariantBase32, []byte("foobar"), "MZXW6YTBOI======"},
+		{VariantBase32Hex, []byte("foobar"), "CPNMUOJ1E8======"},
+		{VariantBase58, []byte("Hello World!"), "2NEpo7TZRRrLZSi2U"},
+		{VariantBase58, []byte{0, 0, 1}, "112"},
+		{VariantBase62, []byte{0xff}, "47"},
+		{VariantAscii85, []byte("Man "), "9jqo^"},
+		{VariantZ85, []byte{0x86, 0x4F, 0xD2, 0x6F, 0xB5, 0x59, 0xF7, 0x5B}, "HelloWorld"},
+		{VariantBase45, []byte("AB"), "BB8"},
+		{VariantBase45, []byte("ietf!"), "QED8WEX0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.variant, func(t *testing.T) {
+			got, err := encoder.EncodeVariant(tt.variant, tt.input)
+			if err != nil {
+				t.Fatalf("EncodeVariant() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("EncodeVariant() = %q, want %q", got, tt.want)
+			}
+
+			decoded, err := NewDecoder().DecodeVariant(tt.variant, got, false)
+			if err != nil {
+				t.Fatalf("DecodeVariant() error = %v", err)
+			}
+			if !bytes.Equal(decoded, tt.input) {
+				t.Errorf("DecodeVariant() = %v, want %v", decoded, tt.input)
+			}
+		})
+	}
+}
+
+func TestEncoder_EncodeVariant_MIMEWrapsAt76(t *testing.T) {
+	encoded, err := NewEncoder().EncodeVariant(VariantMIME, bytes.Repeat([]byte("a"), 100))
+	if err != nil {
+		t.Fatalf("EncodeVariant() error = %v", err)
+	}
+
+	lines := strings.Split(encoded, "\r\n")
+	if len(lines) != 2 || len(lines[0]) != 76 {
+		t.Errorf("EncodeVariant(mime) lines = %d, first = %d", len(lines), len(lines[0]))
+	}
+}
+
+func TestDecoder_DecodeVariant_Lenient(t *testing.T) {
+	decoder := NewDecoder()
+
+	if _, err := decoder.DecodeVariant(VariantStd, "aGVs bG8", false); err == nil {
+		t.Errorf("DecodeVariant(strict) should reject whitespace and missing padding")
+	}
+
+	decoded, err := decoder.DecodeVariant(VariantStd, " aGVs\nbG8 ", true)
+	if err != nil || string(decoded) != "hello" {
+		t.Errorf("DecodeVariant(lenient) = %q, %v", decoded, err)
+	}
+
+	decoded, err = decoder.DecodeVariant(VariantBase32, "mzxw6ytboi", true)
+	if err != nil || string(decoded) != "foobar" {
+		t.Errorf("DecodeVariant(base32 lenient) = %q, %v", decoded, err)
+	}
+}
+
+func TestDetector_Detect(t *testing.T) {
+	detector := NewDetector()
+
+	results, err := detector.Detect("eyJhbGciOiJIUzI1NiJ9")
+	if err != nil {
+		t.Fatalf("Detect() error = %v", err)
+	}
+	if results[0].Decoded != `{"alg":"HS256"}` {
+		t.Errorf("Detect() best = %+v", results[0])
+	}
+
+	results, err = detector.Detect("MZXW6YTBOI======")
+	if err != nil {
+		t.Fatalf("Detect() error = %v", err)
+	}
+	if results[0].Variant != VariantBase32 {
+		t.Errorf("Detect() best = %+v", results[0])
+	}
+}
+
+func TestVariantWithAlphabet_RoundTrip(t *testing.T) {
+	encoder := NewEncoder()
+	decoder := NewDecoder()
+	// 20 字节满足 Z85 的 4 字节对齐，全零分组覆盖 Ascii85 的 z 缩写
+	data := []byte("custom alphabet \x00\x00\x00\x00")
+
+	reverse := func(s string) string {
+		r := []byte(s)
+		for i, j := 0, len(r)-1; i < j; i, j = i+1, j-1 {
+			r[i], r[j] = r[j], r[i]
+		}
+		return string(r)
+	}
+
+	for _, info := range ListVariants() {
+		t.Run(info.Name, func(t *testing.T) {
+			alphabet := reverse(info.Alphabet)
+			encoded, err := encoder.EncodeVariantWithAlphabet(info.Name, alphabet, data)
+			if err != nil {
+				t.Fatalf("EncodeVariantWithAlphabet() error = %v", err)
+			}
+			standard, _ := encoder.EncodeVariant(info.Name, data)
+			if encoded == standard {
+				t.Errorf("custom alphabet produced the standard encoding %q", encoded)
+			}
+			decoded, err := decoder.DecodeVariantWithAlphabet(info.Name, alphabet, encoded, false)
+			if err != nil || string(decoded) != string(data) {
+				t.Errorf("DecodeVariantWithAlphabet() = %q, %v", decoded, err)
+			}
+		})
+	}
+
+	// 空字母表退回默认字母表
+	encoded, err := encoder.EncodeVariantWithAlphabet(VariantStd, "", []byte("hello"))
+	if err != nil || encoded != "aGVsbG8=" {
+		t.Errorf("EncodeVariantWithAlphabet(empty) = %q, %v", encoded, err)
+	}
+}
+
+func TestValidateAlphabet(t *testing.T) {
+	tests := []struct {
+		name     string
+		variant  string
+		alphabet string
+	}{
+		{"长度不符", VariantBase58, "abc"},
+		{"字符重复", VariantBase32, "AACDEFGHIJKLMNOPQRSTUVWXYZ234567"},
+		{"包含填充字符", VariantBase32, "=BCDEFGHIJKLMNOPQRSTUVWXYZ234567"},
+		{"不可打印字符", VariantBase32, "\tBCDEFGHIJKLMNOPQRSTUVWXYZ234567"},
+		{"空格", VariantBase32, " BCDEFGHIJKLMNOPQRSTUVWXYZ234567"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateAlphabet(tt.variant, tt.alphabet); !errors.Is(err, ErrInvalidAlphabet) {
+				t.Errorf("ValidateAlphabet() error = %v, want ErrInvalidAlphabet", err)
+			}
+		})
+	}
+	if err := ValidateAlphabet(VariantBase45, base45Alphabet); err != nil {
+		t.Errorf("ValidateAlphabet(base45 default) error = %v", err)
+	}
+}
+
+func TestDetector_DetectWithAlphabet(t *testing.T) {
+	alphabet := "ZYXWVUTSRQPONMLKJIHGFEDCBA765432"
+	encoded, err := NewEncoder().EncodeVariantWithAlphabet(VariantBase32, alphabet, []byte("foobar"))
+	if err != nil {
+		t.Fatalf("EncodeVariantWithAlphabet() error = %v", err)
+	}
+
+	results, err := NewDetector().DetectWithAlphabet(encoded, alphabet)
+	if err != nil {
+		t.Fatalf("DetectWithAlphabet() error = %v", err)
+	}
+	if results[0].Decoded != "foobar" {
+		t.Errorf("DetectWithAlphabet() best = %+v", results[0])
+	}
+	for _, r := range results {
+		if r.Variant != VariantBase32 && r.Variant != VariantBase32Hex {
+			t.Errorf("DetectWithAlphabet() tried %s with a 32-character alphabet", r.Variant)
+		}
+	}
+}
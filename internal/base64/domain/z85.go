@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"encoding/binary"
+
+	"github.com/pkg/errors"
+)
+
+// z85Alphabet ZeroMQ Z85 字母表（RFC 32/Z85）
+const z85Alphabet = "0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ.-:+=^!/*?&<>()[]{}@%$#"
+
+// encodeZ85 按 Z85 规范编码，输入长度必须为 4 的倍数
+func encodeZ85(data []byte, alphabet string) (string, error) {
+	if len(data)%4 != 0 {
+		return "", errors.Wrapf(ErrInvalidLength, "Z85 编码要求输入长度为 4 的倍数，当前 %d 字节", len(data))
+	}
+
+	result := make([]byte, 0, len(data)/4*5)
+	for i := 0; i < len(data); i += 4 {
+		value := binary.BigEndian.Uint32(data[i : i+4])
+		var chunk [5]byte
+		for j := 4; j >= 0; j-- {
+			chunk[j] = alphabet[value%85]
+			value /= 85
+		}
+		result = append(result, chunk[:]...)
+	}
+	return string(result), nil
+}
+
+// decodeZ85 按 Z85 规范解码，输入长度必须为 5 的倍数
+func decodeZ85(input, alphabet string) ([]byte, error) {
+	if len(input)%5 != 0 {
+		return nil, errors.Wrapf(ErrInvalidLength, "Z85 解码要求输入长度为 5 的倍数，当前 %d 字符", len(input))
+	}
+
+	indexes := alphabetIndexes(alphabet)
+	result := make([]byte, 0, len(input)/5*4)
+	for i := 0; i < len(input); i += 5 {
+		var value uint64
+		for j := 0; j < 5; j++ {
+			index := indexes[input[i+j]]
+			if index < 0 {
+				return nil, errors.Wrapf(ErrInvalidCharacter, "位置 %d 的字符 %q 不属于 Z85 字母表", i+j, input[i+j])
+			}
+			value = value*85 + uint64(index)
+		}
+		if value > 0xffffffff {
+			return nil, errors.Wrapf(ErrValueOverflow, "位置 %d 的分组超出 32 位范围", i)
+		}
+		var chunk [4]byte
+		binary.BigEndian.PutUint32(chunk[:], uint32(value))
+		result = append(result, chunk[:]...)
+	}
+	return result, nil
+}
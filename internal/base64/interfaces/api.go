@@ -2,6 +2,7 @@ package interfaces
 
 import (
 	"github.com/cyrnicolase/dev-tools/internal/base64/application"
+	"github.com/cyrnicolase/dev-tools/internal/base64/domain"
 	historydomain "github.com/cyrnicolase/dev-tools/internal/history/domain"
 )
 
@@ -47,6 +48,101 @@ func (a *API) ValidateURLSafe(input string) bool {
 	return a.service.ValidateURLSafe(input)
 }
 
+// ListVariants 获取支持的编码变体
+func (a *API) ListVariants() []domain.VariantInfo {
+	return a.service.ListVariants()
+}
+
+// EncodeVariant 使用指定编码变体编码
+func (a *API) EncodeVariant(variant, input string) (string, error) {
+	return a.service.EncodeVariant(variant, input)
+}
+
+// DecodeVariant 使用指定编码变体解码，lenient 为 true 时忽略空白并容忍缺失填充
+func (a *API) DecodeVariant(variant, input string, lenient bool) (string, error) {
+	return a.service.DecodeVariant(variant, input, lenient)
+}
+
+// DetectVariant 自动识别编码变体
+func (a *API) DetectVariant(input string) ([]domain.DetectResult, error) {
+	return a.service.DetectVariant(input)
+}
+
+// EncodeVariantWithAlphabet 使用自定义字母表编码，alphabet 为空时使用默认字母表
+func (a *API) EncodeVariantWithAlphabet(variant, alphabet, input string) (string, error) {
+	return a.service.EncodeVariantWithAlphabet(variant, alphabet, input)
+}
+
+// DecodeVariantWithAlphabet 使用自定义字母表解码，alphabet 为空时使用默认字母表
+func (a *API) DecodeVariantWithAlphabet(variant, alphabet, input string, lenient bool) (string, error) {
+	return a.service.DecodeVariantWithAlphabet(variant, alphabet, input, lenient)
+}
+
+// DetectVariantWithAlphabet 使用自定义字母表识别编码变体
+func (a *API) DetectVariantWithAlphabet(input, alphabet string) ([]domain.DetectResult, error) {
+	return a.service.DetectVariantWithAlphabet(input, alphabet)
+}
+
+// ValidateAlphabet 校验自定义字母表
+func (a *API) ValidateAlphabet(variant, alphabet string) error {
+	return a.service.ValidateAlphabet(variant, alphabet)
+}
+
+// EncodeBytes 使用指定编码变体编码字节序列
+func (a *API) EncodeBytes(variant string, data []byte) (string, error) {
+	return a.service.EncodeBytes(variant, data)
+}
+
+// DecodeBytes 使用指定编码变体解码为原始字节
+func (a *API) DecodeBytes(variant, input string, lenient bool) ([]byte, error) {
+	return a.service.DecodeBytes(variant, input, lenient)
+}
+
+// DecodeBinary 二进制安全解码
+func (a *API) DecodeBinary(variant, input string, lenient bool) (*domain.DecodedPayload, error) {
+	return a.service.DecodeBinary(variant, input, lenient)
+}
+
+// DetectMIMEType 根据文件签名识别 MIME 类型
+func (a *API) DetectMIMEType(data []byte) string {
+	return a.service.DetectMIMEType(data)
+}
+
+// BuildDataURI 构建 data URI
+func (a *API) BuildDataURI(data []byte, mediaType string, useBase64 bool) string {
+	return a.service.BuildDataURI(data, mediaType, useBase64)
+}
+
+// ParseDataURI 解析 data URI
+func (a *API) ParseDataURI(uri string) (*domain.DataURIInfo, error) {
+	return a.service.ParseDataURI(uri)
+}
+
+// DecodeDataURI 解析 data URI 并返回原始数据与媒体类型
+func (a *API) DecodeDataURI(uri string) ([]byte, string, error) {
+	return a.service.DecodeDataURI(uri)
+}
+
+// CompressBytes 使用指定算法压缩字节序列
+func (a *API) CompressBytes(codec string, data []byte, level int) ([]byte, error) {
+	return a.service.CompressBytes(codec, data, level)
+}
+
+// Compress 压缩文本并包装为十六进制或 Base64
+func (a *API) Compress(codec, wrap, input string, level int) (*application.CompressResult, error) {
+	return a.service.Compress(codec, wrap, input, level)
+}
+
+// Decompress 解除包装并解压
+func (a *API) Decompress(codec, wrap, input string, formatJSON bool) (*application.DecompressResult, error) {
+	return a.service.Decompress(codec, wrap, input, formatJSON)
+}
+
+// DecompressBytes 解压原始字节序列
+func (a *API) DecompressBytes(codec string, data []byte, formatJSON bool) (*application.DecompressResult, error) {
+	return a.service.DecompressBytes(codec, data, formatJSON)
+}
+
 // ListHistory 获取历史记录
 func (a *API) ListHistory() ([]historydomain.ToolHistoryRecord, error) {
 	return a.service.ListHistory()
@@ -0,0 +1,182 @@
+package application
+
+import (
+	"strings"
+	"time"
+
+	"github.com/cyrnicolase/dev-tools/internal/cron/domain"
+	historydomain "github.com/cyrnicolase/dev-tools/internal/history/domain"
+	timestampdomain "github.com/cyrnicolase/dev-tools/internal/timestamp/domain"
+	"github.com/pkg/errors"
+)
+
+// Service cron 工具服务层
+type Service struct {
+	parser         *domain.Parser
+	builder        *domain.Builder
+	formatter      *timestampdomain.Formatter
+	smartParser    *timestampdomain.SmartParser
+	historyStore   *historydomain.ToolHistoryStore
+	historyInitErr error
+}
+
+const cronToolID = "cron"
+
+// NextRun 一次触发时间的展示信息
+type NextRun struct {
+	// Time 按所选格式与时区格式化的时间
+	Time      string `json:"time"`
+	Timestamp int64  `json:"timestamp"`
+	UTC       string `json:"utc"`
+	Weekday   string `json:"weekday"`
+	// Flag 夏令时标记：dst-gap 表示本地时间不存在，dst-ambiguous 表示本地时间出现两次
+	Flag string `json:"flag"`
+	Note string `json:"note"`
+}
+
+// NextRunsResult 触发时间预览结果
+type NextRunsResult struct {
+	Expression  string              `json:"expression"`
+	Dialect     string              `json:"dialect"`
+	Timezone    string              `json:"timezone"`
+	Explanation *domain.Explanation `json:"explanation"`
+	Runs        []NextRun           `json:"runs"`
+}
+
+// BuildResult 表单构建结果
+type BuildResult struct {
+	Expression  string              `json:"expression"`
+	Explanation *domain.Explanation `json:"explanation"`
+}
+
+// NewService 创建新的 Service 实例
+func NewService() *Service {
+	historyStore, historyErr := historydomain.NewToolHistoryStore()
+	return &Service{
+		parser:         domain.NewParser(),
+		builder:        domain.NewBuilder(),
+		formatter:      timestampdomain.NewFormatter(nil),
+		smartParser:    timestampdomain.NewSmartParser(nil),
+		historyStore:   historyStore,
+		historyInitErr: historyErr,
+	}
+}
+
+// Explain 解析表达式并生成中英文说明
+func (s *Service) Explain(expression, dialect string) (*domain.Explanation, error) {
+	schedule, err := s.parser.Parse(expression, dialect)
+	if err != nil {
+		return nil, err
+	}
+	return schedule.Explain(), nil
+}
+
+// NextRuns 计算 from 之后 count 次触发时间，from 为空时从当前时间开始
+// 时间按所选时区计算并使用时间戳工具的格式输出，夏令时导致跳过或重复的触发会被标记
+func (s *Service) NextRuns(expression, dialect, from string, count int, format, timezone string) (*NextRunsResult, error) {
+	if count <= 0 {
+		count = domain.DefaultRunCount
+	}
+	if count > domain.MaxRunCount {
+		return nil, errors.Wrapf(domain.ErrInvalidCount, "最多预览 %d 次", domain.MaxRunCount)
+	}
+	loc, err := timestampdomain.LoadLocation(timezone)
+	if err != nil {
+		return nil, err
+	}
+	schedule, err := s.parser.Parse(expression, dialect)
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	if strings.TrimSpace(from) != "" {
+		if start, err = s.smartParser.ParseTime(from, timezone); err != nil {
+			return nil, err
+		}
+	}
+
+	runs := schedule.Next(start, loc, count)
+	if len(runs) == 0 {
+		return nil, errors.Wrapf(domain.ErrNoNextRun, "表达式 %s 在可预见的时间内不会触发", expression)
+	}
+
+	result := &NextRunsResult{
+		Expression:  expression,
+		Dialect:     schedule.Dialect,
+		Timezone:    loc.String(),
+		Explanation: schedule.Explain(),
+		Runs:        make([]NextRun, 0, len(runs)),
+	}
+	for _, run := range runs {
+		formatted, err := s.formatter.FormatTime(run.Time, format, timezone)
+		if err != nil {
+			return nil, err
+		}
+		result.Runs = append(result.Runs, NextRun{
+			Time:      formatted,
+			Timestamp: run.Time.Unix(),
+			UTC:       run.Time.UTC().Format(time.RFC3339),
+			Weekday:   run.Time.In(loc).Weekday().String(),
+			Flag:      run.Flag,
+			Note:      runNote(run),
+		})
+	}
+	return result, nil
+}
+
+// runNote 生成夏令时标记的说明
+func runNote(run domain.Run) string {
+	switch run.Flag {
+	case domain.RunFlagDSTGap:
+		return "本地时间 " + run.Wall + " 因夏令时开始而不存在，此处按时钟拨快后的第一个时刻计算，部分 cron 实现会跳过本次触发"
+	case domain.RunFlagDSTAmbiguous:
+		return "本地时间 " + run.Wall + " 因夏令时结束而出现两次，部分 cron 实现可能执行两次"
+	}
+	return ""
+}
+
+// Build 根据表单选项构建表达式
+func (s *Service) Build(opts domain.BuildOptions) (*BuildResult, error) {
+	expression, err := s.builder.Build(opts)
+	if err != nil {
+		return nil, err
+	}
+	explanation, err := s.Explain(expression, opts.Dialect)
+	if err != nil {
+		return nil, err
+	}
+	return &BuildResult{Expression: expression, Explanation: explanation}, nil
+}
+
+// ListHistory 获取历史记录
+func (s *Service) ListHistory() ([]historydomain.ToolHistoryRecord, error) {
+	if s.historyInitErr != nil || s.historyStore == nil {
+		return nil, s.historyUnavailableError()
+	}
+	return s.historyStore.List(cronToolID)
+}
+
+// AddHistory 添加历史记录
+func (s *Service) AddHistory(record historydomain.ToolHistoryRecord) ([]historydomain.ToolHistoryRecord, error) {
+	if s.historyInitErr != nil || s.historyStore == nil {
+		return nil, s.historyUnavailableError()
+	}
+	record.ToolID = cronToolID
+	return s.historyStore.Add(record)
+}
+
+// ClearHistory 清空历史记录
+func (s *Service) ClearHistory() error {
+	if s.historyInitErr != nil || s.historyStore == nil {
+		return s.historyUnavailableError()
+	}
+	return s.historyStore.Clear(cronToolID)
+}
+
+func (s *Service) historyUnavailableError() error {
+	if s.historyInitErr != nil {
+		return s.historyInitErr
+	}
+	return errors.WithStack(historydomain.ErrHistoryStoreUnavailable)
+}
@@ -0,0 +1,150 @@
+package domain
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// 表单构建支持的频率
+const (
+	FrequencySecond = "second"
+	FrequencyMinute = "minute"
+	FrequencyHour   = "hour"
+	FrequencyDay    = "day"
+	FrequencyWeek   = "week"
+	FrequencyMonth  = "month"
+	FrequencyYear   = "year"
+)
+
+// BuildOptions 表单构建选项
+type BuildOptions struct {
+	// Dialect 输出方言：standard、seconds、quartz
+	Dialect string `json:"dialect"`
+	// Frequency 频率：second、minute、hour、day、week、month、year
+	Frequency string `json:"frequency"`
+	// Interval 间隔，如每 15 分钟；小于等于 1 时表示每个单位
+	Interval int `json:"interval"`
+	Second   int `json:"second"`
+	Minute   int `json:"minute"`
+	Hour     int `json:"hour"`
+	// Weekdays 星期（0 为周日），用于 week 频率
+	Weekdays []int `json:"weekdays"`
+	// MonthDays 日期（1-31），用于 month、year 频率
+	MonthDays []int `json:"monthDays"`
+	// LastDayOfMonth 在每月最后一天触发（standard 不支持）
+	LastDayOfMonth bool `json:"lastDayOfMonth"`
+	// Months 月份（1-12），为空表示每月
+	Months []int `json:"months"`
+}
+
+// Builder 根据表单选项构建 cron 表达式
+type Builder struct {
+	parser *Parser
+}
+
+// NewBuilder 创建新的 Builder 实例
+func NewBuilder() *Builder {
+	return &Builder{
+		parser: NewParser(),
+	}
+}
+
+// Build 根据表单选项构建表达式，并使用解析器校验结果
+func (b *Builder) Build(opts BuildOptions) (string, error) {
+	dialect := opts.Dialect
+	if dialect == "" || dialect == DialectAuto {
+		dialect = DialectStandard
+	}
+	if dialect != DialectStandard && dialect != DialectSeconds && dialect != DialectQuartz {
+		return "", errors.Wrapf(ErrUnsupportedDialect, "不支持的方言: %s", opts.Dialect)
+	}
+
+	interval := func(unitMin int) string {
+		if opts.Interval <= 1 {
+			return "*"
+		}
+		if unitMin == 0 {
+			return "*/" + strconv.Itoa(opts.Interval)
+		}
+		// 日与月从 1 开始，使用 1/n 避免 */n 在不同实现中的差异
+		return strconv.Itoa(unitMin) + "/" + strconv.Itoa(opts.Interval)
+	}
+
+	second, minute, hour := strconv.Itoa(opts.Second), strconv.Itoa(opts.Minute), strconv.Itoa(opts.Hour)
+	dom, month, dow := "*", joinInts(opts.Months, "*"), "*"
+	switch opts.Frequency {
+	case FrequencySecond:
+		if dialect == DialectStandard {
+			return "", errors.Wrap(ErrInvalidBuildOptions, "标准 cron 不支持按秒触发，请选择带秒或 Quartz 格式")
+		}
+		second, minute, hour = interval(0), "*", "*"
+	case FrequencyMinute:
+		minute, hour = interval(0), "*"
+	case FrequencyHour:
+		hour = interval(0)
+	case FrequencyDay:
+		dom = interval(1)
+	case FrequencyWeek:
+		if len(opts.Weekdays) == 0 {
+			return "", errors.Wrap(ErrInvalidBuildOptions, "按周触发时至少选择一天")
+		}
+		weekdays := opts.Weekdays
+		if dialect == DialectQuartz {
+			weekdays = make([]int, len(opts.Weekdays))
+			for i, day := range opts.Weekdays {
+				weekdays[i] = day + 1
+			}
+		}
+		dow = joinInts(weekdays, "*")
+	case FrequencyMonth, FrequencyYear:
+		switch {
+		case opts.LastDayOfMonth && dialect == DialectStandard:
+			return "", errors.Wrap(ErrInvalidBuildOptions, "标准 cron 不支持月末（L），请选择带秒或 Quartz 格式")
+		case opts.LastDayOfMonth:
+			dom = "L"
+		case len(opts.MonthDays) == 0:
+			dom = "1"
+		default:
+			dom = joinInts(opts.MonthDays, "1")
+		}
+		if opts.Frequency == FrequencyMonth && opts.Interval > 1 && len(opts.Months) == 0 {
+			month = interval(1)
+		}
+		if opts.Frequency == FrequencyYear && len(opts.Months) == 0 {
+			month = "1"
+		}
+	default:
+		return "", errors.Wrapf(ErrInvalidBuildOptions, "不支持的频率: %s", opts.Frequency)
+	}
+
+	if dialect == DialectQuartz {
+		if dow == "*" {
+			dow = "?"
+		} else {
+			dom = "?"
+		}
+	}
+	fields := []string{second, minute, hour, dom, month, dow}
+	if dialect == DialectStandard {
+		fields = fields[1:]
+	}
+	expression := strings.Join(fields, " ")
+	if _, err := b.parser.Parse(expression, dialect); err != nil {
+		return "", errors.Wrap(ErrInvalidBuildOptions, err.Error())
+	}
+	return expression, nil
+}
+
+// joinInts 用逗号连接整数列表，为空时返回默认值
+func joinInts(values []int, fallback string) string {
+	if len(values) == 0 {
+		return fallback
+	}
+	parts := make([]string, len(values))
+	for i, v := range values {
+		parts[i] = strconv.Itoa(v)
+	}
+	return strings.Join(parts, ",")
+}
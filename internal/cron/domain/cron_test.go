@@ -0,0 +1,275 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+func TestParseDialects(t *testing.T) {
+	parser := NewParser()
+	tests := []struct {
+		expression string
+		dialect    string
+		want       string
+	}{
+		{expression: "*/15 9-17 * * 1-5", want: DialectStandard},
+		{expression: "@daily", want: DialectStandard},
+		{expression: "0 */5 * * * *", want: DialectSeconds},
+		{expression: "0 0 12 ? * 6#3", want: DialectQuartz},
+		{expression: "0 0 9 * JUL WED", want: DialectSeconds},
+		{expression: "0 0/30 8-10 ? * 2-6 2025", want: DialectQuartz},
+		{expression: "0 0 9 L * ?", dialect: DialectQuartz, want: DialectQuartz},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expression, func(t *testing.T) {
+			schedule, err := parser.Parse(tt.expression, tt.dialect)
+			if err != nil {
+				t.Fatalf("Parse(%q) error = %v", tt.expression, err)
+			}
+			if schedule.Dialect != tt.want {
+				t.Errorf("Parse(%q) dialect = %q, want %q", tt.expression, schedule.Dialect, tt.want)
+			}
+		})
+	}
+
+	invalid := []struct {
+		expression string
+		dialect    string
+		wantErr    error
+	}{
+		{expression: "", wantErr: ErrEmptyExpression},
+		{expression: "* * * *", wantErr: ErrInvalidExpression},
+		{expression: "60 * * * *", wantErr: ErrInvalidField},
+		{expression: "* 5-3 * * *", wantErr: ErrInvalidField},
+		{expression: "0 0 L * *", wantErr: ErrInvalidField},
+		{expression: "0 0 12 * * 6#3", dialect: DialectQuartz, wantErr: ErrInvalidExpression},
+		{expression: "0 0 12 * * *", dialect: "vixie", wantErr: ErrUnsupportedDialect},
+		{expression: "@reboot", wantErr: ErrInvalidExpression},
+	}
+	for _, tt := range invalid {
+		if _, err := parser.Parse(tt.expression, tt.dialect); !errors.Is(err, tt.wantErr) {
+			t.Errorf("Parse(%q) error = %v, want %v", tt.expression, err, tt.wantErr)
+		}
+	}
+}
+
+func TestNext(t *testing.T) {
+	parser := NewParser()
+	shanghai, _ := time.LoadLocation("Asia/Shanghai")
+	// 2024-01-31 是周三
+	from := time.Date(2024, 1, 31, 10, 0, 0, 0, shanghai)
+
+	tests := []struct {
+		name       string
+		expression string
+		want       []string
+	}{
+		{
+			name:       "工作日每 15 分钟",
+			expression: "*/15 9-17 * * 1-5",
+			want:       []string{"2024-01-31 10:15:00", "2024-01-31 10:30:00", "2024-01-31 10:45:00"},
+		},
+		{
+			name:       "日与周同时受限时取并集",
+			expression: "0 9 1 * 5",
+			want:       []string{"2024-02-01 09:00:00", "2024-02-02 09:00:00", "2024-02-09 09:00:00"},
+		},
+		{
+			name:       "Quartz 每月第三个周五",
+			expression: "0 0 12 ? * 6#3",
+			want:       []string{"2024-02-16 12:00:00", "2024-03-15 12:00:00", "2024-04-19 12:00:00"},
+		},
+		{
+			name:       "Quartz 月末",
+			expression: "0 0 18 L * ?",
+			want:       []string{"2024-01-31 18:00:00", "2024-02-29 18:00:00", "2024-03-31 18:00:00"},
+		},
+		{
+			name:       "Quartz 月末最后一个工作日",
+			expression: "0 0 9 LW * ?",
+			want:       []string{"2024-02-29 09:00:00", "2024-03-29 09:00:00", "2024-04-30 09:00:00"},
+		},
+		{
+			name:       "Quartz 单独的 L 为周六",
+			expression: "0 0 12 ? * L",
+			want:       []string{"2024-02-03 12:00:00", "2024-02-10 12:00:00", "2024-02-17 12:00:00"},
+		},
+		{
+			name:       "闰日",
+			expression: "0 0 29 2 *",
+			want:       []string{"2024-02-29 00:00:00", "2028-02-29 00:00:00", "2032-02-29 00:00:00"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule, err := parser.Parse(tt.expression, DialectAuto)
+			if err != nil {
+				t.Fatalf("Parse(%q) error = %v", tt.expression, err)
+			}
+			runs := schedule.Next(from, shanghai, len(tt.want))
+			if len(runs) != len(tt.want) {
+				t.Fatalf("Next() returned %d runs, want %d", len(runs), len(tt.want))
+			}
+			for i, run := range runs {
+				if got := run.Time.Format(time.DateTime); got != tt.want[i] {
+					t.Errorf("Next()[%d] = %s, want %s", i, got, tt.want[i])
+				}
+			}
+		})
+	}
+
+	// 带秒格式中 7 是周日，单独的 L 仍应落在周六
+	seconds, err := parser.Parse("0 0 12 * * L", DialectSeconds)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if runs := seconds.Next(from, shanghai, 1); len(runs) != 1 || runs[0].Time.Weekday() != time.Saturday {
+		t.Errorf("Next() for seconds L = %v, want Saturday", runs)
+	}
+
+	schedule, _ := parser.Parse("0 0 30 2 *", DialectAuto)
+	if runs := schedule.Next(from, shanghai, 1); len(runs) != 0 {
+		t.Errorf("Next() for Feb 30 = %v, want none", runs)
+	}
+}
+
+func TestNextDST(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	schedule, err := NewParser().Parse("30 2 * * *", DialectAuto)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	// 2024-03-31 柏林 02:00 跳到 03:00，02:30 不存在
+	runs := schedule.Next(time.Date(2024, 3, 30, 12, 0, 0, 0, berlin), berlin, 2)
+	if runs[0].Flag != RunFlagDSTGap || runs[0].Wall != "2024-03-31 02:30:00" || runs[0].Time.Format("15:04 MST") != "03:00 CEST" {
+		t.Errorf("Next() on spring forward = %+v", runs[0])
+	}
+	if runs[1].Flag != RunFlagNone {
+		t.Errorf("Next() after spring forward flag = %q", runs[1].Flag)
+	}
+
+	// 2026-03-08 纽约 02:00 跳到 03:00：time.Date 会把 02:30 放到空档之前（01:30 EST），
+	// 修正后应在 03:00 EDT 触发并保留标记，不能与 01:30 的触发合并
+	newYork, _ := time.LoadLocation("America/New_York")
+	gapSchedule, err := NewParser().Parse("30 1,2 * * *", DialectAuto)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	runs = gapSchedule.Next(time.Date(2026, 3, 8, 0, 0, 0, 0, newYork), newYork, 3)
+	want := []struct{ time, flag string }{
+		{"2026-03-08 01:30 EST", RunFlagNone},
+		{"2026-03-08 03:00 EDT", RunFlagDSTGap},
+		{"2026-03-09 01:30 EDT", RunFlagNone},
+	}
+	for i, w := range want {
+		if got := runs[i].Time.Format("2006-01-02 15:04 MST"); got != w.time || runs[i].Flag != w.flag {
+			t.Errorf("Next()[%d] = %s %q, want %s %q", i, got, runs[i].Flag, w.time, w.flag)
+		}
+	}
+
+	// 2024-10-27 柏林 03:00 回拨到 02:00，02:30 出现两次
+	runs = schedule.Next(time.Date(2024, 10, 26, 12, 0, 0, 0, berlin), berlin, 2)
+	if runs[0].Flag != RunFlagDSTAmbiguous {
+		t.Errorf("Next() on fall back flag = %q, want %q", runs[0].Flag, RunFlagDSTAmbiguous)
+	}
+}
+
+func TestExplain(t *testing.T) {
+	parser := NewParser()
+	tests := []struct {
+		expression   string
+		english      string
+		chinese      string
+		wantWarnings int
+	}{
+		{
+			expression: "*/15 9-17 * * 1-5",
+			english:    "Every 15 minutes, between 09:00 and 17:59, on Monday through Friday",
+			chinese:    "周一至周五，9点至17点之间，每 15 分钟",
+		},
+		{
+			expression: "30 9 * * MON-FRI",
+			english:    "At 09:30, on Monday through Friday",
+			chinese:    "周一至周五，09:30",
+		},
+		{
+			expression:   "0 0 12 ? * 6#3",
+			english:      "At 12:00, on the third Friday of the month",
+			chinese:      "每月第三个周五，12:00",
+			wantWarnings: 1,
+		},
+		{
+			expression:   "0 0 1,15 * 3",
+			english:      "At 00:00, on day 1 and day 15 of the month or on Wednesday",
+			chinese:      "每月1日、15日或周三，00:00",
+			wantWarnings: 1,
+		},
+		{
+			expression: "*/10 * * * * *",
+			english:    "Every 10 seconds, every day",
+			chinese:    "每天，每 10 秒",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expression, func(t *testing.T) {
+			schedule, err := parser.Parse(tt.expression, DialectAuto)
+			if err != nil {
+				t.Fatalf("Parse(%q) error = %v", tt.expression, err)
+			}
+			explanation := schedule.Explain()
+			if explanation.English != tt.english {
+				t.Errorf("English = %q, want %q", explanation.English, tt.english)
+			}
+			if explanation.Chinese != tt.chinese {
+				t.Errorf("Chinese = %q, want %q", explanation.Chinese, tt.chinese)
+			}
+			if len(explanation.Warnings) != tt.wantWarnings {
+				t.Errorf("Warnings = %v, want %d", explanation.Warnings, tt.wantWarnings)
+			}
+		})
+	}
+}
+
+func TestBuild(t *testing.T) {
+	builder := NewBuilder()
+	tests := []struct {
+		name string
+		opts BuildOptions
+		want string
+	}{
+		{name: "每 15 分钟", opts: BuildOptions{Frequency: FrequencyMinute, Interval: 15}, want: "*/15 * * * *"},
+		{name: "每天", opts: BuildOptions{Frequency: FrequencyDay, Hour: 9, Minute: 30}, want: "30 9 * * *"},
+		{name: "工作日", opts: BuildOptions{Frequency: FrequencyWeek, Hour: 9, Weekdays: []int{1, 2, 3, 4, 5}}, want: "0 9 * * 1,2,3,4,5"},
+		{name: "Quartz 工作日", opts: BuildOptions{Dialect: DialectQuartz, Frequency: FrequencyWeek, Hour: 9, Weekdays: []int{1, 5}}, want: "0 0 9 ? * 2,6"},
+		{name: "Quartz 月末", opts: BuildOptions{Dialect: DialectQuartz, Frequency: FrequencyMonth, Hour: 18, LastDayOfMonth: true}, want: "0 0 18 L * ?"},
+		{name: "每季度", opts: BuildOptions{Frequency: FrequencyMonth, Interval: 3, MonthDays: []int{1}}, want: "0 0 1 1/3 *"},
+		{name: "每 10 秒", opts: BuildOptions{Dialect: DialectSeconds, Frequency: FrequencySecond, Interval: 10}, want: "*/10 * * * * *"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := builder.Build(tt.opts)
+			if err != nil {
+				t.Fatalf("Build() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Build() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	invalid := []BuildOptions{
+		{Frequency: FrequencySecond},
+		{Frequency: FrequencyWeek},
+		{Frequency: FrequencyMonth, LastDayOfMonth: true},
+		{Frequency: FrequencyDay, Hour: 25},
+		{Frequency: "fortnight"},
+	}
+	for _, opts := range invalid {
+		if _, err := builder.Build(opts); !errors.Is(err, ErrInvalidBuildOptions) {
+			t.Errorf("Build(%+v) error = %v, want ErrInvalidBuildOptions", opts, err)
+		}
+	}
+}
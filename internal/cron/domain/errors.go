@@ -0,0 +1,29 @@
+package domain
+
+// CronError cron 工具错误类型
+type CronError struct {
+	Errmsg string
+}
+
+// Error 实现 error 接口
+func (e CronError) Error() string {
+	return e.Errmsg
+}
+
+// 预定义的错误
+var (
+	// ErrEmptyExpression 表达式为空
+	ErrEmptyExpression = CronError{Errmsg: "cron 表达式不能为空"}
+	// ErrInvalidExpression 无效的表达式
+	ErrInvalidExpression = CronError{Errmsg: "无效的 cron 表达式"}
+	// ErrInvalidField 无效的字段
+	ErrInvalidField = CronError{Errmsg: "无效的 cron 字段"}
+	// ErrUnsupportedDialect 不支持的表达式方言
+	ErrUnsupportedDialect = CronError{Errmsg: "不支持的 cron 方言"}
+	// ErrNoNextRun 表达式在可预见的时间内不会触发
+	ErrNoNextRun = CronError{Errmsg: "表达式在可预见的时间内不会触发"}
+	// ErrInvalidCount 无效的触发次数
+	ErrInvalidCount = CronError{Errmsg: "无效的触发次数"}
+	// ErrInvalidBuildOptions 无效的表达式构建选项
+	ErrInvalidBuildOptions = CronError{Errmsg: "无效的表达式构建选项"}
+)
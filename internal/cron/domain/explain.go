@@ -0,0 +1,381 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// FieldInfo 单个字段的说明
+type FieldInfo struct {
+	Name    string `json:"name"`
+	Raw     string `json:"raw"`
+	English string `json:"english"`
+	Chinese string `json:"chinese"`
+}
+
+// Explanation 表达式说明
+type Explanation struct {
+	Expression string      `json:"expression"`
+	Dialect    string      `json:"dialect"`
+	English    string      `json:"english"`
+	Chinese    string      `json:"chinese"`
+	Fields     []FieldInfo `json:"fields"`
+	// Warnings 容易误解或配置错误的地方
+	Warnings []string `json:"warnings"`
+}
+
+// unitText 字段的中英文单位与取值描述
+type unitText struct {
+	en       string
+	enPlural string
+	zh       string
+	valueEN  func(v int) string
+	valueZH  func(v int) string
+}
+
+var (
+	weekdayNamesZh = []string{"周日", "周一", "周二", "周三", "周四", "周五", "周六"}
+	ordinalsEN     = []string{"", "first", "second", "third", "fourth", "fifth"}
+	ordinalsZh     = []string{"", "第一个", "第二个", "第三个", "第四个", "第五个"}
+	fieldNamesEN   = []string{"second", "minute", "hour", "day of month", "month", "day of week", "year"}
+)
+
+// unitTexts 返回各字段的描述方式，周的取值需按方言转换
+func (s *Schedule) unitTexts() []unitText {
+	weekday := func(v int) int { return normalizeValue(v, fieldDayOfWeek, s.Dialect) }
+	return []unitText{
+		{"second", "seconds", "秒", func(v int) string { return fmt.Sprintf("second %d", v) }, func(v int) string { return fmt.Sprintf("第%d秒", v) }},
+		{"minute", "minutes", "分钟", func(v int) string { return fmt.Sprintf("minute %d", v) }, func(v int) string { return fmt.Sprintf("第%d分钟", v) }},
+		{"hour", "hours", "小时", func(v int) string { return fmt.Sprintf("%02d:00", v) }, func(v int) string { return fmt.Sprintf("%d点", v) }},
+		{"day", "days", "天", func(v int) string { return fmt.Sprintf("day %d", v) }, func(v int) string { return fmt.Sprintf("%d日", v) }},
+		{"month", "months", "个月", func(v int) string { return time.Month(v).String() }, func(v int) string { return fmt.Sprintf("%d月", v) }},
+		{"day", "days", "天", func(v int) string { return time.Weekday(weekday(v)).String() }, func(v int) string { return weekdayNamesZh[weekday(v)] }},
+		{"year", "years", "年", func(v int) string { return fmt.Sprintf("%d", v) }, func(v int) string { return fmt.Sprintf("%d年", v) }},
+	}
+}
+
+// Explain 生成表达式的中英文说明与潜在问题提示
+func (s *Schedule) Explain() *Explanation {
+	texts := s.unitTexts()
+	explanation := &Explanation{
+		Expression: s.Expression,
+		Dialect:    s.Dialect,
+		Warnings:   s.warnings(),
+	}
+	for i, f := range s.fields {
+		// 标准格式没有秒字段，未指定年份时不展示年字段
+		if (i == fieldSecond && s.Dialect == DialectStandard) || (i == fieldYear && f.any) {
+			continue
+		}
+		explanation.Fields = append(explanation.Fields, FieldInfo{
+			Name:    fieldNamesEN[i],
+			Raw:     f.raw,
+			English: describeItems(f, texts[i], false),
+			Chinese: describeItems(f, texts[i], true),
+		})
+	}
+	explanation.English = s.explainEnglish(texts)
+	explanation.Chinese = s.explainChinese(texts)
+	return explanation
+}
+
+// describeItems 描述字段的所有项
+func describeItems(f *field, text unitText, chinese bool) string {
+	if f.any && len(f.items) == 1 && f.items[0].step == 0 {
+		if chinese {
+			return "每" + strings.TrimPrefix(text.zh, "个")
+		}
+		return "every " + text.en
+	}
+	phrases := make([]string, 0, len(f.items))
+	for _, item := range f.items {
+		if chinese {
+			phrases = append(phrases, describeItemZh(item, text, f.spec))
+		} else {
+			phrases = append(phrases, describeItemEN(item, text, f.spec))
+		}
+	}
+	if chinese {
+		return strings.Join(phrases, "、")
+	}
+	return joinEnglish(phrases)
+}
+
+// describeItemEN 英文描述字段中的一项
+func describeItemEN(item fieldItem, text unitText, spec fieldSpec) string {
+	switch item.kind {
+	case itemValue:
+		return text.valueEN(item.start)
+	case itemRange:
+		return text.valueEN(item.start) + " through " + text.valueEN(item.end)
+	case itemStep, itemAny:
+		every := fmt.Sprintf("every %d %s", item.step, text.enPlural)
+		if item.step == 1 {
+			every = "every " + text.en
+		}
+		switch {
+		case item.open:
+			return every + " starting at " + text.valueEN(item.start)
+		case item.start != item.end && (item.start != spec.min || item.end != spec.max):
+			return every + " from " + text.valueEN(item.start) + " through " + text.valueEN(item.end)
+		}
+		return every
+	case itemLastDay:
+		if item.n > 0 {
+			return fmt.Sprintf("%d days before the last day of the month", item.n)
+		}
+		return "the last day of the month"
+	case itemLastWeekday:
+		return "the last weekday of the month"
+	case itemWeekday:
+		return fmt.Sprintf("the weekday nearest day %d of the month", item.start)
+	case itemLastDow:
+		return "the last " + text.valueEN(item.start) + " of the month"
+	case itemNthDow:
+		return "the " + ordinalsEN[item.n] + " " + text.valueEN(item.start) + " of the month"
+	}
+	return ""
+}
+
+// describeItemZh 中文描述字段中的一项
+func describeItemZh(item fieldItem, text unitText, spec fieldSpec) string {
+	switch item.kind {
+	case itemValue:
+		return text.valueZH(item.start)
+	case itemRange:
+		return text.valueZH(item.start) + "至" + text.valueZH(item.end)
+	case itemStep, itemAny:
+		every := fmt.Sprintf("每 %d %s", item.step, text.zh)
+		switch {
+		case item.open:
+			return "从" + text.valueZH(item.start) + "开始" + every
+		case item.start != item.end && (item.start != spec.min || item.end != spec.max):
+			return text.valueZH(item.start) + "至" + text.valueZH(item.end) + every
+		}
+		return every
+	case itemLastDay:
+		if item.n > 0 {
+			return fmt.Sprintf("每月倒数第%d天", item.n+1)
+		}
+		return "每月最后一天"
+	case itemLastWeekday:
+		return "每月最后一个工作日"
+	case itemWeekday:
+		return fmt.Sprintf("每月离%d日最近的工作日", item.start)
+	case itemLastDow:
+		return "每月最后一个" + text.valueZH(item.start)
+	case itemNthDow:
+		return "每月" + ordinalsZh[item.n] + text.valueZH(item.start)
+	}
+	return ""
+}
+
+// fixedTimes 秒、分、时均为单个取值的组合（如 09:30、18:30），组合过多时返回 nil
+func (s *Schedule) fixedTimes() []string {
+	for _, i := range []int{fieldSecond, fieldMinute, fieldHour} {
+		for _, item := range s.fields[i].items {
+			if item.kind != itemValue {
+				return nil
+			}
+		}
+	}
+	seconds := s.fields[fieldSecond].sortedValues()
+	minutes := s.fields[fieldMinute].sortedValues()
+	hours := s.fields[fieldHour].sortedValues()
+	if len(seconds)*len(minutes)*len(hours) > 6 {
+		return nil
+	}
+	var times []string
+	for _, h := range hours {
+		for _, m := range minutes {
+			for _, sec := range seconds {
+				if len(seconds) == 1 && sec == 0 {
+					times = append(times, fmt.Sprintf("%02d:%02d", h, m))
+				} else {
+					times = append(times, fmt.Sprintf("%02d:%02d:%02d", h, m, sec))
+				}
+			}
+		}
+	}
+	return times
+}
+
+// explainEnglish 生成英文说明，如 "At 09:30, Monday through Friday"
+func (s *Schedule) explainEnglish(texts []unitText) string {
+	var parts []string
+	if times := s.fixedTimes(); times != nil {
+		parts = append(parts, "at "+joinEnglish(times))
+	} else {
+		second, minute, hour := s.fields[fieldSecond], s.fields[fieldMinute], s.fields[fieldHour]
+		if !(second.raw == "0" || s.Dialect == DialectStandard) {
+			parts = append(parts, describeItems(second, texts[fieldSecond], false))
+		}
+		if !(minute.any && minute.items[0].step == 0 && second.raw != "0" && s.Dialect != DialectStandard) {
+			if minute.raw == "0" {
+				parts = append(parts, "at the start of the hour")
+			} else if allValues(minute) {
+				parts = append(parts, "at "+describeItems(minute, texts[fieldMinute], false))
+			} else {
+				parts = append(parts, describeItems(minute, texts[fieldMinute], false))
+			}
+		}
+		if !hour.any || hour.items[0].step > 0 {
+			parts = append(parts, s.hourEnglish())
+		}
+	}
+
+	days := s.dayPartsEnglish(texts)
+	if len(days) == 0 {
+		parts = append(parts, "every day")
+	}
+	parts = append(parts, days...)
+	sentence := strings.Join(parts, ", ")
+	return strings.ToUpper(sentence[:1]) + sentence[1:]
+}
+
+// hourEnglish 英文描述小时字段
+func (s *Schedule) hourEnglish() string {
+	hour := s.fields[fieldHour]
+	phrases := make([]string, 0, len(hour.items))
+	for _, item := range hour.items {
+		switch item.kind {
+		case itemValue:
+			phrases = append(phrases, fmt.Sprintf("between %02d:00 and %02d:59", item.start, item.start))
+		case itemRange:
+			phrases = append(phrases, fmt.Sprintf("between %02d:00 and %02d:59", item.start, item.end))
+		default:
+			phrases = append(phrases, describeItemEN(item, s.unitTexts()[fieldHour], hour.spec))
+		}
+	}
+	return joinEnglish(phrases)
+}
+
+// dayPartsEnglish 英文描述日、周、月、年字段
+func (s *Schedule) dayPartsEnglish(texts []unitText) []string {
+	var parts []string
+	dom, dow := s.fields[fieldDayOfMonth], s.fields[fieldDayOfWeek]
+	var days []string
+	if !dom.any {
+		text := describeItems(dom, texts[fieldDayOfMonth], false)
+		if !strings.Contains(text, "month") {
+			text += " of the month"
+		}
+		days = append(days, "on "+text)
+	}
+	if !dow.any {
+		days = append(days, "on "+describeItems(dow, texts[fieldDayOfWeek], false))
+	}
+	if len(days) > 0 {
+		parts = append(parts, strings.Join(days, " or "))
+	} else if dom.items[0].step > 0 {
+		parts = append(parts, describeItems(dom, texts[fieldDayOfMonth], false)+" of the month")
+	}
+	if month := s.fields[fieldMonth]; !month.any || month.items[0].step > 0 {
+		parts = append(parts, "in "+describeItems(month, texts[fieldMonth], false))
+	}
+	if year := s.fields[fieldYear]; !year.any || year.items[0].step > 0 {
+		parts = append(parts, "in "+describeItems(year, texts[fieldYear], false))
+	}
+	return parts
+}
+
+// explainChinese 生成中文说明，如 "周一至周五，09:30"
+func (s *Schedule) explainChinese(texts []unitText) string {
+	var parts []string
+	if year := s.fields[fieldYear]; !year.any || year.items[0].step > 0 {
+		parts = append(parts, describeItems(year, texts[fieldYear], true))
+	}
+	if month := s.fields[fieldMonth]; !month.any || month.items[0].step > 0 {
+		parts = append(parts, describeItems(month, texts[fieldMonth], true))
+	}
+
+	dom, dow := s.fields[fieldDayOfMonth], s.fields[fieldDayOfWeek]
+	var days []string
+	if !dom.any || dom.items[0].step > 0 {
+		text := describeItems(dom, texts[fieldDayOfMonth], true)
+		if !strings.HasPrefix(text, "每月") && s.fields[fieldMonth].any {
+			text = "每月" + text
+		}
+		days = append(days, text)
+	}
+	if !dow.any {
+		days = append(days, describeItems(dow, texts[fieldDayOfWeek], true))
+	}
+	if len(days) == 0 {
+		days = append(days, "每天")
+	}
+	parts = append(parts, strings.Join(days, "或"))
+
+	if times := s.fixedTimes(); times != nil {
+		parts = append(parts, strings.Join(times, "、"))
+	} else {
+		second, minute, hour := s.fields[fieldSecond], s.fields[fieldMinute], s.fields[fieldHour]
+		if !hour.any || hour.items[0].step > 0 {
+			hourText := describeItems(hour, texts[fieldHour], true)
+			if allValues(hour) || hour.items[0].kind == itemRange {
+				hourText += "之间"
+			}
+			parts = append(parts, hourText)
+		}
+		if !(minute.any && minute.items[0].step == 0 && second.raw != "0" && s.Dialect != DialectStandard) {
+			if minute.raw == "0" {
+				parts = append(parts, "整点")
+			} else {
+				parts = append(parts, describeItems(minute, texts[fieldMinute], true))
+			}
+		}
+		if !(second.raw == "0" || s.Dialect == DialectStandard) {
+			parts = append(parts, describeItems(second, texts[fieldSecond], true))
+		}
+	}
+	return strings.Join(parts, "，")
+}
+
+// warnings 检查容易误解或配置错误的写法
+func (s *Schedule) warnings() []string {
+	warnings := []string{}
+	dom, dow := s.fields[fieldDayOfMonth], s.fields[fieldDayOfWeek]
+	if s.Dialect != DialectQuartz && !dom.any && !dow.any {
+		warnings = append(warnings, "日与周字段同时受限时，满足任一条件即触发（OR），而不是同时满足")
+	}
+	if s.Dialect != DialectQuartz && strings.Contains(dow.raw, "7") {
+		warnings = append(warnings, "周字段中的 7 表示周日，与 0 相同")
+	}
+	if s.Dialect == DialectQuartz && strings.ContainsAny(dow.raw, "01234567") {
+		warnings = append(warnings, "Quartz 的周字段 1 表示周日、7 表示周六，与标准 cron 不同")
+	}
+	if !dom.any {
+		for _, item := range dom.items {
+			if item.kind != itemLastDay && item.kind != itemLastWeekday && item.start >= 29 {
+				warnings = append(warnings, "日字段包含 29-31 日，部分月份不会触发；需要月末触发时可使用 L")
+				break
+			}
+		}
+	}
+	if minute := s.fields[fieldMinute]; minute.raw == "*" && s.fields[fieldHour].raw != "*" {
+		warnings = append(warnings, "分钟字段为 *，会在指定小时内每分钟触发一次")
+	}
+	return warnings
+}
+
+// allValues 判断字段是否只包含单个取值项
+func allValues(f *field) bool {
+	for _, item := range f.items {
+		if item.kind != itemValue {
+			return false
+		}
+	}
+	return true
+}
+
+// joinEnglish 用逗号与 and 连接英文短语
+func joinEnglish(phrases []string) string {
+	switch len(phrases) {
+	case 0:
+		return ""
+	case 1:
+		return phrases[0]
+	}
+	return strings.Join(phrases[:len(phrases)-1], ", ") + " and " + phrases[len(phrases)-1]
+}
@@ -0,0 +1,382 @@
+package domain
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// 支持的表达式方言
+const (
+	// DialectAuto 根据字段数与特殊字符自动识别
+	DialectAuto = "auto"
+	// DialectStandard 标准 5 字段：分 时 日 月 周（周 0-7，0 与 7 均为周日）
+	DialectStandard = "standard"
+	// DialectSeconds 6 字段（带秒）：秒 分 时 日 月 周，周的取值与标准一致
+	DialectSeconds = "seconds"
+	// DialectQuartz Quartz：秒 分 时 日 月 周 [年]，周 1-7（1 为周日），日与周之一必须为 ?
+	DialectQuartz = "quartz"
+)
+
+// 字段下标
+const (
+	fieldSecond = iota
+	fieldMinute
+	fieldHour
+	fieldDayOfMonth
+	fieldMonth
+	fieldDayOfWeek
+	fieldYear
+	fieldCount
+)
+
+// 字段项类型
+const (
+	itemAny         = "any"
+	itemValue       = "value"
+	itemRange       = "range"
+	itemStep        = "step"
+	itemLastDay     = "last-day"
+	itemWeekday     = "nearest-weekday"
+	itemLastWeekday = "last-weekday"
+	itemLastDow     = "last-dow"
+	itemNthDow      = "nth-dow"
+)
+
+// fieldSpec 字段定义
+type fieldSpec struct {
+	name string
+	min  int
+	max  int
+	// names 名称别名（JAN、MON 等）对应的原始数值
+	names map[string]int
+}
+
+var (
+	monthNames = map[string]int{
+		"JAN": 1, "FEB": 2, "MAR": 3, "APR": 4, "MAY": 5, "JUN": 6,
+		"JUL": 7, "AUG": 8, "SEP": 9, "OCT": 10, "NOV": 11, "DEC": 12,
+	}
+	standardDowNames = map[string]int{"SUN": 0, "MON": 1, "TUE": 2, "WED": 3, "THU": 4, "FRI": 5, "SAT": 6}
+	quartzDowNames   = map[string]int{"SUN": 1, "MON": 2, "TUE": 3, "WED": 4, "THU": 5, "FRI": 6, "SAT": 7}
+
+	secondSpec     = fieldSpec{name: "second", min: 0, max: 59}
+	minuteSpec     = fieldSpec{name: "minute", min: 0, max: 59}
+	hourSpec       = fieldSpec{name: "hour", min: 0, max: 23}
+	domSpec        = fieldSpec{name: "day-of-month", min: 1, max: 31}
+	monthSpec      = fieldSpec{name: "month", min: 1, max: 12, names: monthNames}
+	standardDow    = fieldSpec{name: "day-of-week", min: 0, max: 7, names: standardDowNames}
+	quartzDow      = fieldSpec{name: "day-of-week", min: 1, max: 7, names: quartzDowNames}
+	yearSpec       = fieldSpec{name: "year", min: 1970, max: 2099}
+	fieldNamesZh   = []string{"秒", "分", "时", "日", "月", "周", "年"}
+	cronShorthands = map[string]string{
+		"@yearly":   "0 0 1 1 *",
+		"@annually": "0 0 1 1 *",
+		"@monthly":  "0 0 1 * *",
+		"@weekly":   "0 0 * * 0",
+		"@daily":    "0 0 * * *",
+		"@midnight": "0 0 * * *",
+		"@hourly":   "0 * * * *",
+	}
+)
+
+// fieldItem 字段中以逗号分隔的一项
+type fieldItem struct {
+	kind  string
+	start int
+	end   int
+	step  int
+	// n L-n 的偏移量或 # 的序号
+	n int
+	// open a/n 形式（从 a 开始到最大值）
+	open bool
+}
+
+// field 解析后的字段
+type field struct {
+	raw   string
+	spec  fieldSpec
+	items []fieldItem
+	// any 字段以 * 或 ? 开头（标准 cron 中用于判断日与周是否受限）
+	any bool
+	// values 简单取值展开后的集合，周已转换为 Go 的 time.Weekday
+	values map[int]bool
+}
+
+// Schedule 解析后的 cron 表达式
+type Schedule struct {
+	Expression string
+	Dialect    string
+	fields     [fieldCount]*field
+}
+
+// Parser cron 表达式解析器
+type Parser struct{}
+
+// NewParser 创建新的 Parser 实例
+func NewParser() *Parser {
+	return &Parser{}
+}
+
+// Parse 解析 cron 表达式，dialect 为空或 auto 时根据字段数自动识别：
+// 5 个字段为标准格式，6 个字段包含 ?、L、W、# 时为 Quartz，否则为带秒格式，7 个字段为 Quartz
+func (p *Parser) Parse(expression, dialect string) (*Schedule, error) {
+	expr := strings.TrimSpace(expression)
+	if expr == "" {
+		return nil, errors.WithStack(ErrEmptyExpression)
+	}
+	if strings.HasPrefix(expr, "@") {
+		shorthand, ok := cronShorthands[strings.ToLower(expr)]
+		if !ok {
+			return nil, errors.Wrapf(ErrInvalidExpression, "不支持的简写: %s", expr)
+		}
+		expr = shorthand
+		if dialect != DialectStandard && dialect != DialectAuto && dialect != "" {
+			return nil, errors.Wrapf(ErrInvalidExpression, "简写 %s 仅适用于标准格式", expression)
+		}
+		dialect = DialectStandard
+	}
+
+	parts := strings.Fields(expr)
+	dialect, err := resolveDialect(dialect, parts)
+	if err != nil {
+		return nil, err
+	}
+
+	// 标准格式没有秒字段，按第 0 秒处理
+	if dialect == DialectStandard {
+		parts = append([]string{"0"}, parts...)
+	}
+	schedule := &Schedule{Expression: strings.TrimSpace(expression), Dialect: dialect}
+	specs := []fieldSpec{secondSpec, minuteSpec, hourSpec, domSpec, monthSpec, standardDow, yearSpec}
+	if dialect == DialectQuartz {
+		specs[fieldDayOfWeek] = quartzDow
+	}
+	if len(parts) == fieldCount-1 {
+		parts = append(parts, "*")
+	}
+	for i, part := range parts {
+		f, err := parseField(part, specs[i], i, dialect)
+		if err != nil {
+			return nil, errors.Wrapf(err, "%s字段 %q", fieldNamesZh[i], part)
+		}
+		schedule.fields[i] = f
+	}
+
+	dom, dow := schedule.fields[fieldDayOfMonth], schedule.fields[fieldDayOfWeek]
+	if dialect == DialectQuartz {
+		// Quartz 要求日与周中恰好一个为 ?
+		if (dom.raw == "?") == (dow.raw == "?") {
+			return nil, errors.Wrap(ErrInvalidExpression, "Quartz 表达式的日与周字段必须且只能有一个为 ?")
+		}
+	}
+	return schedule, nil
+}
+
+// resolveDialect 校验字段数并确定方言
+func resolveDialect(dialect string, parts []string) (string, error) {
+	count := len(parts)
+	switch dialect {
+	case "", DialectAuto:
+		switch count {
+		case 5:
+			return DialectStandard, nil
+		case 6:
+			if isQuartzSyntax(parts[3], parts[5]) {
+				return DialectQuartz, nil
+			}
+			return DialectSeconds, nil
+		case 7:
+			return DialectQuartz, nil
+		}
+		return "", errors.Wrapf(ErrInvalidExpression, "字段数为 %d，应为 5、6 或 7 个", count)
+	case DialectStandard:
+		if count != 5 {
+			return "", errors.Wrapf(ErrInvalidExpression, "标准 cron 需要 5 个字段，实际为 %d 个", count)
+		}
+	case DialectSeconds:
+		if count != 6 {
+			return "", errors.Wrapf(ErrInvalidExpression, "带秒的 cron 需要 6 个字段，实际为 %d 个", count)
+		}
+	case DialectQuartz:
+		if count != 6 && count != 7 {
+			return "", errors.Wrapf(ErrInvalidExpression, "Quartz 表达式需要 6 或 7 个字段，实际为 %d 个", count)
+		}
+	default:
+		return "", errors.Wrapf(ErrUnsupportedDialect, "不支持的方言: %s", dialect)
+	}
+	return dialect, nil
+}
+
+// isQuartzSyntax 日或周字段是否使用了 Quartz 特有的 ?、L、W、#（月份与星期名称中的字母不算）
+func isQuartzSyntax(dom, dow string) bool {
+	dow = strings.ToUpper(dow)
+	return strings.ContainsAny(strings.ToUpper(dom), "?LW") || dow == "?" || strings.Contains(dow, "#") || strings.HasSuffix(dow, "L")
+}
+
+// parseField 解析单个字段
+func parseField(raw string, spec fieldSpec, index int, dialect string) (*field, error) {
+	f := &field{raw: raw, spec: spec, values: make(map[int]bool)}
+	upper := strings.ToUpper(raw)
+	f.any = strings.HasPrefix(upper, "*") || upper == "?"
+
+	if upper == "?" {
+		if index != fieldDayOfMonth && index != fieldDayOfWeek {
+			return nil, errors.Wrap(ErrInvalidField, "? 只能用于日或周字段")
+		}
+		f.items = []fieldItem{{kind: itemAny}}
+		return f, nil
+	}
+
+	for _, token := range strings.Split(upper, ",") {
+		item, err := parseItem(token, spec, index, dialect)
+		if err != nil {
+			return nil, err
+		}
+		f.items = append(f.items, item)
+		f.addValues(item, index, dialect)
+	}
+	return f, nil
+}
+
+// parseItem 解析字段中的一项
+func parseItem(token string, spec fieldSpec, index int, dialect string) (fieldItem, error) {
+	if token == "" {
+		return fieldItem{}, errors.Wrap(ErrInvalidField, "存在空的列表项")
+	}
+	special := strings.ContainsAny(token, "LW#")
+	if special && dialect == DialectStandard && !(index == fieldMonth || index == fieldDayOfWeek) {
+		return fieldItem{}, errors.Wrap(ErrInvalidField, "标准 cron 不支持 L、W、#，请使用带秒或 Quartz 格式")
+	}
+
+	switch index {
+	case fieldDayOfMonth:
+		switch {
+		case token == "L":
+			return fieldItem{kind: itemLastDay}, nil
+		case token == "LW":
+			return fieldItem{kind: itemLastWeekday}, nil
+		case strings.HasPrefix(token, "L-"):
+			n, err := strconv.Atoi(token[2:])
+			if err != nil || n < 0 || n > 30 {
+				return fieldItem{}, errors.Wrapf(ErrInvalidField, "无效的月末偏移: %s", token)
+			}
+			return fieldItem{kind: itemLastDay, n: n}, nil
+		case strings.HasSuffix(token, "W"):
+			day, err := parseValue(token[:len(token)-1], spec)
+			if err != nil {
+				return fieldItem{}, err
+			}
+			return fieldItem{kind: itemWeekday, start: day}, nil
+		}
+	case fieldDayOfWeek:
+		switch {
+		case token == "L" && dialect != DialectStandard:
+			// 单独的 L 表示周六：Quartz 中为 7，带秒格式沿用标准取值为 6（7 是周日）
+			saturday := 6
+			if dialect == DialectQuartz {
+				saturday = 7
+			}
+			return fieldItem{kind: itemValue, start: saturday, end: saturday}, nil
+		case strings.HasSuffix(token, "L") && len(token) > 1 && dialect != DialectStandard:
+			day, err := parseValue(token[:len(token)-1], spec)
+			if err != nil {
+				return fieldItem{}, err
+			}
+			return fieldItem{kind: itemLastDow, start: day}, nil
+		case strings.Contains(token, "#") && dialect != DialectStandard:
+			parts := strings.SplitN(token, "#", 2)
+			day, err := parseValue(parts[0], spec)
+			if err != nil {
+				return fieldItem{}, err
+			}
+			n, err := strconv.Atoi(parts[1])
+			if err != nil || n < 1 || n > 5 {
+				return fieldItem{}, errors.Wrapf(ErrInvalidField, "# 后的序号必须为 1-5: %s", token)
+			}
+			return fieldItem{kind: itemNthDow, start: day, n: n}, nil
+		}
+	}
+
+	base, stepText, hasStep := strings.Cut(token, "/")
+	item := fieldItem{kind: itemValue}
+	switch {
+	case base == "*":
+		item.kind, item.start, item.end = itemAny, spec.min, spec.max
+	case strings.Contains(base, "-"):
+		startText, endText, _ := strings.Cut(base, "-")
+		start, err := parseValue(startText, spec)
+		if err != nil {
+			return fieldItem{}, err
+		}
+		end, err := parseValue(endText, spec)
+		if err != nil {
+			return fieldItem{}, err
+		}
+		if start > end {
+			return fieldItem{}, errors.Wrapf(ErrInvalidField, "范围起点大于终点: %s", base)
+		}
+		item.kind, item.start, item.end = itemRange, start, end
+	default:
+		value, err := parseValue(base, spec)
+		if err != nil {
+			return fieldItem{}, err
+		}
+		item.start, item.end = value, value
+	}
+
+	if hasStep {
+		step, err := strconv.Atoi(stepText)
+		if err != nil || step <= 0 || step > spec.max-spec.min+1 {
+			return fieldItem{}, errors.Wrapf(ErrInvalidField, "无效的步长: %s", token)
+		}
+		// a/n 表示从 a 开始到最大值，每 n 个单位一次
+		if item.kind == itemValue {
+			item.end = spec.max
+		}
+		item.step = step
+		item.kind = itemStep
+		item.open = base != "*" && !strings.Contains(base, "-")
+	}
+	return item, nil
+}
+
+// parseValue 解析数值或名称并校验范围
+func parseValue(text string, spec fieldSpec) (int, error) {
+	if value, ok := spec.names[text]; ok {
+		return value, nil
+	}
+	value, err := strconv.Atoi(text)
+	if err != nil {
+		return 0, errors.Wrapf(ErrInvalidField, "无效的取值: %s", text)
+	}
+	if value < spec.min || value > spec.max {
+		return 0, errors.Wrapf(ErrInvalidField, "取值 %d 超出范围 %d-%d", value, spec.min, spec.max)
+	}
+	return value, nil
+}
+
+// addValues 将简单取值项展开到集合中
+func (f *field) addValues(item fieldItem, index int, dialect string) {
+	switch item.kind {
+	case itemAny, itemValue, itemRange, itemStep:
+	default:
+		return
+	}
+	step := max(item.step, 1)
+	for v := item.start; v <= item.end; v += step {
+		f.values[normalizeValue(v, index, dialect)] = true
+	}
+}
+
+// normalizeValue 将周的原始取值转换为 time.Weekday 数值，其余字段保持不变
+func normalizeValue(v, index int, dialect string) int {
+	if index != fieldDayOfWeek {
+		return v
+	}
+	if dialect == DialectQuartz {
+		return v - 1
+	}
+	return v % 7
+}
@@ -0,0 +1,218 @@
+package domain
+
+import (
+	"sort"
+	"time"
+)
+
+const (
+	// DefaultRunCount 默认预览的触发次数
+	DefaultRunCount = 10
+	// MaxRunCount 最多预览的触发次数
+	MaxRunCount = 100
+	// maxSearchYears 查找下一次触发时间的最大年数（覆盖 2 月 29 日等稀疏表达式）
+	maxSearchYears = 30
+)
+
+// 夏令时相关的触发标记
+const (
+	// RunFlagNone 正常触发
+	RunFlagNone = ""
+	// RunFlagDSTGap 本地时间因夏令时开始而不存在，改在时钟拨快后的第一个时刻触发
+	RunFlagDSTGap = "dst-gap"
+	// RunFlagDSTAmbiguous 本地时间因夏令时结束而出现两次
+	RunFlagDSTAmbiguous = "dst-ambiguous"
+)
+
+// Run 一次触发
+type Run struct {
+	Time time.Time
+	Flag string
+	// Wall 表达式匹配到的本地钟面时间（落在夏令时空档时与 Time 不同）
+	Wall string
+}
+
+// Next 返回 from 之后（不含）在 loc 时区的 count 次触发时间
+// 本地时间不存在（夏令时开始）时改在时钟拨快后的第一个时刻触发并标记，出现两次（夏令时结束）时取其中一个时刻并标记
+func (s *Schedule) Next(from time.Time, loc *time.Location, count int) []Run {
+	runs := make([]Run, 0, count)
+	start := from.In(loc)
+	day := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC)
+	limit := day.AddDate(maxSearchYears, 0, 0)
+
+	seconds := s.fields[fieldSecond].sortedValues()
+	minutes := s.fields[fieldMinute].sortedValues()
+	hours := s.fields[fieldHour].sortedValues()
+
+	for ; len(runs) < count && day.Before(limit); day = day.AddDate(0, 0, 1) {
+		year, month, date := day.Date()
+		if !s.matchDay(year, month, date) {
+			continue
+		}
+
+		var dayRuns []Run
+		for _, hour := range hours {
+			for _, minute := range minutes {
+				for _, second := range seconds {
+					t := time.Date(year, month, date, hour, minute, second, 0, loc)
+					flag := RunFlagNone
+					if t.Hour() != hour || t.Minute() != minute || t.Day() != date {
+						// time.Date 对不存在的钟面时间可能返回空档之前或之后的时刻，统一取空档结束的时刻
+						t, flag = gapEnd(t), RunFlagDSTGap
+					} else if isAmbiguous(t) {
+						flag = RunFlagDSTAmbiguous
+					}
+					if !t.After(from) {
+						continue
+					}
+					dayRuns = append(dayRuns, Run{Time: t, Flag: flag, Wall: time.Date(year, month, date, hour, minute, second, 0, time.UTC).Format(time.DateTime)})
+				}
+			}
+		}
+
+		// 空档内的触发会与空档结束时刻的触发重合，只保留最先匹配（带空档标记）的一次
+		sort.SliceStable(dayRuns, func(i, j int) bool { return dayRuns[i].Time.Before(dayRuns[j].Time) })
+		for _, run := range dayRuns {
+			if len(runs) == count {
+				break
+			}
+			if n := len(runs); n > 0 && runs[n-1].Time.Equal(run.Time) {
+				continue
+			}
+			runs = append(runs, run)
+		}
+	}
+	return runs
+}
+
+// gapEnd 返回 t 附近夏令时空档结束（时钟拨快后）的第一个时刻
+func gapEnd(t time.Time) time.Time {
+	lo, hi := t.Add(-6*time.Hour), t.Add(6*time.Hour)
+	_, before := lo.Zone()
+	// 偏移变化发生在整秒，二分到相邻两秒即可
+	for hi.Sub(lo) > time.Second {
+		mid := lo.Add(hi.Sub(lo) / 2).Truncate(time.Second)
+		if _, offset := mid.Zone(); offset == before {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return hi
+}
+
+// isAmbiguous 判断本地时间是否对应两个时刻（夏令时结束时回拨的那一段）
+func isAmbiguous(t time.Time) bool {
+	_, offset := t.Zone()
+	for _, probe := range []time.Duration{-6 * time.Hour, 6 * time.Hour} {
+		_, other := t.Add(probe).Zone()
+		if other == offset {
+			continue
+		}
+		// 同一钟面时间在另一偏移下对应的时刻
+		candidate := t.Add(time.Duration(offset-other) * time.Second)
+		if _, candidateOffset := candidate.Zone(); candidateOffset == other && !candidate.Equal(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchDay 判断日期是否满足日、月、周、年字段
+// 标准 cron 中日与周都受限（不以 * 开头）时满足任一即可，否则需同时满足
+func (s *Schedule) matchDay(year int, month time.Month, day int) bool {
+	if !s.fields[fieldMonth].values[int(month)] || !s.fields[fieldYear].values[year] {
+		return false
+	}
+	dom, dow := s.fields[fieldDayOfMonth], s.fields[fieldDayOfWeek]
+	switch {
+	case dom.any && dow.any:
+		return true
+	case dom.any:
+		return s.matchDayOfWeek(year, month, day)
+	case dow.any:
+		return s.matchDayOfMonth(year, month, day)
+	default:
+		return s.matchDayOfMonth(year, month, day) || s.matchDayOfWeek(year, month, day)
+	}
+}
+
+// matchDayOfMonth 判断日期是否满足日字段
+func (s *Schedule) matchDayOfMonth(year int, month time.Month, day int) bool {
+	last := daysInMonth(year, month)
+	for _, item := range s.fields[fieldDayOfMonth].items {
+		switch item.kind {
+		case itemLastDay:
+			if day == last-item.n {
+				return true
+			}
+		case itemLastWeekday:
+			if day == nearestWeekday(year, month, last) {
+				return true
+			}
+		case itemWeekday:
+			if item.start <= last && day == nearestWeekday(year, month, item.start) {
+				return true
+			}
+		default:
+			if s.fields[fieldDayOfMonth].values[day] {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// matchDayOfWeek 判断日期是否满足周字段
+func (s *Schedule) matchDayOfWeek(year int, month time.Month, day int) bool {
+	weekday := int(time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Weekday())
+	for _, item := range s.fields[fieldDayOfWeek].items {
+		switch item.kind {
+		case itemLastDow:
+			if weekday == normalizeValue(item.start, fieldDayOfWeek, s.Dialect) && day+7 > daysInMonth(year, month) {
+				return true
+			}
+		case itemNthDow:
+			if weekday == normalizeValue(item.start, fieldDayOfWeek, s.Dialect) && (day-1)/7+1 == item.n {
+				return true
+			}
+		default:
+			if s.fields[fieldDayOfWeek].values[weekday] {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// nearestWeekday 返回离指定日期最近的工作日（不跨月）
+func nearestWeekday(year int, month time.Month, day int) int {
+	switch time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Weekday() {
+	case time.Saturday:
+		if day == 1 {
+			return 3
+		}
+		return day - 1
+	case time.Sunday:
+		if day == daysInMonth(year, month) {
+			return day - 2
+		}
+		return day + 1
+	}
+	return day
+}
+
+// daysInMonth 返回指定月份的天数
+func daysInMonth(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// sortedValues 返回字段展开后的取值（升序）
+func (f *field) sortedValues() []int {
+	values := make([]int, 0, len(f.values))
+	for v := range f.values {
+		values = append(values, v)
+	}
+	sort.Ints(values)
+	return values
+}
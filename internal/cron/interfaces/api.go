@@ -0,0 +1,49 @@
+package interfaces
+
+import (
+	"github.com/cyrnicolase/dev-tools/internal/cron/application"
+	"github.com/cyrnicolase/dev-tools/internal/cron/domain"
+	historydomain "github.com/cyrnicolase/dev-tools/internal/history/domain"
+)
+
+// API cron 工具 API 接口
+type API struct {
+	service *application.Service
+}
+
+// NewAPI 创建新的 API 实例
+func NewAPI() *API {
+	return &API{
+		service: application.NewService(),
+	}
+}
+
+// Explain 解析表达式并生成中英文说明
+func (a *API) Explain(expression, dialect string) (*domain.Explanation, error) {
+	return a.service.Explain(expression, dialect)
+}
+
+// NextRuns 计算接下来的触发时间
+func (a *API) NextRuns(expression, dialect, from string, count int, format, timezone string) (*application.NextRunsResult, error) {
+	return a.service.NextRuns(expression, dialect, from, count, format, timezone)
+}
+
+// Build 根据表单选项构建表达式
+func (a *API) Build(opts domain.BuildOptions) (*application.BuildResult, error) {
+	return a.service.Build(opts)
+}
+
+// ListHistory 获取历史记录
+func (a *API) ListHistory() ([]historydomain.ToolHistoryRecord, error) {
+	return a.service.ListHistory()
+}
+
+// AddHistory 添加历史记录
+func (a *API) AddHistory(record historydomain.ToolHistoryRecord) ([]historydomain.ToolHistoryRecord, error) {
+	return a.service.AddHistory(record)
+}
+
+// ClearHistory 清空历史记录
+func (a *API) ClearHistory() error {
+	return a.service.ClearHistory()
+}
@@ -1,6 +1,8 @@
 package application
 
 import (
+	"os"
+
 	"github.com/cyrnicolase/dev-tools/internal/hash/domain"
 	historydomain "github.com/cyrnicolase/dev-tools/internal/history/domain"
 	"github.com/pkg/errors"
@@ -9,6 +11,8 @@ import (
 // Service 散列值计算应用服务
 type Service struct {
 	hasher         *domain.Hasher
+	dirHasher      *domain.DirectoryHasher
+	passwordHasher *domain.PasswordHasher
 	historyStore   *historydomain.ToolHistoryStore
 	historyInitErr error
 }
@@ -20,6 +24,8 @@ func NewService() *Service {
 	historyStore, historyErr := historydomain.NewToolHistoryStore()
 	return &Service{
 		hasher:         domain.NewHasher(),
+		dirHasher:      domain.NewDirectoryHasher(),
+		passwordHasher: domain.NewPasswordHasher(),
 		historyStore:   historyStore,
 		historyInitErr: historyErr,
 	}
@@ -35,6 +41,66 @@ func (s *Service) HashFile(algorithm string, fileData []byte) (string, error) {
 	return s.hasher.Hash(algorithm, fileData)
 }
 
+// DirectoryHashResult 目录散列结果
+type DirectoryHashResult struct {
+	Manifest  *domain.Manifest `json:"manifest"`
+	Format    string           `json:"format"`
+	Content   string           `json:"content"`
+	FileName  string           `json:"fileName"`
+	FileCount int              `json:"fileCount"`
+	TotalSize int64            `json:"totalSize"`
+}
+
+// HashDirectory 计算目录下所有文件的散列值，并生成指定格式的清单文本
+func (s *Service) HashDirectory(dirPath, format string, opts domain.DirectoryHashOptions) (*DirectoryHashResult, error) {
+	manifest, err := s.dirHasher.HashFS(os.DirFS(dirPath), opts)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := domain.FormatManifest(manifest, format)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &DirectoryHashResult{
+		Manifest:  manifest,
+		Format:    format,
+		Content:   content,
+		FileName:  domain.ManifestFileName(manifest.Algorithm),
+		FileCount: len(manifest.Entries),
+	}
+	for _, entry := range manifest.Entries {
+		result.TotalSize += entry.Size
+	}
+	return result, nil
+}
+
+// VerifyManifest 解析清单文本并校验目录
+// algorithm 为空时自动识别清单算法
+func (s *Service) VerifyManifest(dirPath, content, algorithm string, opts domain.DirectoryHashOptions) (*domain.VerifyReport, error) {
+	manifest, err := domain.ParseManifest(content, algorithm)
+	if err != nil {
+		return nil, err
+	}
+	return s.dirHasher.Verify(os.DirFS(dirPath), manifest, opts)
+}
+
+// HashPassword 使用指定算法和参数生成密码散列
+func (s *Service) HashPassword(password string, opts domain.PasswordHashOptions) (string, error) {
+	return s.passwordHasher.Hash(password, opts)
+}
+
+// VerifyPassword 校验明文密码与已有散列是否匹配
+func (s *Service) VerifyPassword(password, encoded string) (bool, error) {
+	return s.passwordHasher.Verify(password, encoded)
+}
+
+// InspectPasswordHash 解析密码散列中的算法参数
+func (s *Service) InspectPasswordHash(encoded string) (*domain.PasswordHashInfo, error) {
+	return s.passwordHasher.Inspect(encoded)
+}
+
 // ListHistory 获取历史记录
 func (s *Service) ListHistory() ([]historydomain.ToolHistoryRecord, error) {
 	if s.historyInitErr != nil || s.historyStore == nil {
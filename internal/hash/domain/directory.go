@@ -0,0 +1,307 @@
+package domain
+
+import (
+	"encoding/hex"
+	"io"
+	"io/fs"
+	"path"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// MaxDirectoryHashConcurrency 目录散列的最大并发数
+	MaxDirectoryHashConcurrency = 32
+)
+
+const (
+	// VerifyStatusOK 文件散列值一致
+	VerifyStatusOK = "ok"
+	// VerifyStatusModified 文件散列值不一致
+	VerifyStatusModified = "modified"
+	// VerifyStatusMissing 清单中的文件不存在
+	VerifyStatusMissing = "missing"
+	// VerifyStatusExtra 目录中存在但清单未记录的文件
+	VerifyStatusExtra = "extra"
+	// VerifyStatusUnreadable 文件或目录无法读取（权限不足、清单条目指向目录等）
+	VerifyStatusUnreadable = "unreadable"
+)
+
+// DirectoryHashOptions 目录散列选项
+type DirectoryHashOptions struct {
+	Algorithm   string   `json:"algorithm"`
+	Include     []string `json:"include"`
+	Exclude     []string `json:"exclude"`
+	Concurrency int      `json:"concurrency"`
+	// ManifestPath 清单文件自身相对于目录的路径，散列与多余文件判定时会跳过该文件
+	ManifestPath string `json:"manifestPath,omitempty"`
+}
+
+// VerifyResult 单个文件的校验结果
+type VerifyResult struct {
+	Path     string `json:"path"`
+	Status   string `json:"status"`
+	Expected string `json:"expected,omitempty"`
+	Actual   string `json:"actual,omitempty"`
+	Error    string `json:"error,omitempty"`
+}
+
+// VerifyReport 清单校验报告
+type VerifyReport struct {
+	Algorithm  string         `json:"algorithm"`
+	Results    []VerifyResult `json:"results"`
+	OK         int            `json:"ok"`
+	Modified   int            `json:"modified"`
+	Missing    int            `json:"missing"`
+	Extra      int            `json:"extra"`
+	Unreadable int            `json:"unreadable"`
+}
+
+// Passed 校验是否全部通过（无缺失、无修改、无多余文件、无法读取的文件）
+func (r *VerifyReport) Passed() bool {
+	return r.Modified == 0 && r.Missing == 0 && r.Extra == 0 && r.Unreadable == 0
+}
+
+// DirectoryHasher 提供目录散列清单生成与校验功能
+type DirectoryHasher struct {
+	hasher *Hasher
+}
+
+// NewDirectoryHasher 创建新的 DirectoryHasher 实例
+func NewDirectoryHasher() *DirectoryHasher {
+	return &DirectoryHasher{
+		hasher: NewHasher(),
+	}
+}
+
+// HashFS 遍历文件系统并以有限并发计算每个文件的散列值，生成按路径排序的清单
+func (d *DirectoryHasher) HashFS(fsys fs.FS, opts DirectoryHashOptions) (*Manifest, error) {
+	if _, err := d.hasher.NewHash(opts.Algorithm); err != nil {
+		return nil, err
+	}
+	filter, err := NewPathFilter(opts.Include, opts.Exclude)
+	if err != nil {
+		return nil, err
+	}
+
+	paths, failed := d.collectFiles(fsys, filter, opts.ManifestPath)
+	if len(failed) > 0 {
+		return nil, failed[0].err
+	}
+
+	entries, errs := d.hashFiles(fsys, paths, opts.Algorithm, opts.Concurrency)
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &Manifest{
+		Algorithm: opts.Algorithm,
+		Entries:   entries,
+	}, nil
+}
+
+// Verify 使用清单校验文件系统，报告缺失、修改以及清单外的多余文件
+// 多余文件的判定会应用 opts 中的 include/exclude 规则；opts.Algorithm 被忽略，以清单算法为准
+func (d *DirectoryHasher) Verify(fsys fs.FS, manifest *Manifest, opts DirectoryHashOptions) (*VerifyReport, error) {
+	filter, err := NewPathFilter(opts.Include, opts.Exclude)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &VerifyReport{Algorithm: manifest.Algorithm}
+	results := make([]VerifyResult, len(manifest.Entries))
+	expected := make(map[string]bool, len(manifest.Entries))
+	var (
+		present []string
+		indexes []int
+	)
+	for i, entry := range manifest.Entries {
+		p := cleanManifestPath(entry.Path)
+		expected[p] = true
+		results[i] = VerifyResult{Path: p, Expected: entry.Hash}
+
+		info, err := fs.Stat(fsys, p)
+		switch {
+		case errors.Is(err, fs.ErrNotExist):
+			results[i].Status = VerifyStatusMissing
+		case err != nil:
+			results[i].Status = VerifyStatusUnreadable
+			results[i].Error = err.Error()
+		case info.IsDir():
+			results[i].Status = VerifyStatusUnreadable
+			results[i].Error = "清单条目指向目录而非文件"
+		default:
+			present = append(present, p)
+			indexes = append(indexes, i)
+		}
+	}
+
+	// 单个文件读取失败只记录在对应条目上，不影响其余条目的校验
+	hashed, errs := d.hashFiles(fsys, present, manifest.Algorithm, opts.Concurrency)
+	for j, i := range indexes {
+		if errs[j] != nil {
+			results[i].Status = VerifyStatusUnreadable
+			results[i].Error = errs[j].Error()
+			continue
+		}
+		results[i].Actual = hashed[j].Hash
+		if strings.EqualFold(hashed[j].Hash, results[i].Expected) {
+			results[i].Status = VerifyStatusOK
+		} else {
+			results[i].Status = VerifyStatusModified
+		}
+	}
+	report.Results = results
+
+	files, failed := d.collectFiles(fsys, filter, opts.ManifestPath)
+	for _, p := range files {
+		if expected[p] {
+			continue
+		}
+		report.Results = append(report.Results, VerifyResult{Path: p, Status: VerifyStatusExtra})
+	}
+	for _, f := range failed {
+		report.Results = append(report.Results, VerifyResult{Path: f.path, Status: VerifyStatusUnreadable, Error: f.err.Error()})
+	}
+
+	for _, result := range report.Results {
+		switch result.Status {
+		case VerifyStatusOK:
+			report.OK++
+		case VerifyStatusModified:
+			report.Modified++
+		case VerifyStatusMissing:
+			report.Missing++
+		case VerifyStatusExtra:
+			report.Extra++
+		case VerifyStatusUnreadable:
+			report.Unreadable++
+		}
+	}
+
+	return report, nil
+}
+
+// walkFailure 遍历目录时无法读取的路径
+type walkFailure struct {
+	path string
+	err  error
+}
+
+// collectFiles 收集通过过滤规则的普通文件路径（按字典序）
+// 清单文件自身（manifestPath 以及根目录下惯用的清单文件名）会被跳过；无法读取的目录记录后继续遍历
+func (d *DirectoryHasher) collectFiles(fsys fs.FS, filter *PathFilter, manifestPath string) ([]string, []walkFailure) {
+	skip := make(map[string]bool, len(algorithmTags)+2)
+	for alg := range algorithmTags {
+		skip[ManifestFileName(alg)] = true
+	}
+	skip[ManifestFileName("")] = true
+	if manifestPath != "" {
+		skip[cleanManifestPath(manifestPath)] = true
+	}
+
+	var (
+		paths  []string
+		failed []walkFailure
+	)
+	_ = fs.WalkDir(fsys, ".", func(p string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			if p == "." || !filter.SkipDir(p) {
+				failed = append(failed, walkFailure{path: p, err: errors.WithStack(err)})
+			}
+			if entry != nil && entry.IsDir() {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		if p == "." {
+			return nil
+		}
+		if entry.IsDir() {
+			if filter.SkipDir(p) {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		if !entry.Type().IsRegular() || skip[p] {
+			return nil
+		}
+		if filter.IncludeFile(p) {
+			paths = append(paths, p)
+		}
+		return nil
+	})
+	sort.Strings(paths)
+	return paths, failed
+}
+
+// hashFiles 使用固定数量的 worker 并发计算文件散列，结果与错误的顺序均与输入一致
+func (d *DirectoryHasher) hashFiles(fsys fs.FS, paths []string, algorithm string, concurrency int) ([]ManifestEntry, []error) {
+	if concurrency <= 0 {
+		concurrency = runtime.NumCPU()
+	}
+	if concurrency > MaxDirectoryHashConcurrency {
+		concurrency = MaxDirectoryHashConcurrency
+	}
+
+	entries := make([]ManifestEntry, len(paths))
+	errs := make([]error, len(paths))
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+
+	for w := 0; w < concurrency; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				entries[i], errs[i] = d.hashFile(fsys, paths[i], algorithm)
+			}
+		}()
+	}
+
+	for i := range paths {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	return entries, errs
+}
+
+// hashFile 以流式方式计算单个文件的散列值
+func (d *DirectoryHasher) hashFile(fsys fs.FS, p, algorithm string) (ManifestEntry, error) {
+	h, err := d.hasher.NewHash(algorithm)
+	if err != nil {
+		return ManifestEntry{}, err
+	}
+
+	file, err := fsys.Open(p)
+	if err != nil {
+		return ManifestEntry{}, errors.Wrapf(err, "无法打开文件: %s", p)
+	}
+	defer file.Close()
+
+	size, err := io.Copy(h, file)
+	if err != nil {
+		return ManifestEntry{}, errors.Wrapf(err, "无法读取文件内容: %s", p)
+	}
+
+	return ManifestEntry{
+		Path: p,
+		Hash: hex.EncodeToString(h.Sum(nil)),
+		Size: size,
+	}, nil
+}
+
+// cleanManifestPath 将清单中的路径规范化为 fs.FS 可用的相对路径
+func cleanManifestPath(p string) string {
+	p = strings.ReplaceAll(p, "\\", "/")
+	p = path.Clean(strings.TrimPrefix(p, "./"))
+	return strings.TrimPrefix(p, "/")
+}
@@ -0,0 +1,31 @@
+package domain
+
+// HashError 散列值工具错误类型
+type HashError struct {
+	Errmsg string
+}
+
+// Error 实现 error 接口
+func (e HashError) Error() string {
+	return e.Errmsg
+}
+
+// 预定义的错误
+var (
+	// ErrUnsupportedAlgorithm 不支持的散列算法
+	ErrUnsupportedAlgorithm = HashError{Errmsg: "不支持的散列算法"}
+	// ErrUnsupportedManifestFormat 不支持的清单格式
+	ErrUnsupportedManifestFormat = HashError{Errmsg: "不支持的清单格式"}
+	// ErrInvalidGlobPattern 无效的匹配规则
+	ErrInvalidGlobPattern = HashError{Errmsg: "无效的匹配规则"}
+	// ErrEmptyManifest 清单内容为空
+	ErrEmptyManifest = HashError{Errmsg: "清单内容为空"}
+	// ErrInvalidManifestLine 无法解析的清单行
+	ErrInvalidManifestLine = HashError{Errmsg: "无法解析的清单行"}
+	// ErrInvalidPasswordHash 无效的密码散列
+	ErrInvalidPasswordHash = HashError{Errmsg: "无效的密码散列"}
+	// ErrInvalidPasswordParams 无效的密码散列参数
+	ErrInvalidPasswordParams = HashError{Errmsg: "无效的密码散列参数"}
+	// ErrPasswordTooLong 密码超出算法支持的长度
+	ErrPasswordTooLong = HashError{Errmsg: "密码长度超出限制"}
+)
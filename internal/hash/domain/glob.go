@@ -0,0 +1,111 @@
+package domain
+
+import (
+	"path"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// PathFilter 基于 glob 规则的路径过滤器
+// 规则使用 "/" 作为分隔符，支持 "*"、"?"、"[...]" 以及匹配任意层级目录的 "**"；
+// 不包含 "/" 的规则只匹配文件名（例如 "*.log" 可匹配任意目录下的日志文件）
+type PathFilter struct {
+	include []string
+	exclude []string
+}
+
+// NewPathFilter 创建新的 PathFilter 实例，会预先校验所有规则
+func NewPathFilter(include, exclude []string) (*PathFilter, error) {
+	f := &PathFilter{
+		include: normalizePatterns(include),
+		exclude: normalizePatterns(exclude),
+	}
+	for _, pattern := range append(append([]string{}, f.include...), f.exclude...) {
+		for _, segment := range strings.Split(pattern, "/") {
+			if _, err := path.Match(segment, ""); err != nil {
+				return nil, errors.Wrapf(ErrInvalidGlobPattern, "无效的匹配规则: %s", pattern)
+			}
+		}
+	}
+	return f, nil
+}
+
+// normalizePatterns 去除空白规则并统一分隔符
+func normalizePatterns(patterns []string) []string {
+	result := make([]string, 0, len(patterns))
+	for _, pattern := range patterns {
+		pattern = strings.TrimSpace(pattern)
+		if pattern == "" {
+			continue
+		}
+		pattern = strings.ReplaceAll(pattern, "\\", "/")
+		pattern = strings.TrimPrefix(pattern, "./")
+		result = append(result, pattern)
+	}
+	return result
+}
+
+// IncludeFile 判断文件是否应被包含
+// 未配置 include 规则时默认包含全部文件，exclude 规则优先于 include 规则
+func (f *PathFilter) IncludeFile(relPath string) bool {
+	if f.matchAny(f.exclude, relPath) {
+		return false
+	}
+	if len(f.include) == 0 {
+		return true
+	}
+	return f.matchAny(f.include, relPath)
+}
+
+// SkipDir 判断目录是否应被整体跳过（仅由 exclude 规则决定）
+func (f *PathFilter) SkipDir(relPath string) bool {
+	return f.matchAny(f.exclude, relPath)
+}
+
+// matchAny 判断路径是否匹配任意一条规则
+func (f *PathFilter) matchAny(patterns []string, relPath string) bool {
+	for _, pattern := range patterns {
+		if matchGlob(pattern, relPath) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchGlob 使用单条规则匹配路径
+func matchGlob(pattern, relPath string) bool {
+	if !strings.Contains(pattern, "/") {
+		matched, _ := path.Match(pattern, path.Base(relPath))
+		return matched
+	}
+	return matchSegments(strings.Split(pattern, "/"), strings.Split(relPath, "/"))
+}
+
+// matchSegments 逐段匹配路径，"**" 可匹配零个或多个目录层级
+func matchSegments(patternParts, pathParts []string) bool {
+	for len(patternParts) > 0 {
+		if patternParts[0] == "**" {
+			rest := patternParts[1:]
+			if len(rest) == 0 {
+				return true
+			}
+			for i := 0; i <= len(pathParts); i++ {
+				if matchSegments(rest, pathParts[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+		if len(pathParts) == 0 {
+			return false
+		}
+		matched, _ := path.Match(patternParts[0], pathParts[0])
+		if !matched {
+			return false
+		}
+		patternParts = patternParts[1:]
+		pathParts = pathParts[1:]
+	}
+	return len(pathParts) == 0
+}
@@ -6,7 +6,9 @@ import (
 	"crypto/sha256"
 	"crypto/sha512"
 	"encoding/hex"
-	"fmt"
+	"hash"
+
+	"github.com/pkg/errors"
 )
 
 // Hasher 提供散列计算功能
@@ -53,6 +55,22 @@ func (h *Hasher) Hash(algorithm string, data []byte) (string, error) {
 	case "sha512":
 		return h.HashSHA512(data), nil
 	default:
-		return "", fmt.Errorf("不支持的散列算法: %s", algorithm)
+		return "", errors.Wrapf(ErrUnsupportedAlgorithm, "不支持的散列算法: %s", algorithm)
+	}
+}
+
+// NewHash 根据算法名称创建流式散列计算器，用于大文件等无需整体读入内存的场景
+func (h *Hasher) NewHash(algorithm string) (hash.Hash, error) {
+	switch algorithm {
+	case "md5":
+		return md5.New(), nil
+	case "sha1":
+		return sha1.New(), nil
+	case "sha256":
+		return sha256.New(), nil
+	case "sha512":
+		return sha512.New(), nil
+	default:
+		return nil, errors.Wrapf(ErrUnsupportedAlgorithm, "不支持的散列算法: %s", algorithm)
 	}
 }
@@ -0,0 +1,171 @@
+package domain
+
+import (
+	"bufio"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// ManifestFormatGNU GNU coreutils 格式（sha256sum 输出）："<hash>  <path>"
+	ManifestFormatGNU = "gnu"
+	// ManifestFormatBSD BSD 格式（shasum --tag 输出）："SHA256 (<path>) = <hash>"
+	ManifestFormatBSD = "bsd"
+)
+
+// algorithmTags 散列算法与 BSD 格式标签的对应关系
+var algorithmTags = map[string]string{
+	"md5":    "MD5",
+	"sha1":   "SHA1",
+	"sha256": "SHA256",
+	"sha512": "SHA512",
+}
+
+// algorithmHexLengths 散列值十六进制长度与算法的对应关系，用于识别 GNU 格式清单的算法
+var algorithmHexLengths = map[int]string{
+	32:  "md5",
+	40:  "sha1",
+	64:  "sha256",
+	128: "sha512",
+}
+
+var (
+	bsdLinePattern = regexp.MustCompile(`^(\\?)([A-Za-z0-9-]+) ?\((.*)\) ?= ([0-9a-fA-F]+)$`)
+	gnuLinePattern = regexp.MustCompile(`^(\\?)([0-9a-fA-F]+) [ *](.+)$`)
+)
+
+// ManifestEntry 清单中的单个文件条目
+type ManifestEntry struct {
+	Path string `json:"path"`
+	Hash string `json:"hash"`
+	Size int64  `json:"size"`
+}
+
+// Manifest 目录散列清单
+type Manifest struct {
+	Algorithm string          `json:"algorithm"`
+	Entries   []ManifestEntry `json:"entries"`
+}
+
+// ManifestFileName 返回与算法对应的惯用清单文件名，例如 SHA256SUMS
+func ManifestFileName(algorithm string) string {
+	tag, ok := algorithmTags[algorithm]
+	if !ok {
+		return "CHECKSUMS"
+	}
+	return tag + "SUMS"
+}
+
+// FormatManifest 将清单渲染为指定格式的文本
+func FormatManifest(manifest *Manifest, format string) (string, error) {
+	tag, ok := algorithmTags[manifest.Algorithm]
+	if !ok {
+		return "", errors.Wrapf(ErrUnsupportedAlgorithm, "不支持的散列算法: %s", manifest.Algorithm)
+	}
+
+	var sb strings.Builder
+	for _, entry := range manifest.Entries {
+		escaped, needEscape := escapeManifestPath(entry.Path)
+		prefix := ""
+		if needEscape {
+			prefix = "\\"
+		}
+		switch format {
+		case ManifestFormatGNU, "":
+			fmt.Fprintf(&sb, "%s%s  %s\n", prefix, entry.Hash, escaped)
+		case ManifestFormatBSD:
+			fmt.Fprintf(&sb, "%s%s (%s) = %s\n", prefix, tag, escaped, entry.Hash)
+		default:
+			return "", errors.Wrapf(ErrUnsupportedManifestFormat, "不支持的清单格式: %s", format)
+		}
+	}
+	return sb.String(), nil
+}
+
+// ParseManifest 解析 GNU 或 BSD 格式的清单文本
+// algorithm 为空时根据 BSD 标签或散列值长度自动识别算法
+func ParseManifest(content, algorithm string) (*Manifest, error) {
+	if strings.TrimSpace(content) == "" {
+		return nil, errors.WithStack(ErrEmptyManifest)
+	}
+
+	manifest := &Manifest{Algorithm: algorithm}
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if strings.TrimSpace(line) == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		entry, lineAlgorithm, err := parseManifestLine(line)
+		if err != nil {
+			return nil, errors.Wrapf(err, "第 %d 行", lineNo)
+		}
+		if manifest.Algorithm == "" {
+			manifest.Algorithm = lineAlgorithm
+		} else if lineAlgorithm != "" && lineAlgorithm != manifest.Algorithm {
+			return nil, errors.Wrapf(ErrInvalidManifestLine, "第 %d 行: 算法 %s 与清单算法 %s 不一致", lineNo, lineAlgorithm, manifest.Algorithm)
+		}
+		manifest.Entries = append(manifest.Entries, entry)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if len(manifest.Entries) == 0 {
+		return nil, errors.WithStack(ErrEmptyManifest)
+	}
+	if _, ok := algorithmTags[manifest.Algorithm]; !ok {
+		return nil, errors.Wrapf(ErrUnsupportedAlgorithm, "不支持的散列算法: %s", manifest.Algorithm)
+	}
+	return manifest, nil
+}
+
+// parseManifestLine 解析单行清单，返回条目以及从该行识别出的算法
+func parseManifestLine(line string) (ManifestEntry, string, error) {
+	if m := bsdLinePattern.FindStringSubmatch(line); m != nil {
+		algorithm := ""
+		for name, tag := range algorithmTags {
+			if strings.EqualFold(tag, strings.ReplaceAll(m[2], "-", "")) {
+				algorithm = name
+			}
+		}
+		if algorithm == "" {
+			return ManifestEntry{}, "", errors.Wrapf(ErrUnsupportedAlgorithm, "不支持的散列算法: %s", m[2])
+		}
+		return ManifestEntry{Path: unescapeManifestPath(m[3], m[1] != ""), Hash: strings.ToLower(m[4])}, algorithm, nil
+	}
+
+	if m := gnuLinePattern.FindStringSubmatch(line); m != nil {
+		algorithm, ok := algorithmHexLengths[len(m[2])]
+		if !ok {
+			return ManifestEntry{}, "", errors.Wrapf(ErrInvalidManifestLine, "无法识别的散列值长度: %d", len(m[2]))
+		}
+		return ManifestEntry{Path: unescapeManifestPath(m[3], m[1] != ""), Hash: strings.ToLower(m[2])}, algorithm, nil
+	}
+
+	return ManifestEntry{}, "", errors.Wrapf(ErrInvalidManifestLine, "无法解析的清单行: %s", line)
+}
+
+// escapeManifestPath 按 coreutils 规则转义文件名中的反斜杠和换行符
+func escapeManifestPath(p string) (string, bool) {
+	if !strings.ContainsAny(p, "\\\n\r") {
+		return p, false
+	}
+	replacer := strings.NewReplacer("\\", "\\\\", "\n", "\\n", "\r", "\\r")
+	return replacer.Replace(p), true
+}
+
+// unescapeManifestPath 还原 coreutils 转义过的文件名
+func unescapeManifestPath(p string, escaped bool) string {
+	if !escaped {
+		return p
+	}
+	replacer := strings.NewReplacer("\\\\", "\\", "\\n", "\n", "\\r", "\r")
+	return replacer.Replace(p)
+}
@@ -0,0 +1,178 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+func buildTestFS() fstest.MapFS {
+	return fstest.MapFS{
+		"a.txt":             {Data: []byte("hello")},
+		"docs/readme.md":    {Data: []byte("# readme")},
+		"docs/build.log":    {Data: []byte("log")},
+		"node_modules/x.js": {Data: []byte("x")},
+	}
+}
+
+func TestDirectoryHasher_HashFSWithRules(t *testing.T) {
+	hasher := NewDirectoryHasher()
+
+	manifest, err := hasher.HashFS(buildTestFS(), DirectoryHashOptions{
+		Algorithm:   "sha256",
+		Exclude:     []string{"*.log", "node_modules"},
+		Concurrency: 2,
+	})
+	if err != nil {
+		t.Fatalf("HashFS() error = %v", err)
+	}
+
+	if len(manifest.Entries) != 2 {
+		t.Fatalf("HashFS() entries = %d, want 2", len(manifest.Entries))
+	}
+	if manifest.Entries[0].Path != "a.txt" || manifest.Entries[1].Path != "docs/readme.md" {
+		t.Errorf("HashFS() paths = %v", manifest.Entries)
+	}
+	if manifest.Entries[0].Hash != NewHasher().HashSHA256([]byte("hello")) {
+		t.Errorf("HashFS() hash = %s", manifest.Entries[0].Hash)
+	}
+}
+
+func TestPathFilter_DoubleStar(t *testing.T) {
+	filter, err := NewPathFilter([]string{"docs/**/*.md"}, nil)
+	if err != nil {
+		t.Fatalf("NewPathFilter() error = %v", err)
+	}
+
+	if !filter.IncludeFile("docs/readme.md") || !filter.IncludeFile("docs/a/b/c.md") {
+		t.Errorf("IncludeFile() should match nested markdown files")
+	}
+	if filter.IncludeFile("src/readme.md") {
+		t.Errorf("IncludeFile() should not match files outside docs")
+	}
+
+	if _, err := NewPathFilter([]string{"[a-"}, nil); err == nil {
+		t.Errorf("NewPathFilter() should reject malformed patterns")
+	}
+}
+
+func TestManifest_FormatAndParseRoundTrip(t *testing.T) {
+	manifest := &Manifest{
+		Algorithm: "md5",
+		Entries: []ManifestEntry{
+			{Path: "a.txt", Hash: NewHasher().HashMD5([]byte("a"))},
+			{Path: "odd\nname", Hash: NewHasher().HashMD5([]byte("b"))},
+		},
+	}
+
+	for _, format := range []string{ManifestFormatGNU, ManifestFormatBSD} {
+		content, err := FormatManifest(manifest, format)
+		if err != nil {
+			t.Fatalf("FormatManifest(%s) error = %v", format, err)
+		}
+
+		parsed, err := ParseManifest(content, "")
+		if err != nil {
+			t.Fatalf("ParseManifest(%s) error = %v", format, err)
+		}
+		if parsed.Algorithm != "md5" || len(parsed.Entries) != 2 {
+			t.Fatalf("ParseManifest(%s) = %+v", format, parsed)
+		}
+		if parsed.Entries[1].Path != "odd\nname" {
+			t.Errorf("ParseManifest(%s) escaped path = %q", format, parsed.Entries[1].Path)
+		}
+	}
+
+	if _, err := ParseManifest("not a manifest line", ""); err == nil {
+		t.Errorf("ParseManifest() should fail on malformed lines")
+	}
+}
+
+func TestDirectoryHasher_Verify(t *testing.T) {
+	hasher := NewDirectoryHasher()
+	content := strings.Join([]string{
+		NewHasher().HashSHA256([]byte("hello")) + "  a.txt",
+		NewHasher().HashSHA256([]byte("changed")) + " *docs/readme.md",
+		NewHasher().HashSHA256([]byte("gone")) + "  gone.txt",
+	}, "\n")
+
+	manifest, err := ParseManifest(content, "")
+	if err != nil {
+		t.Fatalf("ParseManifest() error = %v", err)
+	}
+
+	report, err := hasher.Verify(buildTestFS(), manifest, DirectoryHashOptions{Exclude: []string{"node_modules"}})
+	if err != nil {
+		t.Fatalf("Verify() error = %v", err)
+	}
+
+	if report.OK != 1 || report.Modified != 1 || report.Missing != 1 || report.Extra != 1 {
+		t.Errorf("Verify() report = %+v", report)
+	}
+	if report.Passed() {
+		t.Errorf("Passed() = true, want false")
+	}
+}
+
+func TestDirectoryHasher_VerifySkipsManifestItself(t *testing.T) {
+	hasher := NewDirectoryHasher()
+	fsys := fstest.MapFS{
+		"a.txt": {Data: []byte("hello")},
+	}
+
+	manifest, err := hasher.HashFS(fsys, DirectoryHashOptions{Algorithm: "sha256"})
+	if err != nil {
+		t.Fatalf("HashFS() error = %v", err)
+	}
+	content, err := FormatManifest(manifest, ManifestFormatGNU)
+	if err != nil {
+		t.Fatalf("FormatManifest() error = %v", err)
+	}
+	fsys["SHA256SUMS"] = &fstest.MapFile{Data: []byte(content)}
+	fsys["checks/custom.sums"] = &fstest.MapFile{Data: []byte(content)}
+
+	rehashed, err := hasher.HashFS(fsys, DirectoryHashOptions{Algorithm: "sha256", ManifestPath: "checks/custom.sums"})
+	if err != nil {
+		t.Fatalf("HashFS() error = %v", err)
+	}
+	if len(rehashed.Entries) != 1 || rehashed.Entries[0].Path != "a.txt" {
+		t.Errorf("HashFS() entries = %v, want only a.txt", rehashed.Entries)
+	}
+
+	report, err := hasher.Verify(fsys, manifest, DirectoryHashOptions{ManifestPath: "./checks/custom.sums"})
+	if err != nil {
+		t.Fatalf("Verify() error = %v", err)
+	}
+	if !report.Passed() {
+		t.Errorf("Verify() report = %+v, want passed", report)
+	}
+}
+
+func TestDirectoryHasher_VerifyReportsUnreadableEntries(t *testing.T) {
+	hasher := NewDirectoryHasher()
+	content := strings.Join([]string{
+		NewHasher().HashSHA256([]byte("hello")) + "  a.txt",
+		NewHasher().HashSHA256([]byte("dir")) + "  docs",
+	}, "\n")
+
+	manifest, err := ParseManifest(content, "")
+	if err != nil {
+		t.Fatalf("ParseManifest() error = %v", err)
+	}
+
+	report, err := hasher.Verify(buildTestFS(), manifest, DirectoryHashOptions{Exclude: []string{"docs", "node_modules"}})
+	if err != nil {
+		t.Fatalf("Verify() error = %v", err)
+	}
+
+	if report.OK != 1 || report.Unreadable != 1 {
+		t.Fatalf("Verify() report = %+v", report)
+	}
+	result := report.Results[1]
+	if result.Path != "docs" || result.Status != VerifyStatusUnreadable || result.Error == "" {
+		t.Errorf("Verify() result = %+v, want unreadable with error", result)
+	}
+	if report.Passed() {
+		t.Errorf("Passed() = true, want false")
+	}
+}
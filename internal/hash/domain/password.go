@@ -0,0 +1,481 @@
+package domain
+
+import (
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"hash"
+	"math/bits"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	// PasswordAlgorithmBcrypt bcrypt
+	PasswordAlgorithmBcrypt = "bcrypt"
+	// PasswordAlgorithmScrypt scrypt
+	PasswordAlgorithmScrypt = "scrypt"
+	// PasswordAlgorithmArgon2id Argon2id
+	PasswordAlgorithmArgon2id = "argon2id"
+	// PasswordAlgorithmPBKDF2 PBKDF2
+	PasswordAlgorithmPBKDF2 = "pbkdf2"
+)
+
+// 默认参数（参考 OWASP Password Storage Cheat Sheet 与 RFC 9106）
+const (
+	DefaultBcryptCost        = bcrypt.DefaultCost
+	DefaultScryptN           = 1 << 15
+	DefaultScryptR           = 8
+	DefaultScryptP           = 1
+	DefaultArgon2Memory      = 64 * 1024 // KiB
+	DefaultArgon2Iterations  = 3
+	DefaultArgon2Parallelism = 4
+	DefaultPBKDF2Iterations  = 600000
+	DefaultPBKDF2Digest      = "sha256"
+	DefaultPasswordSaltLen   = 16
+	DefaultPasswordKeyLen    = 32
+)
+
+// 参数上限，避免桌面端计算时间或内存占用失控
+const (
+	maxScryptLogN       = 20
+	maxArgon2Memory     = 1024 * 1024 // KiB
+	maxArgon2Iterations = 100
+	maxPBKDF2Iterations = 10000000
+	maxPasswordSaltLen  = 64
+	maxPasswordKeyLen   = 128
+)
+
+// phcEncoding PHC 字符串使用的无填充标准 Base64
+var phcEncoding = base64.RawStdEncoding
+
+// passlibEncoding passlib 使用的 "adapted base64"（以 "." 代替 "+"）
+var passlibEncoding = base64.NewEncoding("ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789./").WithPadding(base64.NoPadding)
+
+// PasswordHashOptions 密码散列参数
+// 未设置（零值）的字段使用对应算法的默认值
+type PasswordHashOptions struct {
+	Algorithm   string `json:"algorithm"`
+	Cost        int    `json:"cost"`        // bcrypt cost（4-31）
+	N           int    `json:"n"`           // scrypt CPU/内存开销，必须为 2 的幂
+	R           int    `json:"r"`           // scrypt 块大小
+	P           int    `json:"p"`           // scrypt 并行度
+	Memory      int    `json:"memory"`      // Argon2id 内存（KiB）
+	Iterations  int    `json:"iterations"`  // Argon2id 迭代次数 / PBKDF2 轮数
+	Parallelism int    `json:"parallelism"` // Argon2id 并行度
+	Digest      string `json:"digest"`      // PBKDF2 摘要算法：sha1、sha256、sha512
+	SaltLength  int    `json:"saltLength"`  // 盐长度（字节）
+	KeyLength   int    `json:"keyLength"`   // 派生密钥长度（字节）
+}
+
+// PasswordHashInfo 密码散列中解析出的参数
+type PasswordHashInfo struct {
+	Algorithm  string            `json:"algorithm"`
+	Variant    string            `json:"variant"`
+	Version    string            `json:"version,omitempty"`
+	Params     map[string]string `json:"params"`
+	Salt       string            `json:"salt"`
+	Hash       string            `json:"hash"`
+	SaltLength int               `json:"saltLength"`
+	KeyLength  int               `json:"keyLength"`
+}
+
+// PasswordHasher 提供密码散列生成、校验与参数解析功能
+type PasswordHasher struct{}
+
+// NewPasswordHasher 创建新的 PasswordHasher 实例
+func NewPasswordHasher() *PasswordHasher {
+	return &PasswordHasher{}
+}
+
+// Hash 使用指定算法和参数生成密码散列
+// bcrypt 输出 "$2b$" 格式，其余算法输出 PHC 字符串格式
+func (h *PasswordHasher) Hash(password string, opts PasswordHashOptions) (string, error) {
+	opts = withPasswordDefaults(opts)
+	if err := validatePasswordOptions(opts); err != nil {
+		return "", err
+	}
+
+	if opts.Algorithm == PasswordAlgorithmBcrypt {
+		return h.hashBcrypt(password, opts.Cost)
+	}
+
+	salt := make([]byte, opts.SaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", errors.WithStack(err)
+	}
+	return h.hashWithSalt(password, salt, opts)
+}
+
+// hashBcrypt 生成 bcrypt 散列
+func (h *PasswordHasher) hashBcrypt(password string, cost int) (string, error) {
+	if len(password) > 72 {
+		return "", errors.Wrapf(ErrPasswordTooLong, "bcrypt 最多支持 72 字节，当前 %d 字节", len(password))
+	}
+	hashed, err := bcrypt.GenerateFromPassword([]byte(password), cost)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+	// $2a$ 与 $2b$ 在 72 字节限制内计算结果相同，统一输出当前推荐的 $2b$ 前缀
+	return "$2b$" + strings.TrimPrefix(string(hashed), "$2a$"), nil
+}
+
+// hashWithSalt 使用给定盐生成 PHC 格式散列
+func (h *PasswordHasher) hashWithSalt(password string, salt []byte, opts PasswordHashOptions) (string, error) {
+	switch opts.Algorithm {
+	case PasswordAlgorithmScrypt:
+		key, err := scrypt.Key([]byte(password), salt, opts.N, opts.R, opts.P, opts.KeyLength)
+		if err != nil {
+			return "", errors.WithStack(err)
+		}
+		return fmt.Sprintf("$scrypt$ln=%d,r=%d,p=%d$%s$%s",
+			bits.TrailingZeros(uint(opts.N)), opts.R, opts.P,
+			phcEncoding.EncodeToString(salt), phcEncoding.EncodeToString(key)), nil
+	case PasswordAlgorithmArgon2id:
+		key := argon2.IDKey([]byte(password), salt, uint32(opts.Iterations), uint32(opts.Memory), uint8(opts.Parallelism), uint32(opts.KeyLength))
+		return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+			argon2.Version, opts.Memory, opts.Iterations, opts.Parallelism,
+			phcEncoding.EncodeToString(salt), phcEncoding.EncodeToString(key)), nil
+	case PasswordAlgorithmPBKDF2:
+		digest, _ := pbkdf2Digest(opts.Digest)
+		key, err := pbkdf2.Key(digest, password, salt, opts.Iterations, opts.KeyLength)
+		if err != nil {
+			return "", errors.WithStack(err)
+		}
+		return fmt.Sprintf("$pbkdf2-%s$i=%d,l=%d$%s$%s",
+			opts.Digest, opts.Iterations, opts.KeyLength,
+			phcEncoding.EncodeToString(salt), phcEncoding.EncodeToString(key)), nil
+	default:
+		return "", errors.Wrapf(ErrUnsupportedAlgorithm, "不支持的散列算法: %s", opts.Algorithm)
+	}
+}
+
+// Verify 校验明文密码是否与已有散列匹配
+func (h *PasswordHasher) Verify(password, encoded string) (bool, error) {
+	encoded = strings.TrimSpace(encoded)
+	info, err := h.Inspect(encoded)
+	if err != nil {
+		return false, err
+	}
+
+	if info.Algorithm == PasswordAlgorithmBcrypt {
+		err := bcrypt.CompareHashAndPassword([]byte(encoded), []byte(password))
+		if err == nil {
+			return true, nil
+		}
+		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+			return false, nil
+		}
+		return false, errors.Wrapf(ErrInvalidPasswordHash, "bcrypt: %v", err)
+	}
+
+	opts, salt, expected, err := optionsFromInfo(info)
+	if err != nil {
+		return false, err
+	}
+	if err := validatePasswordOptions(opts); err != nil {
+		return false, err
+	}
+
+	recomputed, err := h.hashWithSalt(password, salt, opts)
+	if err != nil {
+		return false, err
+	}
+	actual, err := h.Inspect(recomputed)
+	if err != nil {
+		return false, err
+	}
+	actualKey, _ := phcEncoding.DecodeString(actual.Hash)
+	return subtle.ConstantTimeCompare(actualKey, expected) == 1, nil
+}
+
+// Inspect 解析密码散列中嵌入的算法、版本、参数、盐和密钥
+// 支持 bcrypt（$2a$/$2b$/$2y$）以及 scrypt、Argon2、PBKDF2 的 PHC 字符串
+func (h *PasswordHasher) Inspect(encoded string) (*PasswordHashInfo, error) {
+	encoded = strings.TrimSpace(encoded)
+	if encoded == "" {
+		return nil, errors.WithStack(ErrInvalidPasswordHash)
+	}
+
+	if strings.HasPrefix(encoded, "$2") {
+		return inspectBcrypt(encoded)
+	}
+
+	parts := strings.Split(encoded, "$")
+	if len(parts) < 4 || parts[0] != "" {
+		return nil, errors.Wrapf(ErrInvalidPasswordHash, "无法识别的散列格式")
+	}
+
+	id := parts[1]
+	fields := parts[2:]
+	info := &PasswordHashInfo{Variant: id, Params: map[string]string{}}
+
+	// 可选的 v=<version> 段
+	if strings.HasPrefix(fields[0], "v=") {
+		info.Version = strings.TrimPrefix(fields[0], "v=")
+		fields = fields[1:]
+	}
+	if len(fields) != 3 {
+		return nil, errors.Wrapf(ErrInvalidPasswordHash, "PHC 字符串段数不正确")
+	}
+
+	switch {
+	case id == "scrypt":
+		info.Algorithm = PasswordAlgorithmScrypt
+	case id == "argon2id", id == "argon2i", id == "argon2d":
+		// argon2i/argon2d 仅支持解析参数，校验时会返回不支持的错误
+		info.Algorithm = id
+	case strings.HasPrefix(id, "pbkdf2"):
+		info.Algorithm = PasswordAlgorithmPBKDF2
+		digest := strings.TrimPrefix(strings.TrimPrefix(id, "pbkdf2"), "-")
+		if digest == "" {
+			digest = "sha1"
+		}
+		info.Params["digest"] = digest
+	default:
+		return nil, errors.Wrapf(ErrUnsupportedAlgorithm, "不支持的散列算法: %s", id)
+	}
+
+	// passlib 风格的 PBKDF2 使用纯数字轮数而非 key=value 参数
+	if info.Algorithm == PasswordAlgorithmPBKDF2 && isDigits(fields[0]) {
+		info.Params["i"] = fields[0]
+	} else {
+		for _, kv := range strings.Split(fields[0], ",") {
+			key, value, ok := strings.Cut(kv, "=")
+			if !ok {
+				return nil, errors.Wrapf(ErrInvalidPasswordHash, "无效的参数: %s", kv)
+			}
+			info.Params[key] = value
+		}
+	}
+
+	salt, err := decodePHCBase64(fields[1])
+	if err != nil {
+		return nil, errors.Wrapf(ErrInvalidPasswordHash, "无效的盐: %v", err)
+	}
+	key, err := decodePHCBase64(fields[2])
+	if err != nil {
+		return nil, errors.Wrapf(ErrInvalidPasswordHash, "无效的散列值: %v", err)
+	}
+	info.Salt = phcEncoding.EncodeToString(salt)
+	info.Hash = phcEncoding.EncodeToString(key)
+	info.SaltLength = len(salt)
+	info.KeyLength = len(key)
+	return info, nil
+}
+
+// inspectBcrypt 解析 bcrypt 散列："$2b$<cost>$<22 位盐><31 位散列>"
+func inspectBcrypt(encoded string) (*PasswordHashInfo, error) {
+	parts := strings.Split(encoded, "$")
+	if len(parts) != 4 || len(parts[3]) != 53 {
+		return nil, errors.Wrapf(ErrInvalidPasswordHash, "无效的 bcrypt 散列")
+	}
+	cost, err := strconv.Atoi(parts[2])
+	if err != nil {
+		return nil, errors.Wrapf(ErrInvalidPasswordHash, "无效的 bcrypt cost: %s", parts[2])
+	}
+	return &PasswordHashInfo{
+		Algorithm:  PasswordAlgorithmBcrypt,
+		Variant:    parts[1],
+		Params:     map[string]string{"cost": strconv.Itoa(cost)},
+		Salt:       parts[3][:22],
+		Hash:       parts[3][22:],
+		SaltLength: 16,
+		KeyLength:  23,
+	}, nil
+}
+
+// optionsFromInfo 根据解析结果还原计算参数、盐和期望的密钥
+func optionsFromInfo(info *PasswordHashInfo) (PasswordHashOptions, []byte, []byte, error) {
+	salt, _ := phcEncoding.DecodeString(info.Salt)
+	key, _ := phcEncoding.DecodeString(info.Hash)
+	opts := PasswordHashOptions{
+		Algorithm:  info.Algorithm,
+		SaltLength: len(salt),
+		KeyLength:  len(key),
+	}
+
+	var err error
+	param := func(name string) int {
+		if err != nil {
+			return 0
+		}
+		value, ok := info.Params[name]
+		if !ok {
+			err = errors.Wrapf(ErrInvalidPasswordHash, "缺少参数: %s", name)
+			return 0
+		}
+		n, convErr := strconv.Atoi(value)
+		if convErr != nil {
+			err = errors.Wrapf(ErrInvalidPasswordHash, "无效的参数 %s=%s", name, value)
+		}
+		return n
+	}
+
+	switch info.Algorithm {
+	case PasswordAlgorithmScrypt:
+		ln := param("ln")
+		if err == nil && (ln < 1 || ln > maxScryptLogN) {
+			err = errors.Wrapf(ErrInvalidPasswordHash, "scrypt ln 必须在 1 到 %d 之间: %d", maxScryptLogN, ln)
+		}
+		if err == nil {
+			opts.N = 1 << ln
+		}
+		opts.R = param("r")
+		opts.P = param("p")
+	case PasswordAlgorithmArgon2id:
+		opts.Memory = param("m")
+		opts.Iterations = param("t")
+		opts.Parallelism = param("p")
+	case PasswordAlgorithmPBKDF2:
+		opts.Iterations = param("i")
+		opts.Digest = info.Params["digest"]
+	default:
+		return opts, nil, nil, errors.Wrapf(ErrUnsupportedAlgorithm, "不支持校验的散列算法: %s", info.Algorithm)
+	}
+	if err != nil {
+		return opts, nil, nil, err
+	}
+	return opts, salt, key, nil
+}
+
+// withPasswordDefaults 为未设置的参数填充默认值
+func withPasswordDefaults(opts PasswordHashOptions) PasswordHashOptions {
+	opts.Algorithm = strings.ToLower(strings.TrimSpace(opts.Algorithm))
+	if opts.SaltLength == 0 {
+		opts.SaltLength = DefaultPasswordSaltLen
+	}
+	if opts.KeyLength == 0 {
+		opts.KeyLength = DefaultPasswordKeyLen
+	}
+	switch opts.Algorithm {
+	case PasswordAlgorithmBcrypt:
+		if opts.Cost == 0 {
+			opts.Cost = DefaultBcryptCost
+		}
+	case PasswordAlgorithmScrypt:
+		if opts.N == 0 {
+			opts.N = DefaultScryptN
+		}
+		if opts.R == 0 {
+			opts.R = DefaultScryptR
+		}
+		if opts.P == 0 {
+			opts.P = DefaultScryptP
+		}
+	case PasswordAlgorithmArgon2id:
+		if opts.Memory == 0 {
+			opts.Memory = DefaultArgon2Memory
+		}
+		if opts.Iterations == 0 {
+			opts.Iterations = DefaultArgon2Iterations
+		}
+		if opts.Parallelism == 0 {
+			opts.Parallelism = DefaultArgon2Parallelism
+		}
+	case PasswordAlgorithmPBKDF2:
+		if opts.Iterations == 0 {
+			opts.Iterations = DefaultPBKDF2Iterations
+		}
+		if opts.Digest == "" {
+			opts.Digest = DefaultPBKDF2Digest
+		}
+	}
+	return opts
+}
+
+// validatePasswordOptions 校验参数范围
+func validatePasswordOptions(opts PasswordHashOptions) error {
+	invalid := func(format string, args ...interface{}) error {
+		return errors.Wrapf(ErrInvalidPasswordParams, format, args...)
+	}
+
+	if opts.Algorithm != PasswordAlgorithmBcrypt {
+		if opts.SaltLength < 8 || opts.SaltLength > maxPasswordSaltLen {
+			return invalid("盐长度必须在 8-%d 字节之间", maxPasswordSaltLen)
+		}
+		if opts.KeyLength < 16 || opts.KeyLength > maxPasswordKeyLen {
+			return invalid("密钥长度必须在 16-%d 字节之间", maxPasswordKeyLen)
+		}
+	}
+
+	switch opts.Algorithm {
+	case PasswordAlgorithmBcrypt:
+		if opts.Cost < bcrypt.MinCost || opts.Cost > bcrypt.MaxCost {
+			return invalid("bcrypt cost 必须在 %d-%d 之间", bcrypt.MinCost, bcrypt.MaxCost)
+		}
+	case PasswordAlgorithmScrypt:
+		if opts.N < 2 || opts.N&(opts.N-1) != 0 || opts.N > 1<<maxScryptLogN {
+			return invalid("scrypt N 必须是 2 到 2^%d 之间的 2 的幂", maxScryptLogN)
+		}
+		if opts.R < 1 || opts.P < 1 || opts.R*opts.P >= 1<<30 {
+			return invalid("scrypt r、p 必须为正数且 r*p < 2^30")
+		}
+	case PasswordAlgorithmArgon2id:
+		if opts.Memory < 8*opts.Parallelism || opts.Memory > maxArgon2Memory {
+			return invalid("Argon2id 内存必须在 %d-%d KiB 之间", 8*opts.Parallelism, maxArgon2Memory)
+		}
+		if opts.Iterations < 1 || opts.Iterations > maxArgon2Iterations {
+			return invalid("Argon2id 迭代次数必须在 1-%d 之间", maxArgon2Iterations)
+		}
+		if opts.Parallelism < 1 || opts.Parallelism > 255 {
+			return invalid("Argon2id 并行度必须在 1-255 之间")
+		}
+	case PasswordAlgorithmPBKDF2:
+		if _, err := pbkdf2Digest(opts.Digest); err != nil {
+			return err
+		}
+		if opts.Iterations < 1 || opts.Iterations > maxPBKDF2Iterations {
+			return invalid("PBKDF2 轮数必须在 1-%d 之间", maxPBKDF2Iterations)
+		}
+	default:
+		return errors.Wrapf(ErrUnsupportedAlgorithm, "不支持的密码散列算法: %s", opts.Algorithm)
+	}
+	return nil
+}
+
+// pbkdf2Digest 根据名称返回 PBKDF2 使用的摘要函数
+func pbkdf2Digest(name string) (func() hash.Hash, error) {
+	switch name {
+	case "sha1":
+		return sha1.New, nil
+	case "sha256":
+		return sha256.New, nil
+	case "sha512":
+		return sha512.New, nil
+	default:
+		return nil, errors.Wrapf(ErrUnsupportedAlgorithm, "不支持的 PBKDF2 摘要算法: %s", name)
+	}
+}
+
+// decodePHCBase64 解码 PHC 字符串中的 Base64 段，兼容 passlib 的 adapted base64
+func decodePHCBase64(s string) ([]byte, error) {
+	s = strings.TrimRight(s, "=")
+	if strings.Contains(s, ".") {
+		return passlibEncoding.DecodeString(s)
+	}
+	return phcEncoding.DecodeString(s)
+}
+
+// isDigits 判断字符串是否全部由数字组成
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,87 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestPasswordHasher_HashAndVerify(t *testing.T) {
+	hasher := NewPasswordHasher()
+
+	tests := []struct {
+		name   string
+		opts   PasswordHashOptions
+		prefix string
+	}{
+		{name: "bcrypt", opts: PasswordHashOptions{Algorithm: "bcrypt", Cost: 4}, prefix: "$2b$04$"},
+		{name: "scrypt", opts: PasswordHashOptions{Algorithm: "scrypt", N: 1024}, prefix: "$scrypt$ln=10,r=8,p=1$"},
+		{name: "argon2id", opts: PasswordHashOptions{Algorithm: "argon2id", Memory: 64, Iterations: 1, Parallelism: 1}, prefix: "$argon2id$v=19$m=64,t=1,p=1$"},
+		{name: "pbkdf2", opts: PasswordHashOptions{Algorithm: "pbkdf2", Iterations: 1000, Digest: "sha512"}, prefix: "$pbkdf2-sha512$i=1000,l=32$"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			encoded, err := hasher.Hash("s3cret", tt.opts)
+			if err != nil {
+				t.Fatalf("Hash() error = %v", err)
+			}
+			if !strings.HasPrefix(encoded, tt.prefix) {
+				t.Errorf("Hash() = %s, want prefix %s", encoded, tt.prefix)
+			}
+
+			ok, err := hasher.Verify("s3cret", encoded)
+			if err != nil || !ok {
+				t.Errorf("Verify(correct) = %v, %v", ok, err)
+			}
+			ok, err = hasher.Verify("wrong", encoded)
+			if err != nil || ok {
+				t.Errorf("Verify(wrong) = %v, %v", ok, err)
+			}
+		})
+	}
+}
+
+func TestPasswordHasher_Inspect(t *testing.T) {
+	hasher := NewPasswordHasher()
+
+	// RFC 9106 风格的 Argon2id PHC 字符串
+	info, err := hasher.Inspect("$argon2id$v=19$m=65536,t=3,p=4$c29tZXNhbHQ$RdescudvJCsgt3ub+b+dWRWJTmaaJObG")
+	if err != nil {
+		t.Fatalf("Inspect() error = %v", err)
+	}
+	if info.Algorithm != "argon2id" || info.Version != "19" || info.Params["m"] != "65536" || info.SaltLength != 8 {
+		t.Errorf("Inspect() = %+v", info)
+	}
+
+	info, err = hasher.Inspect("$2y$12$R9h/cIPz0gi.URNNX3kh2OPST9/PgBkqquzi.Ss7KIUgO2t0jWMUW")
+	if err != nil {
+		t.Fatalf("Inspect(bcrypt) error = %v", err)
+	}
+	if info.Algorithm != "bcrypt" || info.Variant != "2y" || info.Params["cost"] != "12" {
+		t.Errorf("Inspect(bcrypt) = %+v", info)
+	}
+
+	if _, err := hasher.Inspect("plain-text"); err == nil {
+		t.Errorf("Inspect() should reject unknown formats")
+	}
+}
+
+func TestPasswordHasher_RejectsInvalidParams(t *testing.T) {
+	hasher := NewPasswordHasher()
+
+	if _, err := hasher.Hash("pw", PasswordHashOptions{Algorithm: "scrypt", N: 1000}); err == nil {
+		t.Errorf("Hash() should reject non power-of-two scrypt N")
+	}
+	if _, err := hasher.Hash("pw", PasswordHashOptions{Algorithm: "md5"}); err == nil {
+		t.Errorf("Hash() should reject unsupported algorithms")
+	}
+
+	// 粘贴的 scrypt 散列中 ln 超出范围时应报错而不是 panic
+	for _, ln := range []string{"-1", "0", "21", "64", "9999"} {
+		encoded := "$scrypt$ln=" + ln + ",r=8,p=1$c2FsdHNhbHQ$aGFzaGhhc2g"
+		if _, err := hasher.Verify("pw", encoded); !errors.Is(err, ErrInvalidPasswordHash) {
+			t.Errorf("Verify(ln=%s) error = %v, want ErrInvalidPasswordHash", ln, err)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package interfaces
 
 import (
 	"github.com/cyrnicolase/dev-tools/internal/hash/application"
+	"github.com/cyrnicolase/dev-tools/internal/hash/domain"
 	historydomain "github.com/cyrnicolase/dev-tools/internal/history/domain"
 )
 
@@ -27,6 +28,31 @@ func (a *API) HashFile(algorithm string, fileData []byte) (string, error) {
 	return a.service.HashFile(algorithm, fileData)
 }
 
+// HashDirectory 计算目录散列清单
+func (a *API) HashDirectory(dirPath, format string, opts domain.DirectoryHashOptions) (*application.DirectoryHashResult, error) {
+	return a.service.HashDirectory(dirPath, format, opts)
+}
+
+// VerifyManifest 使用清单校验目录
+func (a *API) VerifyManifest(dirPath, content, algorithm string, opts domain.DirectoryHashOptions) (*domain.VerifyReport, error) {
+	return a.service.VerifyManifest(dirPath, content, algorithm, opts)
+}
+
+// HashPassword 生成密码散列（bcrypt、scrypt、Argon2id、PBKDF2）
+func (a *API) HashPassword(password string, opts domain.PasswordHashOptions) (string, error) {
+	return a.service.HashPassword(password, opts)
+}
+
+// VerifyPassword 校验明文密码与已有散列是否匹配
+func (a *API) VerifyPassword(password, encoded string) (bool, error) {
+	return a.service.VerifyPassword(password, encoded)
+}
+
+// InspectPasswordHash 解析密码散列中的算法参数
+func (a *API) InspectPasswordHash(encoded string) (*domain.PasswordHashInfo, error) {
+	return a.service.InspectPasswordHash(encoded)
+}
+
 // ListHistory 获取历史记录
 func (a *API) ListHistory() ([]historydomain.ToolHistoryRecord, error) {
 	return a.service.ListHistory()
@@ -0,0 +1,111 @@
+package application
+
+import (
+	"os"
+
+	"github.com/cyrnicolase/dev-tools/internal/hex/domain"
+	historydomain "github.com/cyrnicolase/dev-tools/internal/history/domain"
+	"github.com/pkg/errors"
+)
+
+// Service 十六进制工具服务层
+type Service struct {
+	converter      *domain.Converter
+	dumper         *domain.Dumper
+	historyStore   *historydomain.ToolHistoryStore
+	historyInitErr error
+}
+
+const hexToolID = "hex"
+
+// NewService 创建新的 Service 实例
+func NewService() *Service {
+	historyStore, historyErr := historydomain.NewToolHistoryStore()
+	return &Service{
+		converter:      domain.NewConverter(),
+		dumper:         domain.NewDumper(),
+		historyStore:   historyStore,
+		historyInitErr: historyErr,
+	}
+}
+
+// TextToHex 将文本（UTF-8 字节）转换为指定格式的十六进制字符串
+func (s *Service) TextToHex(text, style string, uppercase bool) (string, error) {
+	return s.converter.Format([]byte(text), style, uppercase)
+}
+
+// BytesToHex 将字节序列转换为指定格式的十六进制字符串
+func (s *Service) BytesToHex(data []byte, style string, uppercase bool) (string, error) {
+	return s.converter.Format(data, style, uppercase)
+}
+
+// HexToText 将十六进制字符串转换为文本
+func (s *Service) HexToText(input string) (*domain.TextResult, error) {
+	return s.converter.ToText(input)
+}
+
+// Reformat 将任意格式的十六进制字符串转换为指定格式
+func (s *Service) Reformat(input, style string, uppercase bool) (string, error) {
+	data, err := s.converter.Parse(input)
+	if err != nil {
+		return "", err
+	}
+	return s.converter.Format(data, style, uppercase)
+}
+
+// ParseBytes 将十六进制字符串解析为字节序列
+func (s *Service) ParseBytes(input string) ([]byte, error) {
+	return s.converter.Parse(input)
+}
+
+// DumpBytes 将字节序列渲染为 xxd 风格转储
+func (s *Service) DumpBytes(data []byte, opts domain.DumpOptions) string {
+	return s.dumper.Dump(data, 0, opts)
+}
+
+// DumpFilePage 分页读取文件并渲染为 xxd 风格转储，每次只读取一页内容
+func (s *Service) DumpFilePage(filePath string, offset int64, pageSize int, opts domain.DumpOptions) (*domain.DumpPage, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to open file %s", filePath)
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to stat file %s", filePath)
+	}
+	return s.dumper.DumpPage(file, info.Size(), offset, pageSize, opts)
+}
+
+// ListHistory 获取历史记录
+func (s *Service) ListHistory() ([]historydomain.ToolHistoryRecord, error) {
+	if s.historyInitErr != nil || s.historyStore == nil {
+		return nil, s.historyUnavailableError()
+	}
+	return s.historyStore.List(hexToolID)
+}
+
+// AddHistory 添加历史记录
+func (s *Service) AddHistory(record historydomain.ToolHistoryRecord) ([]historydomain.ToolHistoryRecord, error) {
+	if s.historyInitErr != nil || s.historyStore == nil {
+		return nil, s.historyUnavailableError()
+	}
+	record.ToolID = hexToolID
+	return s.historyStore.Add(record)
+}
+
+// ClearHistory 清空历史记录
+func (s *Service) ClearHistory() error {
+	if s.historyInitErr != nil || s.historyStore == nil {
+		return s.historyUnavailableError()
+	}
+	return s.historyStore.Clear(hexToolID)
+}
+
+func (s *Service) historyUnavailableError() error {
+	if s.historyInitErr != nil {
+		return s.historyInitErr
+	}
+	return errors.WithStack(historydomain.ErrHistoryStoreUnavailable)
+}
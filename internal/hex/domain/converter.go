@@ -0,0 +1,173 @@
+package domain
+
+import (
+	"encoding/hex"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+)
+
+// 十六进制输出格式
+const (
+	StylePlain   = "plain"   // deadbeef
+	StyleSpaced  = "spaced"  // de ad be ef
+	StyleColon   = "colon"   // de:ad:be:ef
+	StylePrefix  = "prefix"  // 0xde, 0xad, 0xbe, 0xef
+	StyleCArray  = "c-array" // unsigned char data[] = { 0xde, ... };
+	StyleEscaped = "escaped" // \xde\xad\xbe\xef
+)
+
+const (
+	// cArrayBytesPerLine C 数组格式每行字节数
+	cArrayBytesPerLine = 12
+)
+
+// prefixedBytePattern 匹配 "0x" 或 "\x" 前缀的单个字节
+var prefixedBytePattern = regexp.MustCompile(`(?i)(?:0x|\\x)([0-9a-f]{1,2})`)
+
+// cArrayBodyPattern 提取 C 数组花括号内的内容
+var cArrayBodyPattern = regexp.MustCompile(`(?s)\{(.*)\}`)
+
+// TextResult 十六进制转文本结果
+type TextResult struct {
+	Text      string `json:"text"`
+	ByteCount int    `json:"byteCount"`
+	IsUTF8    bool   `json:"isUtf8"`
+}
+
+// Converter 提供文本、字节与十六进制字符串之间的转换功能
+type Converter struct{}
+
+// NewConverter 创建新的 Converter 实例
+func NewConverter() *Converter {
+	return &Converter{}
+}
+
+// Format 将字节序列格式化为指定风格的十六进制字符串
+func (c *Converter) Format(data []byte, style string, uppercase bool) (string, error) {
+	digits := hex.EncodeToString(data)
+	if uppercase {
+		digits = strings.ToUpper(digits)
+	}
+	pairs := make([]string, len(data))
+	for i := range data {
+		pairs[i] = digits[i*2 : i*2+2]
+	}
+
+	switch style {
+	case StylePlain, "":
+		return digits, nil
+	case StyleSpaced:
+		return strings.Join(pairs, " "), nil
+	case StyleColon:
+		return strings.Join(pairs, ":"), nil
+	case StylePrefix:
+		if len(pairs) == 0 {
+			return "", nil
+		}
+		return "0x" + strings.Join(pairs, ", 0x"), nil
+	case StyleEscaped:
+		if len(pairs) == 0 {
+			return "", nil
+		}
+		return `\x` + strings.Join(pairs, `\x`), nil
+	case StyleCArray:
+		var sb strings.Builder
+		sb.WriteString("unsigned char data[] = {")
+		for i, pair := range pairs {
+			if i%cArrayBytesPerLine == 0 {
+				sb.WriteString("\n  ")
+			} else {
+				sb.WriteString(" ")
+			}
+			sb.WriteString("0x")
+			sb.WriteString(pair)
+			if i < len(pairs)-1 {
+				sb.WriteString(",")
+			}
+		}
+		sb.WriteString("\n};")
+		return sb.String(), nil
+	default:
+		return "", errors.Wrapf(ErrUnsupportedStyle, "不支持的输出格式: %s", style)
+	}
+}
+
+// Parse 解析十六进制字符串为字节序列
+// 支持连续十六进制、空格/冒号/短横线/逗号分隔、"0x" 与 "\x" 前缀以及 C 数组
+func (c *Converter) Parse(input string) ([]byte, error) {
+	input = strings.TrimSpace(input)
+	if input == "" {
+		return nil, errors.WithStack(ErrEmptyInput)
+	}
+
+	if m := cArrayBodyPattern.FindStringSubmatch(input); m != nil {
+		input = m[1]
+	}
+
+	// 整体带 "0x" 前缀的连续十六进制（如 0xdeadbeef）按普通十六进制处理
+	if strings.HasPrefix(strings.ToLower(input), "0x") && !strings.ContainsAny(input[2:], " ,:\n\t") &&
+		!strings.Contains(strings.ToLower(input[2:]), "0x") {
+		input = input[2:]
+	} else if prefixedBytePattern.MatchString(input) {
+		return c.parsePrefixed(input)
+	}
+
+	cleaned := strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) || r == ':' || r == '-' || r == ',' {
+			return -1
+		}
+		return r
+	}, input)
+	if len(cleaned)%2 != 0 {
+		return nil, errors.Wrapf(ErrOddLength, "共 %d 个十六进制字符", len(cleaned))
+	}
+	data, err := hex.DecodeString(cleaned)
+	if err != nil {
+		return nil, errors.Wrapf(ErrInvalidHex, "%v", err)
+	}
+	return data, nil
+}
+
+// parsePrefixed 解析由 "0x"/"\x" 前缀字节组成的输入，要求除分隔符外不含其他内容
+func (c *Converter) parsePrefixed(input string) ([]byte, error) {
+	matches := prefixedBytePattern.FindAllStringSubmatchIndex(input, -1)
+	data := make([]byte, 0, len(matches))
+	last := 0
+	for _, m := range matches {
+		if rest := strings.Trim(input[last:m[0]], " \t\r\n,;"); rest != "" {
+			return nil, errors.Wrapf(ErrInvalidHex, "无法识别的内容: %q", rest)
+		}
+		value, _ := hex.DecodeString(leftPad(input[m[2]:m[3]]))
+		data = append(data, value[0])
+		last = m[1]
+	}
+	if rest := strings.Trim(input[last:], " \t\r\n,;"); rest != "" {
+		return nil, errors.Wrapf(ErrInvalidHex, "无法识别的内容: %q", rest)
+	}
+	return data, nil
+}
+
+// leftPad 将单个十六进制字符补齐为两位
+func leftPad(s string) string {
+	if len(s) == 1 {
+		return "0" + s
+	}
+	return s
+}
+
+// ToText 将十六进制字符串解析为文本
+func (c *Converter) ToText(input string) (*TextResult, error) {
+	data, err := c.Parse(input)
+	if err != nil {
+		return nil, err
+	}
+	return &TextResult{
+		Text:      string(data),
+		ByteCount: len(data),
+		IsUTF8:    utf8.Valid(data),
+	}, nil
+}
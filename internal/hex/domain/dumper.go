@@ -0,0 +1,131 @@
+package domain
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// DefaultBytesPerLine xxd 默认每行字节数
+	DefaultBytesPerLine = 16
+	// DefaultGroupSize xxd 默认分组字节数
+	DefaultGroupSize = 2
+	// DefaultPageSize 分页浏览默认每页字节数
+	DefaultPageSize = 4 * 1024
+	// MaxPageSize 每页最大字节数，避免一次性向前端传输过多内容
+	MaxPageSize = 64 * 1024
+	// maxBytesPerLine 每行最大字节数
+	maxBytesPerLine = 64
+)
+
+// DumpOptions xxd 风格转储选项
+type DumpOptions struct {
+	BytesPerLine int  `json:"bytesPerLine"`
+	GroupSize    int  `json:"groupSize"`
+	Uppercase    bool `json:"uppercase"`
+}
+
+// DumpPage 分页转储结果
+type DumpPage struct {
+	Offset    int64  `json:"offset"`
+	Length    int    `json:"length"`
+	TotalSize int64  `json:"totalSize"`
+	Dump      string `json:"dump"`
+	HasMore   bool   `json:"hasMore"`
+}
+
+// Dumper 提供 xxd 风格的十六进制转储功能
+type Dumper struct{}
+
+// NewDumper 创建新的 Dumper 实例
+func NewDumper() *Dumper {
+	return &Dumper{}
+}
+
+// normalize 填充默认选项并校验取值范围
+func (o DumpOptions) normalize() DumpOptions {
+	if o.BytesPerLine <= 0 || o.BytesPerLine > maxBytesPerLine {
+		o.BytesPerLine = DefaultBytesPerLine
+	}
+	if o.GroupSize <= 0 || o.GroupSize > o.BytesPerLine {
+		o.GroupSize = DefaultGroupSize
+	}
+	return o
+}
+
+// Dump 将数据渲染为 xxd 风格文本，baseOffset 为首字节在原始数据中的偏移量
+// 每行格式：偏移量、按组分隔的十六进制、ASCII 列（不可打印字符显示为 "."）
+func (d *Dumper) Dump(data []byte, baseOffset int64, opts DumpOptions) string {
+	opts = opts.normalize()
+	hexDigits := "0123456789abcdef"
+	offsetFormat := "%08x: "
+	if opts.Uppercase {
+		hexDigits = "0123456789ABCDEF"
+		offsetFormat = "%08X: "
+	}
+
+	// 满行十六进制列宽度，用于对齐最后一行的 ASCII 列
+	groups := (opts.BytesPerLine + opts.GroupSize - 1) / opts.GroupSize
+	hexWidth := opts.BytesPerLine*2 + groups - 1
+
+	var sb strings.Builder
+	for start := 0; start < len(data); start += opts.BytesPerLine {
+		end := min(start+opts.BytesPerLine, len(data))
+		line := data[start:end]
+
+		fmt.Fprintf(&sb, offsetFormat, baseOffset+int64(start))
+		written := 0
+		for i, b := range line {
+			if i > 0 && i%opts.GroupSize == 0 {
+				sb.WriteByte(' ')
+				written++
+			}
+			sb.WriteByte(hexDigits[b>>4])
+			sb.WriteByte(hexDigits[b&0x0f])
+			written += 2
+		}
+		sb.WriteString(strings.Repeat(" ", hexWidth-written+2))
+		for _, b := range line {
+			if b >= 0x20 && b < 0x7f {
+				sb.WriteByte(b)
+			} else {
+				sb.WriteByte('.')
+			}
+		}
+		sb.WriteByte('\n')
+	}
+	return sb.String()
+}
+
+// DumpPage 从 ReaderAt 中读取一页数据并渲染，只读取当前页，适合浏览大文件
+// totalSize 为数据总字节数，pageSize 不合法时使用 DefaultPageSize
+func (d *Dumper) DumpPage(r io.ReaderAt, totalSize, offset int64, pageSize int, opts DumpOptions) (*DumpPage, error) {
+	if offset < 0 || (offset > 0 && offset >= totalSize) {
+		return nil, errors.Wrapf(ErrInvalidOffset, "偏移量 %d 超出范围 [0, %d)", offset, totalSize)
+	}
+	if pageSize <= 0 {
+		pageSize = DefaultPageSize
+	}
+	if pageSize > MaxPageSize {
+		pageSize = MaxPageSize
+	}
+
+	length := int(min(int64(pageSize), totalSize-offset))
+	buf := make([]byte, length)
+	n, err := r.ReadAt(buf, offset)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, errors.Wrapf(err, "failed to read at offset %d", offset)
+	}
+	buf = buf[:n]
+
+	return &DumpPage{
+		Offset:    offset,
+		Length:    n,
+		TotalSize: totalSize,
+		Dump:      d.Dump(buf, offset, opts),
+		HasMore:   offset+int64(n) < totalSize,
+	}, nil
+}
@@ -0,0 +1,25 @@
+package domain
+
+// HexError 十六进制工具错误类型
+type HexError struct {
+	Errmsg string
+}
+
+// Error 实现 error 接口
+func (e HexError) Error() string {
+	return e.Errmsg
+}
+
+// 预定义的错误
+var (
+	// ErrEmptyInput 输入为空
+	ErrEmptyInput = HexError{Errmsg: "输入不能为空"}
+	// ErrInvalidHex 无效的十六进制字符串
+	ErrInvalidHex = HexError{Errmsg: "无效的十六进制字符串"}
+	// ErrOddLength 十六进制字符数为奇数
+	ErrOddLength = HexError{Errmsg: "十六进制字符数必须为偶数"}
+	// ErrUnsupportedStyle 不支持的输出格式
+	ErrUnsupportedStyle = HexError{Errmsg: "不支持的输出格式"}
+	// ErrInvalidOffset 无效的偏移量
+	ErrInvalidOffset = HexError{Errmsg: "无效的偏移量"}
+)
@@ -0,0 +1,115 @@
+package domain
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestConverter_Parse(t *testing.T) {
+	converter := NewConverter()
+	want := []byte{0xde, 0xad, 0xbe, 0xef}
+
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{name: "plain", input: "deadbeef"},
+		{name: "uppercase", input: "DEADBEEF"},
+		{name: "spaced", input: "de ad be ef"},
+		{name: "colon", input: "de:ad:be:ef"},
+		{name: "dash", input: "de-ad-be-ef"},
+		{name: "whole prefix", input: "0xdeadbeef"},
+		{name: "prefixed bytes", input: "0xde, 0xad, 0xbe, 0xef"},
+		{name: "escaped", input: `\xde\xad\xbe\xef`},
+		{name: "c array", input: "unsigned char data[] = {\n  0xde, 0xad,\n  0xbe, 0xef\n};"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := converter.Parse(tt.input)
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if !bytes.Equal(got, want) {
+				t.Errorf("Parse() = %x, want %x", got, want)
+			}
+		})
+	}
+
+	for _, invalid := range []string{"", "abc", "zz", "0xde 0xad junk"} {
+		if _, err := converter.Parse(invalid); err == nil {
+			t.Errorf("Parse(%q) should fail", invalid)
+		}
+	}
+}
+
+func TestConverter_FormatRoundTrip(t *testing.T) {
+	converter := NewConverter()
+	data := []byte("Hello, hex!")
+
+	for _, style := range []string{StylePlain, StyleSpaced, StyleColon, StylePrefix, StyleCArray, StyleEscaped} {
+		t.Run(style, func(t *testing.T) {
+			formatted, err := converter.Format(data, style, true)
+			if err != nil {
+				t.Fatalf("Format() error = %v", err)
+			}
+			got, err := converter.Parse(formatted)
+			if err != nil {
+				t.Fatalf("Parse(%q) error = %v", formatted, err)
+			}
+			if !bytes.Equal(got, data) {
+				t.Errorf("round trip = %q, want %q", got, data)
+			}
+		})
+	}
+}
+
+func TestConverter_FormatEmpty(t *testing.T) {
+	converter := NewConverter()
+
+	for _, style := range []string{StylePlain, StyleSpaced, StyleColon, StylePrefix, StyleEscaped} {
+		formatted, err := converter.Format(nil, style, false)
+		if err != nil || formatted != "" {
+			t.Errorf("Format(nil, %s) = %q, %v, want empty", style, formatted, err)
+		}
+	}
+}
+
+func TestDumper_Dump(t *testing.T) {
+	dumper := NewDumper()
+
+	// 与 `printf 'Hello, world!\n\x00\x01' | xxd` 输出一致
+	got := dumper.Dump([]byte("Hello, world!\n\x00\x01"), 0, DumpOptions{})
+	want := "00000000: 4865 6c6c 6f2c 2077 6f72 6c64 210a 0001  Hello, world!...\n"
+	if got != want {
+		t.Errorf("Dump() =\n%q\nwant\n%q", got, want)
+	}
+
+	got = dumper.Dump([]byte("abc"), 0x10, DumpOptions{})
+	want = "00000010: 6162 63                                  abc\n"
+	if got != want {
+		t.Errorf("Dump(partial) =\n%q\nwant\n%q", got, want)
+	}
+}
+
+func TestDumper_DumpPage(t *testing.T) {
+	dumper := NewDumper()
+	data := bytes.Repeat([]byte{0xaa}, 100)
+	reader := bytes.NewReader(data)
+
+	page, err := dumper.DumpPage(reader, int64(len(data)), 64, 64, DumpOptions{})
+	if err != nil {
+		t.Fatalf("DumpPage() error = %v", err)
+	}
+	if page.Length != 36 || page.HasMore {
+		t.Errorf("DumpPage() length = %d, hasMore = %v", page.Length, page.HasMore)
+	}
+	if !strings.HasPrefix(page.Dump, "00000040: ") {
+		t.Errorf("DumpPage() should start at offset 0x40, got %q", page.Dump)
+	}
+
+	if _, err := dumper.DumpPage(reader, int64(len(data)), 100, 64, DumpOptions{}); err == nil {
+		t.Errorf("DumpPage() should reject offsets past the end")
+	}
+}
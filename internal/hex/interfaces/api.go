@@ -0,0 +1,73 @@
+package interfaces
+
+import (
+	"github.com/cyrnicolase/dev-tools/internal/hex/application"
+	"github.com/cyrnicolase/dev-tools/internal/hex/domain"
+	historydomain "github.com/cyrnicolase/dev-tools/internal/history/domain"
+)
+
+// API 十六进制工具 API 接口
+type API struct {
+	service *application.Service
+}
+
+// NewAPI 创建新的 API 实例
+func NewAPI() *API {
+	return &API{
+		service: application.NewService(),
+	}
+}
+
+// TextToHex 将文本转换为十六进制字符串
+func (a *API) TextToHex(text, style string, uppercase bool) (string, error) {
+	return a.service.TextToHex(text, style, uppercase)
+}
+
+// BytesToHex 将字节序列转换为十六进制字符串
+func (a *API) BytesToHex(data []byte, style string, uppercase bool) (string, error) {
+	return a.service.BytesToHex(data, style, uppercase)
+}
+
+// HexToText 将十六进制字符串转换为文本
+func (a *API) HexToText(input string) (*domain.TextResult, error) {
+	return a.service.HexToText(input)
+}
+
+// Reformat 转换十六进制字符串格式
+func (a *API) Reformat(input, style string, uppercase bool) (string, error) {
+	return a.service.Reformat(input, style, uppercase)
+}
+
+// DumpText 将文本渲染为 xxd 风格转储
+func (a *API) DumpText(text string, opts domain.DumpOptions) string {
+	return a.service.DumpBytes([]byte(text), opts)
+}
+
+// DumpHex 将十六进制字符串渲染为 xxd 风格转储
+func (a *API) DumpHex(input string, opts domain.DumpOptions) (string, error) {
+	data, err := a.service.ParseBytes(input)
+	if err != nil {
+		return "", err
+	}
+	return a.service.DumpBytes(data, opts), nil
+}
+
+// DumpFilePage 分页渲染文件的 xxd 风格转储
+func (a *API) DumpFilePage(filePath string, offset int64, pageSize int, opts domain.DumpOptions) (*domain.DumpPage, error) {
+	return a.service.DumpFilePage(filePath, offset, pageSize, opts)
+}
+
+// ListHistory 获取历史记录
+func (a *API) ListHistory() ([]historydomain.ToolHistoryRecord, error) {
+	return a.service.ListHistory()
+}
+
+// AddHistory 添加历史记录
+func (a *API) AddHistory(record historydomain.ToolHistoryRecord) ([]historydomain.ToolHistoryRecord, error) {
+	return a.service.AddHistory(record)
+}
+
+// ClearHistory 清空历史记录
+func (a *API) ClearHistory() error {
+	return a.service.ClearHistory()
+}
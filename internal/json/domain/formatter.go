@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"sort"
 	"strconv"
+	"strings"
 
 	"github.com/pkg/errors"
 )
@@ -240,6 +241,18 @@ func (f *Formatter) escapeKey(s string) string {
 	return buf.String()
 }
 
+// FormatValue 将任意值格式化为美化的 JSON，key 排序且不转义 HTML 字符（如 URL 中的 "&"）
+func (f *Formatter) FormatValue(value interface{}) (string, error) {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", indentStep)
+	if err := encoder.Encode(f.sortMapKeys(value)); err != nil {
+		return "", errors.WithStack(err)
+	}
+	return strings.TrimSuffix(buf.String(), "\n"), nil
+}
+
 // Minify 压缩 JSON 字符串
 func (f *Formatter) Minify(input string) (string, error) {
 	var jsonObj interface{}
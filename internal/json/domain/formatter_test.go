@@ -49,3 +49,20 @@ func TestFormatter_Minify(t *testing.T) {
 		t.Errorf("Minify() result should be shorter than input")
 	}
 }
+
+func TestFormatter_FormatValue(t *testing.T) {
+	formatter := NewFormatter()
+
+	result, err := formatter.FormatValue(map[string]interface{}{
+		"url":  "https://example.com/?a=1&b=2",
+		"list": []interface{}{"x"},
+	})
+	if err != nil {
+		t.Fatalf("FormatValue() error = %v", err)
+	}
+
+	want := "{\n  \"list\": [\n    \"x\"\n  ],\n  \"url\": \"https://example.com/?a=1&b=2\"\n}"
+	if result != want {
+		t.Errorf("FormatValue() = %q, want %q", result, want)
+	}
+}
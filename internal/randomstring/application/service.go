@@ -35,6 +35,16 @@ func (s *Service) GenerateBatch(length int, includeNumbers, includeLowercase, in
 	return s.generator.GenerateBatch(length, includeNumbers, includeLowercase, includeUppercase, includeSpecial, count)
 }
 
+// GeneratePassword 按策略生成密码并估算熵
+func (s *Service) GeneratePassword(policy domain.PasswordPolicy) (*domain.PasswordResult, error) {
+	return s.generator.GeneratePassword(policy)
+}
+
+// GeneratePassphrase 生成 diceware 口令并估算熵
+func (s *Service) GeneratePassphrase(opts domain.PassphraseOptions) (*domain.PasswordResult, error) {
+	return s.generator.GeneratePassphrase(opts)
+}
+
 // ListHistory 获取历史记录
 func (s *Service) ListHistory() ([]historydomain.ToolHistoryRecord, error) {
 	if s.historyInitErr != nil || s.historyStore == nil {
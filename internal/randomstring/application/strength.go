@@ -0,0 +1,22 @@
+package application
+
+import (
+	"github.com/cyrnicolase/dev-tools/internal/randomstring/domain"
+)
+
+// StrengthService 密码强度分析应用服务，全部在本地完成
+type StrengthService struct {
+	analyzer *domain.StrengthAnalyzer
+}
+
+// NewStrengthService 创建新的 StrengthService 实例
+func NewStrengthService() *StrengthService {
+	return &StrengthService{
+		analyzer: domain.NewStrengthAnalyzer(),
+	}
+}
+
+// Analyze 分析密码强度，userInputs 为用户名、邮箱等需要避免出现在密码中的个人信息
+func (s *StrengthService) Analyze(password string, userInputs []string) (*domain.StrengthResult, error) {
+	return s.analyzer.Analyze(password, userInputs)
+}
@@ -16,5 +16,13 @@ var (
 	ErrInvalidLength = RandomStringError{Errmsg: "字符串长度必须在1-100之间"}
 	// ErrNoCharTypeSelected 未选择字符类型
 	ErrNoCharTypeSelected = RandomStringError{Errmsg: "至少需要选择一个字符类型"}
+	// ErrInvalidPolicy 密码策略无效
+	ErrInvalidPolicy = RandomStringError{Errmsg: "密码策略无效"}
+	// ErrInvalidPassphrase 口令参数无效
+	ErrInvalidPassphrase = RandomStringError{Errmsg: "口令参数无效"}
+	// ErrEmptyPassword 待分析的密码为空
+	ErrEmptyPassword = RandomStringError{Errmsg: "密码不能为空"}
+	// ErrPasswordTooLong 待分析的密码过长
+	ErrPasswordTooLong = RandomStringError{Errmsg: "密码过长"}
 )
 
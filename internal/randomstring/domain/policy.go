@@ -0,0 +1,285 @@
+package domain
+
+import (
+	"crypto/rand"
+	"math"
+	"math/big"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// MaxPasswordLength 策略模式下的最大密码长度
+	MaxPasswordLength = 1024
+	// MaxPasswordCount 单次最多生成的数量
+	MaxPasswordCount = 100
+	// AmbiguousChars 容易看错的字符
+	AmbiguousChars = "0O1lI"
+)
+
+// PasswordPolicy 密码生成策略
+type PasswordPolicy struct {
+	Length    int  `json:"length"`
+	Numbers   bool `json:"numbers"`
+	Lowercase bool `json:"lowercase"`
+	Uppercase bool `json:"uppercase"`
+	Special   bool `json:"special"`
+	// MinNumbers 等字段为对应字符类型至少出现的次数，仅对选中的类型生效
+	MinNumbers   int `json:"minNumbers"`
+	MinLowercase int `json:"minLowercase"`
+	MinUppercase int `json:"minUppercase"`
+	MinSpecial   int `json:"minSpecial"`
+	// ExcludeAmbiguous 排除容易看错的字符（0O1lI）
+	ExcludeAmbiguous bool `json:"excludeAmbiguous"`
+	// CustomAlphabet 自定义字符集，非空时作为额外的字符类型参与生成，支持 Unicode
+	CustomAlphabet string `json:"customAlphabet"`
+	MinCustom      int    `json:"minCustom"`
+	Count          int    `json:"count"`
+}
+
+// PasswordResult 密码或口令生成结果
+type PasswordResult struct {
+	Passwords []string `json:"passwords"`
+	// PoolSize 可选字符总数（口令为词表大小）
+	PoolSize int `json:"poolSize"`
+	// Entropy 每个结果的估算熵（位）
+	Entropy float64 `json:"entropy"`
+}
+
+// charClass 字符类型及其最少出现次数
+type charClass struct {
+	name  string
+	chars []rune
+	min   int
+}
+
+// GeneratePassword 按策略生成密码：先从每个类型中取出最少数量的字符，其余从全部字符中随机选取，最后整体打乱
+func (g *Generator) GeneratePassword(policy PasswordPolicy) (*PasswordResult, error) {
+	if policy.Length < 1 || policy.Length > MaxPasswordLength {
+		return nil, errors.Wrapf(ErrInvalidPolicy, "长度必须在 1-%d 之间", MaxPasswordLength)
+	}
+	classes, err := g.policyClasses(policy)
+	if err != nil {
+		return nil, err
+	}
+
+	// 公共字符池中重复的字符只计一次，避免被多个类型同时包含的字符概率偏高
+	var pool []rune
+	inPool := make(map[rune]bool)
+	required := 0
+	for _, class := range classes {
+		for _, r := range class.chars {
+			if !inPool[r] {
+				inPool[r] = true
+				pool = append(pool, r)
+			}
+		}
+		required += class.min
+	}
+	if required > policy.Length {
+		return nil, errors.Wrapf(ErrInvalidPolicy, "最少字符数之和 %d 超过长度 %d", required, policy.Length)
+	}
+
+	count := min(max(policy.Count, 1), MaxPasswordCount)
+	passwords := make([]string, 0, count)
+	for i := 0; i < count; i++ {
+		password, err := generateWithClasses(policy.Length, classes, pool)
+		if err != nil {
+			return nil, err
+		}
+		passwords = append(passwords, password)
+	}
+
+	return &PasswordResult{
+		Passwords: passwords,
+		PoolSize:  len(pool),
+		Entropy:   policyEntropy(policy.Length, classes),
+	}, nil
+}
+
+// policyClasses 根据策略构建字符类型，每个类型保留完整的字符集用于满足最少次数
+func (g *Generator) policyClasses(policy PasswordPolicy) ([]charClass, error) {
+	candidates := []struct {
+		name     string
+		selected bool
+		chars    string
+		min      int
+	}{
+		{"numbers", policy.Numbers, g.numbers, policy.MinNumbers},
+		{"lowercase", policy.Lowercase, g.lowercase, policy.MinLowercase},
+		{"uppercase", policy.Uppercase, g.uppercase, policy.MinUppercase},
+		{"special", policy.Special, g.special, policy.MinSpecial},
+		{"custom", policy.CustomAlphabet != "", policy.CustomAlphabet, policy.MinCustom},
+	}
+
+	var classes []charClass
+	for _, c := range candidates {
+		if c.min < 0 {
+			return nil, errors.Wrapf(ErrInvalidPolicy, "%s 的最少字符数不能为负数", c.name)
+		}
+		if !c.selected {
+			continue
+		}
+		// 自定义字符集与已选类型重复的字符仍属于自定义类型
+		var chars []rune
+		inClass := make(map[rune]bool)
+		for _, r := range c.chars {
+			if inClass[r] || (policy.ExcludeAmbiguous && strings.ContainsRune(AmbiguousChars, r)) {
+				continue
+			}
+			inClass[r] = true
+			chars = append(chars, r)
+		}
+		if len(chars) == 0 {
+			if c.min > 0 {
+				return nil, errors.Wrapf(ErrInvalidPolicy, "%s 没有可用字符", c.name)
+			}
+			continue
+		}
+		classes = append(classes, charClass{name: c.name, chars: chars, min: c.min})
+	}
+	if len(classes) == 0 {
+		return nil, ErrNoCharTypeSelected
+	}
+	return classes, nil
+}
+
+// generateWithClasses 生成满足各类型最少次数的单个密码
+func generateWithClasses(length int, classes []charClass, pool []rune) (string, error) {
+	result := make([]rune, 0, length)
+	for _, class := range classes {
+		for i := 0; i < class.min; i++ {
+			r, err := randomRune(class.chars)
+			if err != nil {
+				return "", err
+			}
+			result = append(result, r)
+		}
+	}
+	for len(result) < length {
+		r, err := randomRune(pool)
+		if err != nil {
+			return "", err
+		}
+		result = append(result, r)
+	}
+
+	// Fisher-Yates 洗牌，避免必选字符总出现在开头
+	for i := len(result) - 1; i > 0; i-- {
+		j, err := randomIndex(i + 1)
+		if err != nil {
+			return "", err
+		}
+		result[i], result[j] = result[j], result[i]
+	}
+	return string(result), nil
+}
+
+// policyEntropy 估算熵：log2(满足各类型最少次数的字符串总数)
+// 没有最少次数约束时等于 length × log2(字符池大小)。类型之间有重叠时，先把每个字符划归到唯一的类型，
+// 只统计"各类型自有字符满足最少次数"的字符串，它们必然满足原约束，因此结果是不会高估的下界
+func policyEntropy(length int, classes []charClass) float64 {
+	sizes := ownedSizes(classes)
+
+	// 总数 = length! × [x^length] Π Σ_{c≥min} size^c·x^c / c!，在对数空间中逐类卷积
+	dp := make([]float64, length+1)
+	for j := range dp {
+		dp[j] = math.Inf(-1)
+	}
+	dp[0] = 0
+	for k, class := range classes {
+		if sizes[k] == 0 {
+			if class.min > 0 {
+				return 0
+			}
+			continue
+		}
+		logSize := math.Log(float64(sizes[k]))
+		terms := make([]float64, length+1)
+		next := make([]float64, length+1)
+		for c := range terms {
+			lgamma, _ := math.Lgamma(float64(c + 1))
+			terms[c] = float64(c)*logSize - lgamma
+			next[c] = math.Inf(-1)
+		}
+		for j, prev := range dp {
+			if math.IsInf(prev, -1) {
+				continue
+			}
+			for c := class.min; j+c <= length; c++ {
+				next[j+c] = logAddExp(next[j+c], prev+terms[c])
+			}
+		}
+		dp = next
+	}
+	lgamma, _ := math.Lgamma(float64(length + 1))
+	return round2((dp[length] + lgamma) / math.Ln2)
+}
+
+// ownedSizes 将每个字符划归到唯一的类型，返回各类型拥有的字符数
+// 字符优先归属唯一有最少次数约束的类型（此时不损失精度），否则归属最先出现的类型；
+// 有约束却分不到字符的类型再从与其重叠的类型中均分字符，保证每个约束都被计入
+func ownedSizes(classes []charClass) []int {
+	owner := make(map[rune]int)
+	var order []rune
+	for k, class := range classes {
+		for _, r := range class.chars {
+			o, ok := owner[r]
+			switch {
+			case !ok:
+				owner[r] = k
+				order = append(order, r)
+			case classes[o].min == 0 && class.min > 0:
+				owner[r] = k
+			}
+		}
+	}
+
+	sizes := make([]int, len(classes))
+	for _, r := range order {
+		sizes[owner[r]]++
+	}
+	for k, class := range classes {
+		if class.min == 0 || sizes[k] > 0 {
+			continue
+		}
+		for _, r := range class.chars {
+			if o := owner[r]; o != k && sizes[o] > sizes[k]+1 {
+				owner[r] = k
+				sizes[o]--
+				sizes[k]++
+			}
+		}
+	}
+	return sizes
+}
+
+// logAddExp 计算 ln(e^a + e^b)
+func logAddExp(a, b float64) float64 {
+	if math.IsInf(a, -1) {
+		return b
+	}
+	if a < b {
+		a, b = b, a
+	}
+	return a + math.Log1p(math.Exp(b-a))
+}
+
+// randomRune 从字符集中随机选取一个字符
+func randomRune(chars []rune) (rune, error) {
+	idx, err := randomIndex(len(chars))
+	if err != nil {
+		return 0, err
+	}
+	return chars[idx], nil
+}
+
+// randomIndex 返回 [0, n) 内的安全随机数
+func randomIndex(n int) (int, error) {
+	idx, err := rand.Int(rand.Reader, big.NewInt(int64(n)))
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+	return int(idx.Int64()), nil
+}
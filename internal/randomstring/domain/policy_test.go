@@ -0,0 +1,138 @@
+package domain
+
+import (
+	"math"
+	"strings"
+	"testing"
+	"unicode"
+)
+
+func TestGeneratePassword(t *testing.T) {
+	generator := NewGenerator()
+	policy := PasswordPolicy{
+		Length: 12, Numbers: true, Lowercase: true, Uppercase: true, Special: true,
+		MinNumbers: 2, MinUppercase: 2, MinSpecial: 3, ExcludeAmbiguous: true, Count: 50,
+	}
+	result, err := generator.GeneratePassword(policy)
+	if err != nil {
+		t.Fatalf("GeneratePassword error = %v", err)
+	}
+	if len(result.Passwords) != 50 || result.PoolSize != 10+26+26+len(generator.special)-5 {
+		t.Fatalf("len = %d, PoolSize = %d", len(result.Passwords), result.PoolSize)
+	}
+	for _, password := range result.Passwords {
+		if len(password) != 12 || strings.ContainsAny(password, AmbiguousChars) {
+			t.Fatalf("password = %q", password)
+		}
+		var numbers, upper, special int
+		for _, r := range password {
+			switch {
+			case unicode.IsDigit(r):
+				numbers++
+			case unicode.IsUpper(r):
+				upper++
+			case strings.ContainsRune(generator.special, r):
+				special++
+			}
+		}
+		if numbers < 2 || upper < 2 || special < 3 {
+			t.Errorf("password %q does not satisfy policy", password)
+		}
+	}
+	// 最少次数约束会略微降低熵
+	if unconstrained := 12 * math.Log2(float64(result.PoolSize)); result.Entropy >= unconstrained || result.Entropy < unconstrained-3 {
+		t.Errorf("Entropy = %v, unconstrained = %v", result.Entropy, unconstrained)
+	}
+}
+
+func TestGeneratePasswordCustomAlphabet(t *testing.T) {
+	generator := NewGenerator()
+	result, err := generator.GeneratePassword(PasswordPolicy{Length: 300, CustomAlphabet: "αβγαβ", MinCustom: 1})
+	if err != nil {
+		t.Fatalf("GeneratePassword error = %v", err)
+	}
+	if result.PoolSize != 3 || math.Abs(result.Entropy-300*math.Log2(3)) > 0.01 {
+		t.Errorf("PoolSize = %d, Entropy = %v", result.PoolSize, result.Entropy)
+	}
+	if got := result.Passwords[0]; len([]rune(got)) != 300 || strings.Trim(got, "αβγ") != "" {
+		t.Errorf("password = %q", got)
+	}
+
+	// 自定义字符集与已选类型完全重叠时，最少次数仍从自定义字符集中抽取
+	overlap, err := generator.GeneratePassword(PasswordPolicy{Length: 8, Lowercase: true, CustomAlphabet: "ab", MinCustom: 1, Count: 50})
+	if err != nil {
+		t.Fatalf("GeneratePassword(overlap) error = %v", err)
+	}
+	// 至少含一个 a/b 的 8 位小写字符串共 26^8 - 24^8 个
+	if want := math.Log2(math.Pow(26, 8) - math.Pow(24, 8)); overlap.PoolSize != 26 || math.Abs(overlap.Entropy-want) > 0.01 {
+		t.Errorf("overlap PoolSize = %d, Entropy = %v", overlap.PoolSize, overlap.Entropy)
+	}
+	for _, password := range overlap.Passwords {
+		if !strings.ContainsAny(password, "ab") {
+			t.Errorf("password %q has no custom character", password)
+		}
+	}
+
+	// 最少次数覆盖全部长度时只剩自定义字符集的组合：3^4
+	covered, err := generator.GeneratePassword(PasswordPolicy{Length: 4, Lowercase: true, CustomAlphabet: "abc", MinCustom: 4})
+	if err != nil {
+		t.Fatalf("GeneratePassword(covered) error = %v", err)
+	}
+	if want := 4 * math.Log2(3); math.Abs(covered.Entropy-want) > 0.01 {
+		t.Errorf("covered Entropy = %v, want %v", covered.Entropy, want)
+	}
+
+	// 两个约束类型完全重叠时给出不超过无约束熵的保守估计
+	shared, err := generator.GeneratePassword(PasswordPolicy{Length: 8, Lowercase: true, MinLowercase: 1, CustomAlphabet: "abcdefghijklmnopqrstuvwxyz", MinCustom: 1})
+	if err != nil {
+		t.Fatalf("GeneratePassword(shared) error = %v", err)
+	}
+	if shared.Entropy <= 0 || shared.Entropy > 8*math.Log2(26) {
+		t.Errorf("shared Entropy = %v", shared.Entropy)
+	}
+
+	for _, policy := range []PasswordPolicy{
+		{Length: 0, Numbers: true},
+		{Length: MaxPasswordLength + 1, Numbers: true},
+		{Length: 8},
+		{Length: 4, Numbers: true, MinNumbers: 5},
+		{Length: 8, Numbers: true, MinNumbers: -1},
+	} {
+		if _, err := generator.GeneratePassword(policy); err == nil {
+			t.Errorf("GeneratePassword(%+v) expected error", policy)
+		}
+	}
+}
+
+func TestGeneratePassphrase(t *testing.T) {
+	generator := NewGenerator()
+	if n := len(dicewareWords()); n != 7776 {
+		t.Fatalf("wordlist size = %d", n)
+	}
+
+	result, err := generator.GeneratePassphrase(PassphraseOptions{})
+	if err != nil {
+		t.Fatalf("GeneratePassphrase error = %v", err)
+	}
+	if parts := strings.Split(result.Passwords[0], "-"); len(parts) != DefaultPassphraseWords {
+		t.Errorf("passphrase = %q", result.Passwords[0])
+	}
+	if math.Abs(result.Entropy-77.55) > 0.01 {
+		t.Errorf("Entropy = %v", result.Entropy)
+	}
+
+	result, err = generator.GeneratePassphrase(PassphraseOptions{Words: 4, Separator: " ", Capitalize: true, IncludeNumber: true, Count: 3})
+	if err != nil {
+		t.Fatalf("GeneratePassphrase error = %v", err)
+	}
+	for _, passphrase := range result.Passwords {
+		parts := strings.Split(passphrase, " ")
+		if len(parts) != 4 || !strings.ContainsAny(passphrase, "0123456789") || !unicode.IsUpper(rune(parts[0][0])) {
+			t.Errorf("passphrase = %q", passphrase)
+		}
+	}
+
+	if _, err := generator.GeneratePassphrase(PassphraseOptions{Words: MaxPassphraseWords + 1}); err == nil {
+		t.Error("expected error for too many words")
+	}
+}
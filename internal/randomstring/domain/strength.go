@@ -0,0 +1,379 @@
+package domain
+
+import (
+	"embed"
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// MaxStrengthInputLength 强度分析支持的最大密码长度（字符数）
+const MaxStrengthInputLength = 128
+
+// 匹配到的模式类型
+const (
+	PatternDictionary = "dictionary"
+	PatternSpatial    = "spatial"
+	PatternRepeat     = "repeat"
+	PatternSequence   = "sequence"
+	PatternDate       = "date"
+	PatternYear       = "year"
+	PatternBruteforce = "bruteforce"
+)
+
+// 词典名称
+const (
+	DictionaryPasswords  = "passwords"
+	DictionaryEnglish    = "english"
+	DictionaryFirstNames = "first_names"
+	DictionarySurnames   = "surnames"
+	// DictionaryUserInputs 调用方提供的用户名、邮箱等个人信息
+	DictionaryUserInputs = "user_inputs"
+)
+
+// 攻击场景
+const (
+	ScenarioOnlineThrottled   = "online_throttled"
+	ScenarioOnlineUnthrottled = "online_unthrottled"
+	ScenarioOfflineSlowHash   = "offline_slow_hash"
+	ScenarioOfflineFastHash   = "offline_fast_hash"
+)
+
+// crackScenarios 各攻击场景的名称与每秒猜测次数
+var crackScenarios = []struct {
+	scenario string
+	name     string
+	rate     float64
+}{
+	{ScenarioOnlineThrottled, "在线攻击（限速，每小时 100 次）", 100.0 / 3600},
+	{ScenarioOnlineUnthrottled, "在线攻击（不限速，每秒 10 次）", 10},
+	{ScenarioOfflineSlowHash, "离线攻击（慢哈希如 bcrypt，每秒 1 万次）", 1e4},
+	{ScenarioOfflineFastHash, "离线攻击（快哈希如 MD5，每秒 100 亿次）", 1e10},
+}
+
+// dictionaryFiles 按词频排序的内嵌词典，每行一个小写单词，词频数据来自 zxcvbn 项目
+//
+//go:embed dictionaries/*.txt
+var dictionaryFiles embed.FS
+
+// builtinDictionaryNames 内嵌词典，顺序决定猜测次数相同时的匹配优先级
+var builtinDictionaryNames = []string{DictionaryPasswords, DictionaryEnglish, DictionaryFirstNames, DictionarySurnames}
+
+// rankedDictionary 单词到词频排名（从 1 开始）的映射
+type rankedDictionary struct {
+	name  string
+	ranks map[string]int
+}
+
+// builtinDictionaries 首次分析时加载内嵌词典
+var builtinDictionaries = sync.OnceValue(func() []rankedDictionary {
+	dictionaries := make([]rankedDictionary, 0, len(builtinDictionaryNames))
+	for _, name := range builtinDictionaryNames {
+		data, _ := dictionaryFiles.ReadFile("dictionaries/" + name + ".txt")
+		dictionaries = append(dictionaries, newRankedDictionary(name, strings.Fields(string(data))))
+	}
+	return dictionaries
+})
+
+// newRankedDictionary 按顺序为单词编排名，重复的单词保留最靠前的排名
+func newRankedDictionary(name string, words []string) rankedDictionary {
+	ranks := make(map[string]int, len(words))
+	for i, word := range words {
+		word = strings.ToLower(word)
+		if _, ok := ranks[word]; !ok {
+			ranks[word] = i + 1
+		}
+	}
+	return rankedDictionary{name: name, ranks: ranks}
+}
+
+// StrengthMatch 密码中匹配到的一段模式
+type StrengthMatch struct {
+	Pattern string `json:"pattern"`
+	Token   string `json:"token"`
+	// Start、End 为片段在密码中的字符下标（包含 End）
+	Start        int     `json:"start"`
+	End          int     `json:"end"`
+	Guesses      float64 `json:"guesses"`
+	GuessesLog10 float64 `json:"guessesLog10"`
+
+	// 词典匹配：词典名称、匹配到的单词及其排名
+	Dictionary  string `json:"dictionary,omitempty"`
+	MatchedWord string `json:"matchedWord,omitempty"`
+	Rank        int    `json:"rank,omitempty"`
+	Reversed    bool   `json:"reversed,omitempty"`
+	L33t        bool   `json:"l33t,omitempty"`
+	// Substitutions l33t 替换，如 "@→a"
+	Substitutions []string `json:"substitutions,omitempty"`
+
+	// 键盘路径：转折次数与按下 Shift 的字符数
+	Turns        int `json:"turns,omitempty"`
+	ShiftedCount int `json:"shiftedCount,omitempty"`
+
+	// 重复：被重复的片段与重复次数
+	BaseToken   string `json:"baseToken,omitempty"`
+	RepeatCount int    `json:"repeatCount,omitempty"`
+
+	// 序列：相邻字符的差值，负数表示递减
+	Delta int `json:"delta,omitempty"`
+
+	// 日期与年份
+	Year      int    `json:"year,omitempty"`
+	Month     int    `json:"month,omitempty"`
+	Day       int    `json:"day,omitempty"`
+	Separator string `json:"separator,omitempty"`
+
+	logGuesses     float64
+	baseLogGuesses float64
+	subs           map[rune]rune
+}
+
+// CrackTime 某种攻击场景下的估算破解时间
+type CrackTime struct {
+	Scenario         string  `json:"scenario"`
+	Name             string  `json:"name"`
+	GuessesPerSecond float64 `json:"guessesPerSecond"`
+	Seconds          float64 `json:"seconds"`
+	Display          string  `json:"display"`
+}
+
+// StrengthResult 密码强度分析结果
+type StrengthResult struct {
+	Length       int     `json:"length"`
+	Guesses      float64 `json:"guesses"`
+	GuessesLog10 float64 `json:"guessesLog10"`
+	// Score 0-4，分别对应猜测次数低于 10^3、10^6、10^8、10^10 与更高
+	Score      int         `json:"score"`
+	CrackTimes []CrackTime `json:"crackTimes"`
+	// Matches 猜测次数最少的分解方式，未匹配任何模式的部分按暴力破解计算
+	Matches     []StrengthMatch `json:"matches"`
+	Warning     string          `json:"warning"`
+	Suggestions []string        `json:"suggestions"`
+}
+
+// StrengthAnalyzer 参照 zxcvbn 的思路在本地估算密码强度
+type StrengthAnalyzer struct{}
+
+// NewStrengthAnalyzer 创建新的 StrengthAnalyzer 实例
+func NewStrengthAnalyzer() *StrengthAnalyzer {
+	return &StrengthAnalyzer{}
+}
+
+// Analyze 识别密码中的词典单词、键盘路径、日期、重复、序列与 l33t 替换，估算猜测次数与破解时间并给出建议
+// userInputs 为用户名、邮箱等个人信息，会作为额外的词典参与匹配
+func (a *StrengthAnalyzer) Analyze(password string, userInputs []string) (*StrengthResult, error) {
+	if password == "" {
+		return nil, errors.WithStack(ErrEmptyPassword)
+	}
+	runes := []rune(password)
+	if len(runes) > MaxStrengthInputLength {
+		return nil, errors.Wrapf(ErrPasswordTooLong, "最多支持 %d 个字符", MaxStrengthInputLength)
+	}
+
+	dictionaries := builtinDictionaries()
+	if len(userInputs) > 0 {
+		var words []string
+		for _, input := range userInputs {
+			words = append(words, strings.Fields(input)...)
+		}
+		dictionaries = append(dictionaries[:len(dictionaries):len(dictionaries)], newRankedDictionary(DictionaryUserInputs, words))
+	}
+
+	matcher := &strengthMatcher{dictionaries: dictionaries, referenceYear: time.Now().Year()}
+	logGuesses, sequence := matcher.mostGuessable(runes, matcher.omnimatch(runes))
+	guesses := math.Pow(10, logGuesses)
+	score := guessesToScore(logGuesses)
+
+	crackTimes := make([]CrackTime, 0, len(crackScenarios))
+	for _, s := range crackScenarios {
+		seconds := guesses / s.rate
+		crackTimes = append(crackTimes, CrackTime{
+			Scenario:         s.scenario,
+			Name:             s.name,
+			GuessesPerSecond: s.rate,
+			Seconds:          seconds,
+			Display:          displayCrackTime(seconds),
+		})
+	}
+
+	warning, suggestions := strengthFeedback(score, sequence, len(runes))
+	return &StrengthResult{
+		Length:       len(runes),
+		Guesses:      math.Round(guesses),
+		GuessesLog10: round2(logGuesses),
+		Score:        score,
+		CrackTimes:   crackTimes,
+		Matches:      sequence,
+		Warning:      warning,
+		Suggestions:  suggestions,
+	}, nil
+}
+
+// dpEntry 最优分解中的一个状态
+type dpEntry struct {
+	valid bool
+	// logPi 各匹配猜测次数乘积的对数
+	logPi float64
+	// logGuesses 组合后的总猜测次数的对数
+	logGuesses float64
+	match      StrengthMatch
+}
+
+// minGuessesBeforeGrowingSequenceLog 每多拼接一个片段至少增加的猜测次数（10^4）的对数
+const minGuessesBeforeGrowingSequenceLog = 4
+
+// mostGuessable 在所有匹配中找出总猜测次数最小的不重叠组合，返回 log10(猜测次数) 与该组合
+// 由 l 个片段组成的组合猜测次数为 l! × Π guesses + 10000^(l-1)，未被匹配覆盖的部分按暴力破解计算
+func (m *strengthMatcher) mostGuessable(pw []rune, matches []StrengthMatch) (float64, []StrengthMatch) {
+	n := len(pw)
+	byEnd := make([][]StrengthMatch, n)
+	for _, match := range matches {
+		byEnd[match.End] = append(byEnd[match.End], match)
+	}
+
+	// best[k][l] 为覆盖前 k+1 个字符、由 l 个片段组成的最优组合的最后一个片段
+	best := make([][]dpEntry, n)
+	for k := range best {
+		best[k] = make([]dpEntry, k+2)
+	}
+	update := func(match StrengthMatch, l int) {
+		k := match.End
+		logPi := match.logGuesses
+		if l > 1 {
+			logPi += best[match.Start-1][l-1].logPi
+		}
+		logGuesses := logAdd10(logFactorial(l)+logPi, minGuessesBeforeGrowingSequenceLog*float64(l-1))
+		// 片段更少且猜测次数不更多的组合已经存在时舍弃
+		for competing := 1; competing <= l; competing++ {
+			if e := best[k][competing]; e.valid && e.logGuesses <= logGuesses {
+				return
+			}
+		}
+		best[k][l] = dpEntry{valid: true, logPi: logPi, logGuesses: logGuesses, match: match}
+	}
+
+	for k := 0; k < n; k++ {
+		for _, match := range byEnd[k] {
+			if match.Start == 0 {
+				update(match, 1)
+				continue
+			}
+			for l, e := range best[match.Start-1] {
+				if e.valid {
+					update(match, l+1)
+				}
+			}
+		}
+		update(bruteforceMatch(pw, 0, k), 1)
+		for i := 1; i <= k; i++ {
+			bruteforce := bruteforceMatch(pw, i, k)
+			for l, e := range best[i-1] {
+				// 相邻的暴力破解片段应合并为一个
+				if e.valid && e.match.Pattern != PatternBruteforce {
+					update(bruteforce, l+1)
+				}
+			}
+		}
+	}
+
+	last := best[n-1]
+	bestL := 0
+	for l, e := range last {
+		if e.valid && (bestL == 0 || e.logGuesses < last[bestL].logGuesses) {
+			bestL = l
+		}
+	}
+	sequence := make([]StrengthMatch, bestL)
+	for l, k := bestL, n-1; l > 0; l-- {
+		sequence[l-1] = best[k][l].match
+		k = sequence[l-1].Start - 1
+	}
+	return last[bestL].logGuesses, sequence
+}
+
+// bruteforceMatch 暴力破解片段，每个字符按 10 种可能计算
+func bruteforceMatch(pw []rune, i, j int) StrengthMatch {
+	match := StrengthMatch{Pattern: PatternBruteforce, Token: string(pw[i : j+1]), Start: i, End: j}
+	logGuesses := float64(j - i + 1)
+	if i == j {
+		logGuesses = math.Log10(11)
+	}
+	setGuesses(&match, logGuesses)
+	return match
+}
+
+// guessesToScore 将猜测次数映射为 0-4 分
+func guessesToScore(logGuesses float64) int {
+	const delta = 5
+	guesses := math.Pow(10, logGuesses)
+	switch {
+	case guesses < 1e3+delta:
+		return 0
+	case guesses < 1e6+delta:
+		return 1
+	case guesses < 1e8+delta:
+		return 2
+	case guesses < 1e10+delta:
+		return 3
+	default:
+		return 4
+	}
+}
+
+// displayCrackTime 将秒数转换为易读的时长
+func displayCrackTime(seconds float64) string {
+	const (
+		minute  = 60
+		hour    = minute * 60
+		day     = hour * 24
+		month   = day * 31
+		year    = month * 12
+		century = year * 100
+	)
+	units := []struct {
+		size float64
+		name string
+	}{{year, "年"}, {month, "个月"}, {day, "天"}, {hour, "小时"}, {minute, "分钟"}, {1, "秒"}}
+	switch {
+	case seconds < 1:
+		return "不到 1 秒"
+	case seconds >= century:
+		return "数百年以上"
+	}
+	for _, unit := range units {
+		if seconds >= unit.size {
+			return fmt.Sprintf("%.0f %s", math.Round(seconds/unit.size), unit.name)
+		}
+	}
+	return "不到 1 秒"
+}
+
+// setGuesses 记录匹配的猜测次数
+func setGuesses(match *StrengthMatch, logGuesses float64) {
+	match.logGuesses = logGuesses
+	match.GuessesLog10 = round2(logGuesses)
+	match.Guesses = math.Round(math.Pow(10, logGuesses))
+}
+
+// logAdd10 计算 log10(10^a + 10^b)
+func logAdd10(a, b float64) float64 {
+	if a < b {
+		a, b = b, a
+	}
+	return a + math.Log10(1+math.Pow(10, b-a))
+}
+
+// logFactorial 计算 log10(n!)
+func logFactorial(n int) float64 {
+	lgamma, _ := math.Lgamma(float64(n + 1))
+	return lgamma / math.Ln10
+}
+
+// round2 保留两位小数
+func round2(v float64) float64 {
+	return math.Round(v*100) / 100
+}
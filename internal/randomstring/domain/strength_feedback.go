@@ -0,0 +1,112 @@
+package domain
+
+import "unicode"
+
+const (
+	// feedbackScoreThreshold 得分高于该值时不再给出建议
+	feedbackScoreThreshold = 2
+	// minSuggestedLength 建议的最短密码长度
+	minSuggestedLength = 12
+)
+
+// strengthFeedback 根据得分与最长的匹配片段给出警告和改进建议
+func strengthFeedback(score int, sequence []StrengthMatch, length int) (string, []string) {
+	if score > feedbackScoreThreshold {
+		return "", []string{}
+	}
+
+	longest := sequence[0]
+	for _, match := range sequence[1:] {
+		if len([]rune(match.Token)) > len([]rune(longest.Token)) {
+			longest = match
+		}
+	}
+	warning, extra := matchFeedback(longest, len(sequence) == 1)
+
+	suggestions := []string{"再加一两个单词，不常见的单词更好"}
+	if length < minSuggestedLength {
+		suggestions = append(suggestions, "把长度增加到 12 个字符以上")
+	}
+	return warning, append(suggestions, extra...)
+}
+
+// matchFeedback 针对单个匹配片段的警告和建议
+func matchFeedback(match StrengthMatch, isSoleMatch bool) (string, []string) {
+	switch match.Pattern {
+	case PatternDictionary:
+		return dictionaryFeedback(match, isSoleMatch)
+	case PatternSpatial:
+		warning := "短的键盘路径很容易被猜到"
+		if match.Turns == 1 {
+			warning = "键盘上同一行连续的按键很容易被猜到"
+		}
+		return warning, []string{"使用更长、转折更多的键盘路径，或者干脆不用"}
+	case PatternRepeat:
+		warning := "像 \"abcabcabc\" 这样的重复只比 \"abc\" 稍难猜一点"
+		if len([]rune(match.BaseToken)) == 1 {
+			warning = "像 \"aaa\" 这样的重复很容易被猜到"
+		}
+		return warning, []string{"避免重复的单词和字符"}
+	case PatternSequence:
+		return "像 abc 或 6543 这样的序列很容易被猜到", []string{"避免连续的字母或数字序列"}
+	case PatternDate:
+		return "日期很容易被猜到", []string{"避免使用与自己相关的日期和年份"}
+	case PatternYear:
+		return "最近的年份很容易被猜到", []string{"避免使用最近的年份", "避免使用与自己相关的年份"}
+	}
+	return "", nil
+}
+
+// dictionaryFeedback 词典匹配的警告和建议
+func dictionaryFeedback(match StrengthMatch, isSoleMatch bool) (string, []string) {
+	var warning string
+	switch match.Dictionary {
+	case DictionaryPasswords:
+		switch {
+		case isSoleMatch && !match.L33t && !match.Reversed && match.Rank <= 10:
+			warning = "这是最常用的 10 个密码之一"
+		case isSoleMatch && !match.L33t && !match.Reversed && match.Rank <= 100:
+			warning = "这是最常用的 100 个密码之一"
+		case isSoleMatch && !match.L33t && !match.Reversed:
+			warning = "这是非常常见的密码"
+		case match.GuessesLog10 <= 4:
+			warning = "这与常用密码非常相似"
+		}
+	case DictionaryEnglish:
+		if isSoleMatch {
+			warning = "单个单词很容易被猜到"
+		}
+	case DictionaryFirstNames, DictionarySurnames:
+		warning = "常见的名字和姓氏很容易被猜到"
+		if isSoleMatch {
+			warning = "单独的名字或姓氏很容易被猜到"
+		}
+	case DictionaryUserInputs:
+		warning = "密码包含了用户名等个人信息"
+	}
+
+	var suggestions []string
+	token := []rune(match.Token)
+	upper, letters := 0, 0
+	for _, r := range token {
+		if unicode.IsLetter(r) {
+			letters++
+		}
+		if unicode.IsUpper(r) {
+			upper++
+		}
+	}
+	switch {
+	case upper == 1 && unicode.IsUpper(token[0]):
+		suggestions = append(suggestions, "只把首字母大写作用不大")
+	case upper > 1 && upper == letters:
+		suggestions = append(suggestions, "全部大写与全部小写几乎一样容易被猜到")
+	}
+	if match.Reversed && len(token) >= 4 {
+		suggestions = append(suggestions, "把单词倒过来写并不会更难猜")
+	}
+	if match.L33t {
+		suggestions = append(suggestions, "像用 @ 代替 a 这样的替换作用不大")
+	}
+	return warning, suggestions
+}
@@ -0,0 +1,662 @@
+package domain
+
+import (
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+const (
+	// maxDictionaryWordLength 词典匹配的最大片段长度
+	maxDictionaryWordLength = 32
+	// maxL33tCombinations l33t 替换组合的上限
+	maxL33tCombinations = 64
+	// minYearSpace 日期与年份猜测时的最小年份范围
+	minYearSpace = 20
+)
+
+// l33tTable 字母与常见的 l33t 替换字符
+var l33tTable = map[rune]string{
+	'a': "4@",
+	'b': "8",
+	'c': "({[<",
+	'e': "3",
+	'g': "69",
+	'i': "1!|",
+	'l': "1|7",
+	'o': "0",
+	's': "$5",
+	't': "+7",
+	'x': "%",
+	'z': "2",
+}
+
+// l33tReverse 替换字符到可能的原字母
+var l33tReverse = func() map[rune][]rune {
+	reverse := make(map[rune][]rune)
+	letters := make([]rune, 0, len(l33tTable))
+	for letter := range l33tTable {
+		letters = append(letters, letter)
+	}
+	sort.Slice(letters, func(i, j int) bool { return letters[i] < letters[j] })
+	for _, letter := range letters {
+		for _, sub := range l33tTable[letter] {
+			reverse[sub] = append(reverse[sub], letter)
+		}
+	}
+	return reverse
+}()
+
+// qwertyRows QWERTY 键盘布局（未按 Shift 与按下 Shift），每行相对上一行右移半个键位，空格为占位
+var qwertyRows = [][2]string{
+	{"`1234567890-=", "~!@#$%^&*()_+"},
+	{" qwertyuiop[]\\", " QWERTYUIOP{}|"},
+	{" asdfghjkl;'", ` ASDFGHJKL:"`},
+	{" zxcvbnm,./", " ZXCVBNM<>?"},
+}
+
+// keyDirections 相邻键位的六个方向：左、左上、右上、右、右下、左下
+var keyDirections = [6][2]int{{0, -1}, {-1, 0}, {-1, 1}, {0, 1}, {1, 0}, {1, -1}}
+
+// keyPosition 字符在键盘上的位置
+type keyPosition struct {
+	row, col int
+	shifted  bool
+}
+
+// keyboardLayout 键盘布局及用于估算键盘路径猜测次数的统计量
+type keyboardLayout struct {
+	positions map[rune]keyPosition
+	// startingPositions 可作为起点的字符数
+	startingPositions float64
+	// averageDegree 每个键平均的相邻键数
+	averageDegree float64
+}
+
+// qwerty QWERTY 键盘
+var qwerty = func() keyboardLayout {
+	layout := keyboardLayout{positions: make(map[rune]keyPosition)}
+	grid := make([][]rune, len(qwertyRows))
+	for row, keys := range qwertyRows {
+		grid[row] = []rune(keys[0])
+		for col, r := range []rune(keys[0]) {
+			if r != ' ' {
+				layout.positions[r] = keyPosition{row: row, col: col}
+			}
+		}
+		for col, r := range []rune(keys[1]) {
+			if r != ' ' {
+				layout.positions[r] = keyPosition{row: row, col: col, shifted: true}
+			}
+		}
+	}
+
+	keys, neighbors := 0, 0
+	for row := range grid {
+		for col, r := range grid[row] {
+			if r == ' ' {
+				continue
+			}
+			keys++
+			for _, d := range keyDirections {
+				nr, nc := row+d[0], col+d[1]
+				if nr >= 0 && nr < len(grid) && nc >= 0 && nc < len(grid[nr]) && grid[nr][nc] != ' ' {
+					neighbors++
+				}
+			}
+		}
+	}
+	layout.startingPositions = float64(len(layout.positions))
+	layout.averageDegree = float64(neighbors) / float64(keys)
+	return layout
+}()
+
+// direction 返回从 a 到 b 的键位方向，不相邻时返回 -1
+func (k keyboardLayout) direction(a, b rune) int {
+	pa, ok := k.positions[a]
+	if !ok {
+		return -1
+	}
+	pb, ok := k.positions[b]
+	if !ok {
+		return -1
+	}
+	for i, d := range keyDirections {
+		if pb.row == pa.row+d[0] && pb.col == pa.col+d[1] {
+			return i
+		}
+	}
+	return -1
+}
+
+// separatedDatePattern 带分隔符的日期，如 1990-05-14、14/05/90
+var separatedDatePattern = regexp.MustCompile(`^(\d{1,4})([\s/\\_.-])(\d{1,2})([\s/\\_.-])(\d{1,4})$`)
+
+// strengthMatcher 在密码中查找各种模式并估算猜测次数
+type strengthMatcher struct {
+	dictionaries  []rankedDictionary
+	referenceYear int
+}
+
+// omnimatch 返回所有模式的匹配，按位置排序
+func (m *strengthMatcher) omnimatch(pw []rune) []StrengthMatch {
+	var matches []StrengthMatch
+	matches = append(matches, m.dictionaryMatches(pw)...)
+	matches = append(matches, m.reverseDictionaryMatches(pw)...)
+	matches = append(matches, m.l33tMatches(pw)...)
+	matches = append(matches, spatialMatches(pw)...)
+	matches = append(matches, m.repeatMatches(pw)...)
+	matches = append(matches, sequenceMatches(pw)...)
+	matches = append(matches, m.dateMatches(pw)...)
+	matches = append(matches, yearMatches(pw)...)
+
+	for i := range matches {
+		m.estimate(&matches[i], len(pw))
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].Start != matches[j].Start {
+			return matches[i].Start < matches[j].Start
+		}
+		return matches[i].End < matches[j].End
+	})
+	return matches
+}
+
+// dictionaryMatches 忽略大小写查找词典中的单词
+func (m *strengthMatcher) dictionaryMatches(pw []rune) []StrengthMatch {
+	lower := make([]rune, len(pw))
+	for i, r := range pw {
+		lower[i] = unicode.ToLower(r)
+	}
+	var matches []StrengthMatch
+	for i := range lower {
+		for j := i; j < len(lower) && j-i < maxDictionaryWordLength; j++ {
+			word := string(lower[i : j+1])
+			for _, dictionary := range m.dictionaries {
+				if rank, ok := dictionary.ranks[word]; ok {
+					matches = append(matches, StrengthMatch{
+						Pattern:     PatternDictionary,
+						Token:       string(pw[i : j+1]),
+						Start:       i,
+						End:         j,
+						Dictionary:  dictionary.name,
+						MatchedWord: word,
+						Rank:        rank,
+					})
+				}
+			}
+		}
+	}
+	return matches
+}
+
+// reverseDictionaryMatches 查找倒写的词典单词
+func (m *strengthMatcher) reverseDictionaryMatches(pw []rune) []StrengthMatch {
+	n := len(pw)
+	reversed := make([]rune, n)
+	for i, r := range pw {
+		reversed[n-1-i] = r
+	}
+	var matches []StrengthMatch
+	for _, match := range m.dictionaryMatches(reversed) {
+		if match.End == match.Start {
+			continue
+		}
+		match.Start, match.End = n-1-match.End, n-1-match.Start
+		match.Token = string(pw[match.Start : match.End+1])
+		match.Reversed = true
+		matches = append(matches, match)
+	}
+	return matches
+}
+
+// l33tMatches 还原 l33t 替换后查找词典单词，如 p@ssw0rd
+func (m *strengthMatcher) l33tMatches(pw []rune) []StrengthMatch {
+	var subChars []rune
+	seen := make(map[rune]bool)
+	for _, r := range pw {
+		if _, ok := l33tReverse[r]; ok && !seen[r] {
+			seen[r] = true
+			subChars = append(subChars, r)
+		}
+	}
+	if len(subChars) == 0 {
+		return nil
+	}
+
+	// 枚举替换字符到原字母的所有组合，如 1 可能是 i 或 l
+	combinations := []map[rune]rune{{}}
+	for _, sub := range subChars {
+		var next []map[rune]rune
+		for _, combination := range combinations {
+			for _, letter := range l33tReverse[sub] {
+				if len(next) >= maxL33tCombinations {
+					break
+				}
+				extended := make(map[rune]rune, len(combination)+1)
+				for k, v := range combination {
+					extended[k] = v
+				}
+				extended[sub] = letter
+				next = append(next, extended)
+			}
+		}
+		combinations = next
+	}
+
+	type matchKey struct {
+		start, end int
+		dictionary string
+		word       string
+	}
+	found := make(map[matchKey]bool)
+	var matches []StrengthMatch
+	for _, combination := range combinations {
+		translated := make([]rune, len(pw))
+		for i, r := range pw {
+			if letter, ok := combination[r]; ok {
+				translated[i] = letter
+			} else {
+				translated[i] = r
+			}
+		}
+		for _, match := range m.dictionaryMatches(translated) {
+			if match.Start == match.End {
+				continue
+			}
+			token := pw[match.Start : match.End+1]
+			subs := make(map[rune]rune)
+			for _, r := range token {
+				if letter, ok := combination[r]; ok {
+					subs[r] = letter
+				}
+			}
+			key := matchKey{match.Start, match.End, match.Dictionary, match.MatchedWord}
+			if len(subs) == 0 || found[key] {
+				continue
+			}
+			found[key] = true
+
+			match.Token = string(token)
+			match.L33t = true
+			match.subs = subs
+			for _, sub := range subChars {
+				if letter, ok := subs[sub]; ok {
+					match.Substitutions = append(match.Substitutions, string(sub)+"→"+string(letter))
+				}
+			}
+			matches = append(matches, match)
+		}
+	}
+	return matches
+}
+
+// spatialMatches 查找至少 3 个字符的键盘路径，如 qwerty、zxcvb、1qaz
+func spatialMatches(pw []rune) []StrengthMatch {
+	var matches []StrengthMatch
+	for i := 0; i < len(pw)-2; {
+		j, turns, shifted, lastDirection := i, 0, 0, -1
+		if p, ok := qwerty.positions[pw[i]]; ok && p.shifted {
+			shifted++
+		}
+		for j+1 < len(pw) {
+			d := qwerty.direction(pw[j], pw[j+1])
+			if d < 0 {
+				break
+			}
+			if d != lastDirection {
+				turns++
+				lastDirection = d
+			}
+			if qwerty.positions[pw[j+1]].shifted {
+				shifted++
+			}
+			j++
+		}
+		if j-i >= 2 {
+			matches = append(matches, StrengthMatch{
+				Pattern:      PatternSpatial,
+				Token:        string(pw[i : j+1]),
+				Start:        i,
+				End:          j,
+				Turns:        turns,
+				ShiftedCount: shifted,
+			})
+		}
+		i = max(j, i+1)
+	}
+	return matches
+}
+
+// repeatMatches 查找重复的片段，如 aaa、abcabc，重复单元的猜测次数递归计算
+func (m *strengthMatcher) repeatMatches(pw []rune) []StrengthMatch {
+	var matches []StrengthMatch
+	for i := 0; i < len(pw)-1; {
+		end, baseLength := -1, 0
+		for b := 1; i+2*b <= len(pw); b++ {
+			j := i + b
+			for j+b <= len(pw) && string(pw[j:j+b]) == string(pw[i:i+b]) {
+				j += b
+			}
+			if j-i >= 2*b && j-1 > end {
+				end, baseLength = j-1, b
+			}
+		}
+		if end < 0 {
+			i++
+			continue
+		}
+
+		base := pw[i : i+baseLength]
+		baseLogGuesses, _ := m.mostGuessable(base, m.omnimatch(base))
+		matches = append(matches, StrengthMatch{
+			Pattern:        PatternRepeat,
+			Token:          string(pw[i : end+1]),
+			Start:          i,
+			End:            end,
+			BaseToken:      string(base),
+			RepeatCount:    (end - i + 1) / baseLength,
+			baseLogGuesses: baseLogGuesses,
+		})
+		i = end + 1
+	}
+	return matches
+}
+
+// sequenceMatches 查找步长固定（不超过 5）的字符序列，如 abcd、13579、9876
+func sequenceMatches(pw []rune) []StrengthMatch {
+	class := func(r rune) int {
+		switch {
+		case unicode.IsDigit(r):
+			return 1
+		case unicode.IsLower(r):
+			return 2
+		case unicode.IsUpper(r):
+			return 3
+		case unicode.IsLetter(r):
+			return 4
+		}
+		return 0
+	}
+	var matches []StrengthMatch
+	for i := 0; i < len(pw)-2; {
+		delta := int(pw[i+1]) - int(pw[i])
+		c := class(pw[i])
+		if delta == 0 || delta > 5 || delta < -5 || c == 0 || class(pw[i+1]) != c {
+			i++
+			continue
+		}
+		j := i + 1
+		for j+1 < len(pw) && int(pw[j+1])-int(pw[j]) == delta && class(pw[j+1]) == c {
+			j++
+		}
+		if j-i < 2 {
+			i++
+			continue
+		}
+		matches = append(matches, StrengthMatch{
+			Pattern: PatternSequence,
+			Token:   string(pw[i : j+1]),
+			Start:   i,
+			End:     j,
+			Delta:   delta,
+		})
+		i = j
+	}
+	return matches
+}
+
+// dateMatches 查找 4-8 位纯数字日期（如 19900514、051490）与带分隔符的日期（如 1990-05-14）
+func (m *strengthMatcher) dateMatches(pw []rune) []StrengthMatch {
+	var matches []StrengthMatch
+	for i := range pw {
+		if !unicode.IsDigit(pw[i]) {
+			continue
+		}
+		for j := i + 3; j < len(pw) && j < i+10; j++ {
+			token := string(pw[i : j+1])
+			var candidates [][3]string
+			separator := ""
+			if groups := separatedDatePattern.FindStringSubmatch(token); groups != nil {
+				if groups[2] != groups[4] {
+					continue
+				}
+				separator = groups[2]
+				candidates = append(candidates, [3]string{groups[1], groups[3], groups[5]})
+			} else if j-i < 8 && isASCIIDigits(token) {
+				for a := 1; a < len(token)-1; a++ {
+					for b := a + 1; b < len(token); b++ {
+						candidates = append(candidates, [3]string{token[:a], token[a:b], token[b:]})
+					}
+				}
+			}
+
+			found, bestDistance := false, 0
+			var date StrengthMatch
+			for _, parts := range candidates {
+				year, month, day, ok := dateFromParts(parts)
+				if !ok {
+					continue
+				}
+				if distance := abs(year - m.referenceYear); !found || distance < bestDistance {
+					found, bestDistance = true, distance
+					date = StrengthMatch{Year: year, Month: month, Day: day}
+				}
+			}
+			if found {
+				date.Pattern, date.Token, date.Start, date.End, date.Separator = PatternDate, token, i, j, separator
+				matches = append(matches, date)
+			}
+		}
+	}
+	return matches
+}
+
+// dateFromParts 将三段数字解释为日期：年份在首或尾，月、日顺序任意
+func dateFromParts(parts [3]string) (year, month, day int, ok bool) {
+	try := func(y, a, b string) bool {
+		yv, valid := expandYear(y)
+		if !valid || len(a) > 2 || len(b) > 2 {
+			return false
+		}
+		av, _ := strconv.Atoi(a)
+		bv, _ := strconv.Atoi(b)
+		for _, md := range [][2]int{{av, bv}, {bv, av}} {
+			if md[0] >= 1 && md[0] <= 12 && md[1] >= 1 && md[1] <= 31 {
+				year, month, day = yv, md[0], md[1]
+				return true
+			}
+		}
+		return false
+	}
+	if try(parts[0], parts[1], parts[2]) || try(parts[2], parts[0], parts[1]) {
+		return year, month, day, true
+	}
+	return 0, 0, 0, false
+}
+
+// expandYear 解析两位或四位年份，两位年份按 1951-2050 补全
+func expandYear(s string) (int, bool) {
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, false
+	}
+	switch len(s) {
+	case 2:
+		if v > 50 {
+			return 1900 + v, true
+		}
+		return 2000 + v, true
+	case 4:
+		return v, v >= 1000 && v <= 2099
+	}
+	return 0, false
+}
+
+// yearMatches 查找 19xx、20xx 形式的年份
+func yearMatches(pw []rune) []StrengthMatch {
+	var matches []StrengthMatch
+	for i := 0; i+4 <= len(pw); i++ {
+		token := string(pw[i : i+4])
+		if !isASCIIDigits(token) || (token[:2] != "19" && token[:2] != "20") {
+			continue
+		}
+		year, _ := strconv.Atoi(token)
+		matches = append(matches, StrengthMatch{Pattern: PatternYear, Token: token, Start: i, End: i + 3, Year: year})
+	}
+	return matches
+}
+
+// estimate 按模式估算匹配的猜测次数；片段不是整个密码时设置最小猜测次数
+func (m *strengthMatcher) estimate(match *StrengthMatch, passwordLength int) {
+	token := []rune(match.Token)
+	var logGuesses float64
+	switch match.Pattern {
+	case PatternDictionary:
+		logGuesses = math.Log10(float64(match.Rank)) + math.Log10(uppercaseVariations(token)) + math.Log10(l33tVariations(token, match.subs))
+		if match.Reversed {
+			logGuesses += math.Log10(2)
+		}
+	case PatternSpatial:
+		logGuesses = math.Log10(spatialGuesses(len(token), match.Turns, match.ShiftedCount))
+	case PatternRepeat:
+		logGuesses = match.baseLogGuesses + math.Log10(float64(match.RepeatCount))
+	case PatternSequence:
+		base := 26.0
+		switch {
+		case strings.ContainsRune("aAzZ019", token[0]):
+			base = 4
+		case unicode.IsDigit(token[0]):
+			base = 10
+		}
+		if match.Delta < 0 {
+			base *= 2
+		}
+		logGuesses = math.Log10(base * float64(len(token)))
+	case PatternDate:
+		guesses := float64(max(abs(match.Year-m.referenceYear), minYearSpace)) * 365
+		if match.Separator != "" {
+			guesses *= 4
+		}
+		logGuesses = math.Log10(guesses)
+	case PatternYear:
+		logGuesses = math.Log10(float64(max(abs(match.Year-m.referenceYear), minYearSpace)))
+	}
+
+	if len(token) < passwordLength {
+		minimum := math.Log10(50)
+		if len(token) == 1 {
+			minimum = 1
+		}
+		logGuesses = max(logGuesses, minimum)
+	}
+	setGuesses(match, logGuesses)
+}
+
+// uppercaseVariations 大小写带来的变化：首字母、末字母或全部大写只算 2 倍
+func uppercaseVariations(token []rune) float64 {
+	upper, lower := 0, 0
+	for _, r := range token {
+		switch {
+		case unicode.IsUpper(r):
+			upper++
+		case unicode.IsLower(r):
+			lower++
+		}
+	}
+	if upper == 0 {
+		return 1
+	}
+	if lower == 0 || (upper == 1 && (unicode.IsUpper(token[0]) || unicode.IsUpper(token[len(token)-1]))) {
+		return 2
+	}
+	var variations float64
+	for i := 1; i <= min(upper, lower); i++ {
+		variations += binomial(upper+lower, i)
+	}
+	return variations
+}
+
+// l33tVariations l33t 替换带来的变化
+func l33tVariations(token []rune, subs map[rune]rune) float64 {
+	variations := 1.0
+	for sub, letter := range subs {
+		subbed, unsubbed := 0, 0
+		for _, r := range token {
+			switch {
+			case r == sub:
+				subbed++
+			case unicode.ToLower(r) == letter:
+				unsubbed++
+			}
+		}
+		if subbed == 0 || unsubbed == 0 {
+			variations *= 2
+			continue
+		}
+		var possibilities float64
+		for i := 1; i <= min(subbed, unsubbed); i++ {
+			possibilities += binomial(subbed+unsubbed, i)
+		}
+		variations *= possibilities
+	}
+	return variations
+}
+
+// spatialGuesses 长度不超过 length、转折不超过 turns 的键盘路径数量，再乘以 Shift 带来的变化
+func spatialGuesses(length, turns, shifted int) float64 {
+	var guesses float64
+	for i := 2; i <= length; i++ {
+		for j := 1; j <= min(turns, i-1); j++ {
+			guesses += binomial(i-1, j-1) * qwerty.startingPositions * math.Pow(qwerty.averageDegree, float64(j))
+		}
+	}
+	if shifted > 0 {
+		unshifted := length - shifted
+		if unshifted == 0 {
+			return guesses * 2
+		}
+		var variations float64
+		for i := 1; i <= min(shifted, unshifted); i++ {
+			variations += binomial(shifted+unshifted, i)
+		}
+		guesses *= variations
+	}
+	return guesses
+}
+
+// binomial 组合数 C(n, k)
+func binomial(n, k int) float64 {
+	if k < 0 || k > n {
+		return 0
+	}
+	result := 1.0
+	for i := 1; i <= min(k, n-k); i++ {
+		result = result * float64(n-i+1) / float64(i)
+	}
+	return result
+}
+
+// isASCIIDigits 判断字符串是否全部由 ASCII 数字组成
+func isASCIIDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// abs 整数绝对值
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
@@ -0,0 +1,101 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestStrengthAnalyzerPatterns(t *testing.T) {
+	analyzer := NewStrengthAnalyzer()
+	tests := []struct {
+		name     string
+		password string
+		pattern  string
+		check    func(StrengthMatch) bool
+		maxScore int
+	}{
+		{"常用密码", "password", PatternDictionary, func(m StrengthMatch) bool { return m.Dictionary == DictionaryPasswords && m.Rank == 1 }, 0},
+		{"l33t 替换", "P@ssw0rd", PatternDictionary, func(m StrengthMatch) bool { return m.L33t && m.MatchedWord == "password" && len(m.Substitutions) == 2 }, 0},
+		{"倒写", "drowssap", PatternDictionary, func(m StrengthMatch) bool { return m.Reversed }, 0},
+		{"键盘路径", "ujmnhy6", PatternSpatial, func(m StrengthMatch) bool { return m.Turns > 1 }, 2},
+		{"重复", "xyzxyzxyz", PatternRepeat, func(m StrengthMatch) bool { return m.BaseToken == "xyz" && m.RepeatCount == 3 }, 1},
+		{"序列", "9876543", PatternSequence, func(m StrengthMatch) bool { return m.Delta == -1 }, 0},
+		{"日期", "19900514", PatternDate, func(m StrengthMatch) bool { return m.Year == 1990 && m.Month == 5 && m.Day == 14 }, 1},
+		{"带分隔符的日期", "14/05/1990", PatternDate, func(m StrengthMatch) bool { return m.Separator == "/" && m.Day == 14 }, 2},
+		{"姓名", "johnsmith", PatternDictionary, func(m StrengthMatch) bool { return m.Dictionary == DictionarySurnames }, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := analyzer.Analyze(tt.password, nil)
+			if err != nil {
+				t.Fatalf("Analyze error = %v", err)
+			}
+			if result.Score > tt.maxScore {
+				t.Errorf("Score = %d, want <= %d", result.Score, tt.maxScore)
+			}
+			found := false
+			for _, m := range result.Matches {
+				if m.Pattern == tt.pattern && tt.check(m) {
+					found = true
+				}
+			}
+			if !found {
+				t.Errorf("Matches = %+v, want %s", result.Matches, tt.pattern)
+			}
+			if result.Warning == "" || len(result.Suggestions) == 0 {
+				t.Errorf("Warning = %q, Suggestions = %v", result.Warning, result.Suggestions)
+			}
+		})
+	}
+}
+
+func TestStrengthAnalyzerScore(t *testing.T) {
+	analyzer := NewStrengthAnalyzer()
+
+	strong, err := analyzer.Analyze("j8#Kq2!vLp9$zX", nil)
+	if err != nil {
+		t.Fatalf("Analyze error = %v", err)
+	}
+	if strong.Score != 4 || strong.Warning != "" || len(strong.CrackTimes) != 4 {
+		t.Errorf("strong = %+v", strong)
+	}
+	if strong.CrackTimes[0].Display != "数百年以上" || strong.CrackTimes[3].Seconds >= strong.CrackTimes[2].Seconds {
+		t.Errorf("CrackTimes = %+v", strong.CrackTimes)
+	}
+
+	// 组合的猜测次数不低于各片段猜测次数之积
+	weak, _ := analyzer.Analyze("password", nil)
+	longer, _ := analyzer.Analyze("password2024", nil)
+	if longer.GuessesLog10 <= weak.GuessesLog10 {
+		t.Errorf("password2024 = %v, password = %v", longer.GuessesLog10, weak.GuessesLog10)
+	}
+
+	// 个人信息作为额外词典参与匹配
+	personal, _ := analyzer.Analyze("zhangwei2024", []string{"zhangwei zw@example.com"})
+	if personal.Matches[0].Dictionary != DictionaryUserInputs || !strings.Contains(personal.Warning, "个人信息") {
+		t.Errorf("personal = %+v", personal)
+	}
+
+	if _, err := analyzer.Analyze("", nil); err == nil {
+		t.Error("expected error for empty password")
+	}
+	if _, err := analyzer.Analyze(strings.Repeat("a", MaxStrengthInputLength+1), nil); err == nil {
+		t.Error("expected error for long password")
+	}
+}
+
+func TestDisplayCrackTime(t *testing.T) {
+	for seconds, want := range map[float64]string{
+		0.5:         "不到 1 秒",
+		42:          "42 秒",
+		90:          "2 分钟",
+		3 * 86400:   "3 天",
+		400 * 86400: "1 年",
+		1e12:        "数百年以上",
+	} {
+		if got := displayCrackTime(seconds); got != want {
+			t.Errorf("displayCrackTime(%v) = %q, want %q", seconds, got, want)
+		}
+	}
+}
@@ -3,17 +3,20 @@ package interfaces
 import (
 	historydomain "github.com/cyrnicolase/dev-tools/internal/history/domain"
 	"github.com/cyrnicolase/dev-tools/internal/randomstring/application"
+	"github.com/cyrnicolase/dev-tools/internal/randomstring/domain"
 )
 
 // API 随机字符串工具 API
 type API struct {
-	service *application.Service
+	service  *application.Service
+	strength *application.StrengthService
 }
 
 // NewAPI 创建新的 API 实例
 func NewAPI() *API {
 	return &API{
-		service: application.NewService(),
+		service:  application.NewService(),
+		strength: application.NewStrengthService(),
 	}
 }
 
@@ -27,6 +30,21 @@ func (a *API) GenerateBatch(length int, includeNumbers, includeLowercase, includ
 	return a.service.GenerateBatch(length, includeNumbers, includeLowercase, includeUppercase, includeSpecial, count)
 }
 
+// GeneratePassword 按策略生成密码
+func (a *API) GeneratePassword(policy domain.PasswordPolicy) (*domain.PasswordResult, error) {
+	return a.service.GeneratePassword(policy)
+}
+
+// GeneratePassphrase 生成 diceware 口令
+func (a *API) GeneratePassphrase(opts domain.PassphraseOptions) (*domain.PasswordResult, error) {
+	return a.service.GeneratePassphrase(opts)
+}
+
+// AnalyzeStrength 分析密码强度
+func (a *API) AnalyzeStrength(password string, userInputs []string) (*domain.StrengthResult, error) {
+	return a.strength.Analyze(password, userInputs)
+}
+
 // ListHistory 获取历史记录
 func (a *API) ListHistory() ([]historydomain.ToolHistoryRecord, error) {
 	return a.service.ListHistory()
@@ -1,6 +1,9 @@
 package application
 
 import (
+	"strings"
+	"time"
+
 	"github.com/cyrnicolase/dev-tools/internal/timestamp/domain"
 	"github.com/pkg/errors"
 )
@@ -9,6 +12,11 @@ import (
 type Service struct {
 	converter      *domain.Converter
 	formatter      *domain.Formatter
+	smartParser    *domain.SmartParser
+	worldClock     *domain.WorldClock
+	translator     *domain.LayoutTranslator
+	batchConverter *domain.BatchConverter
+	formats        *domain.FormatStore
 	historyStore   *domain.HistoryStore
 	historyInitErr error
 }
@@ -16,9 +24,16 @@ type Service struct {
 // NewService 创建新的 Service 实例
 func NewService() *Service {
 	historyStore, historyErr := domain.NewHistoryStore()
+	// 自定义格式存储在各组件间共享，保存或删除格式后立即对转换生效
+	formats := domain.NewFormatStore()
 	return &Service{
-		converter:      domain.NewConverter(),
-		formatter:      domain.NewFormatter(),
+		converter:      domain.NewConverter(formats),
+		formatter:      domain.NewFormatter(formats),
+		smartParser:    domain.NewSmartParser(formats),
+		worldClock:     domain.NewWorldClock(formats),
+		translator:     domain.NewLayoutTranslator(),
+		batchConverter: domain.NewBatchConverter(formats),
+		formats:        formats,
 		historyStore:   historyStore,
 		historyInitErr: historyErr,
 	}
@@ -59,6 +74,146 @@ func (s *Service) GetCurrentTimestampMilli() int64 {
 	return s.formatter.GetCurrentTimestampMilli()
 }
 
+// ConvertTimestamp 时间戳转时间字符串，unit 为 auto 时自动识别秒/毫秒/微秒/纳秒
+func (s *Service) ConvertTimestamp(input, unit, format, timezone string) (*domain.TimestampConversion, error) {
+	return s.converter.ConvertTimestamp(input, unit, format, timezone)
+}
+
+// TimeStringToTimestamps 时间字符串转四种单位的时间戳
+func (s *Service) TimeStringToTimestamps(timeStr, format, timezone string) (*domain.TimestampConversion, error) {
+	return s.converter.TimeStringToTimestamps(timeStr, format, timezone)
+}
+
+// SmartParse 自动识别时间格式或相对时间表达式并转换为时间戳
+func (s *Service) SmartParse(input, format, timezone string) (*domain.SmartParseResult, error) {
+	return s.smartParser.Parse(input, format, timezone)
+}
+
+// WorldClock 将同一时刻展示在多个时区，input 为空时使用当前时间
+// input 支持智能解析的所有格式，不含时区信息时按第一个时区解释；zones 为空时使用默认时区
+func (s *Service) WorldClock(input, format string, zones []string) ([]domain.ZoneTime, error) {
+	if len(zones) == 0 {
+		zones = domain.DefaultWorldClockZones
+	}
+	t, err := s.resolveTime(input, zones[0])
+	if err != nil {
+		return nil, err
+	}
+	return s.worldClock.Render(t, zones, format)
+}
+
+// MeetingPlanner 以第一个时区的日期为基准，按小时列出各时区工作时间的重叠情况
+func (s *Service) MeetingPlanner(date string, zones []string, workStart, workEnd int) (*domain.MeetingPlan, error) {
+	return s.worldClock.Plan(date, zones, workStart, workEnd)
+}
+
+// SuggestTimezones 返回与输入相近的时区名称
+func (s *Service) SuggestTimezones(name string) []string {
+	return domain.SuggestTimezones(name)
+}
+
+// ListTimezones 返回所有已知的 IANA 时区名称
+func (s *Service) ListTimezones() []string {
+	return domain.ListTimezones()
+}
+
+// AddPeriod 对时间加减时长表达式（+3d4h、+1 month、P1Y2M3DT4H），input 为空时使用当前时间
+func (s *Service) AddPeriod(input, expr, format, timezone string) (*domain.ArithmeticResult, error) {
+	t, err := s.resolveTime(input, timezone)
+	if err != nil {
+		return nil, err
+	}
+	return s.converter.AddPeriod(t, expr, format, timezone)
+}
+
+// Difference 计算两个时间的差值，任一为空时使用当前时间
+func (s *Service) Difference(from, to, format, timezone string) (*domain.TimeDifference, error) {
+	fromTime, err := s.resolveTime(from, timezone)
+	if err != nil {
+		return nil, err
+	}
+	toTime, err := s.resolveTime(to, timezone)
+	if err != nil {
+		return nil, err
+	}
+	return s.converter.Difference(fromTime, toTime, format, timezone)
+}
+
+// BusinessDays 统计两个日期之间（含首尾）的工作日数
+func (s *Service) BusinessDays(from, to, timezone string, holidays []string) (*domain.BusinessDayResult, error) {
+	fromTime, err := s.resolveTime(from, timezone)
+	if err != nil {
+		return nil, err
+	}
+	toTime, err := s.resolveTime(to, timezone)
+	if err != nil {
+		return nil, err
+	}
+	return s.converter.BusinessDays(fromTime, toTime, timezone, holidays)
+}
+
+// AddBusinessDays 对时间加减工作日，input 为空时使用当前时间
+func (s *Service) AddBusinessDays(input string, days int, format, timezone string, holidays []string) (*domain.ArithmeticResult, error) {
+	t, err := s.resolveTime(input, timezone)
+	if err != nil {
+		return nil, err
+	}
+	return s.converter.AddBusinessDays(t, days, format, timezone, holidays)
+}
+
+// ParseDuration 解析并人性化展示 Go time.Duration 字符串
+func (s *Service) ParseDuration(input string) (*domain.DurationInfo, error) {
+	return s.converter.ParseDuration(input)
+}
+
+// TranslateLayout 在 Go 布局、strftime、Java 与 moment.js 格式之间转换，并用当前时间生成示例
+func (s *Service) TranslateLayout(pattern, syntax, timezone string) (*domain.LayoutTranslation, error) {
+	translation, err := s.translator.Translate(pattern, syntax)
+	if err != nil {
+		return nil, err
+	}
+	example, err := s.formatter.FormatNow(translation.Go, timezone)
+	if err != nil {
+		return nil, err
+	}
+	translation.Example = example
+	return translation, nil
+}
+
+// ListFormats 返回内置格式与已保存的自定义格式
+func (s *Service) ListFormats() []domain.FormatInfo {
+	return s.formats.ListFormats()
+}
+
+// SaveFormat 保存命名格式，pattern 可以是任意支持的语法
+func (s *Service) SaveFormat(name, pattern, syntax string) ([]domain.FormatInfo, error) {
+	if _, err := s.formats.Save(name, pattern, syntax); err != nil {
+		return nil, err
+	}
+	return s.formats.ListFormats(), nil
+}
+
+// DeleteFormat 删除命名格式
+func (s *Service) DeleteFormat(name string) ([]domain.FormatInfo, error) {
+	if err := s.formats.Delete(name); err != nil {
+		return nil, err
+	}
+	return s.formats.ListFormats(), nil
+}
+
+// BatchConvert 将文本中嵌入的时间戳或时间字符串原位转换，返回逐行对照与替换次数
+func (s *Service) BatchConvert(text string, opts domain.BatchOptions) (*domain.BatchResult, error) {
+	return s.batchConverter.Convert(text, opts)
+}
+
+// resolveTime 使用智能解析将输入转换为时刻，输入为空时返回当前时间
+func (s *Service) resolveTime(input, timezone string) (time.Time, error) {
+	if strings.TrimSpace(input) == "" {
+		return time.Now(), nil
+	}
+	return s.smartParser.ParseTime(input, timezone)
+}
+
 // ListTimestampHistory 获取历史记录
 func (s *Service) ListTimestampHistory() ([]domain.HistoryRecord, error) {
 	if s.historyInitErr != nil || s.historyStore == nil {
@@ -0,0 +1,643 @@
+package domain
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	// isoPeriodPattern ISO 8601 时长：P1Y2M3DT4H5M6.5S、P2W
+	isoPeriodPattern = regexp.MustCompile(`^P(?:(\d+)Y)?(?:(\d+)M)?(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+(?:[.,]\d+)?)S)?)?$`)
+	// periodTermPattern 简写或单词形式的时长项：3d、4h、1 month、2 weeks、3天
+	periodTermPattern = regexp.MustCompile(`([+-]?)\s*(\d+(?:\.\d+)?)\s*([a-zA-Zµ个\p{Han}]+)`)
+	// periodGapPattern 时长项之间允许出现的分隔内容
+	periodGapPattern = regexp.MustCompile(`^(?:\s|,|and)*$`)
+)
+
+// periodUnits 时长单位别名，M 表示月、m 表示分钟，其余不区分大小写
+var periodUnits = map[string]string{
+	"y": "y", "yr": "y", "yrs": "y", "year": "y", "years": "y", "年": "y",
+	"mo": "M", "mon": "M", "month": "M", "months": "M", "个月": "M", "月": "M",
+	"w": "w", "wk": "w", "wks": "w", "week": "w", "weeks": "w", "周": "w", "星期": "w",
+	"d": "d", "day": "d", "days": "d", "天": "d", "日": "d",
+	"h": "h", "hr": "h", "hrs": "h", "hour": "h", "hours": "h", "小时": "h",
+	"m": "m", "min": "m", "mins": "m", "minute": "m", "minutes": "m", "分钟": "m", "分": "m",
+	"s": "s", "sec": "s", "secs": "s", "second": "s", "seconds": "s", "秒": "s",
+	"ms": "ms", "millisecond": "ms", "milliseconds": "ms", "毫秒": "ms",
+	"us": "us", "µs": "us", "microsecond": "us", "microseconds": "us", "微秒": "us",
+	"ns": "ns", "nanosecond": "ns", "nanoseconds": "ns", "纳秒": "ns",
+}
+
+// fixedUnits 固定长度的单位，允许小数
+var fixedUnits = map[string]time.Duration{
+	"h": time.Hour, "m": time.Minute, "s": time.Second,
+	"ms": time.Millisecond, "us": time.Microsecond, "ns": time.Nanosecond,
+}
+
+// Period 日历时长，年、月、日按日历计算，Duration 为精确时长
+type Period struct {
+	Years    int           `json:"years"`
+	Months   int           `json:"months"`
+	Days     int           `json:"days"`
+	Duration time.Duration `json:"duration"`
+}
+
+// ParsePeriod 解析时长表达式，支持：
+//   - 简写：+3d4h、-1y2M、1h30m（M 表示月，m 表示分钟，mo 也表示月）
+//   - 单词：+1 month、2 weeks 3 days、3天4小时
+//   - ISO 8601：P1Y2M3DT4H、-P2W、PT1.5S
+//
+// 开头的符号作用于整个表达式，项内符号只作用于该项及其后省略符号的项
+func ParsePeriod(expr string) (Period, error) {
+	s := strings.TrimSpace(expr)
+	if s == "" {
+		return Period{}, errors.Wrap(ErrInvalidPeriod, "时长表达式不能为空")
+	}
+
+	sign := 1
+	if s[0] == '+' || s[0] == '-' {
+		if s[0] == '-' {
+			sign = -1
+		}
+		rest := strings.TrimSpace(s[1:])
+		// 只有 ISO 8601 形式需要剥离整体符号，简写形式的符号由各项自行处理
+		if strings.HasPrefix(strings.ToUpper(rest), "P") {
+			s = rest
+		} else {
+			sign = 1
+		}
+	}
+
+	var period Period
+	var err error
+	if strings.HasPrefix(strings.ToUpper(s), "P") {
+		period, err = parseISOPeriod(strings.ToUpper(s))
+	} else {
+		period, err = parsePeriodTerms(s)
+	}
+	if err != nil {
+		return Period{}, errors.Wrapf(err, "无效的时长表达式: %s", expr)
+	}
+	if sign < 0 {
+		period = period.Negate()
+	}
+	return period, nil
+}
+
+// parseISOPeriod 解析 ISO 8601 时长
+func parseISOPeriod(s string) (Period, error) {
+	m := isoPeriodPattern.FindStringSubmatch(s)
+	if m == nil || s == "P" || strings.HasSuffix(s, "T") {
+		return Period{}, ErrInvalidPeriod
+	}
+
+	// 各项与简写形式一样限制在 int32 范围内，避免溢出
+	var err error
+	atoi := func(v string) int {
+		if v == "" || err != nil {
+			return 0
+		}
+		n, convErr := strconv.Atoi(v)
+		if convErr != nil || n > math.MaxInt32 {
+			err = errors.Wrapf(ErrInvalidPeriod, "数值超出范围: %s", v)
+		}
+		return n
+	}
+	period := Period{
+		Years:  atoi(m[1]),
+		Months: atoi(m[2]),
+		Days:   atoi(m[3])*7 + atoi(m[4]),
+	}
+	hours, minutes := atoi(m[5]), atoi(m[6])
+	if err == nil && period.Days > math.MaxInt32 {
+		err = errors.Wrapf(ErrInvalidPeriod, "天数超出范围: %d", period.Days)
+	}
+	if err != nil {
+		return Period{}, err
+	}
+	seconds := 0.0
+	if m[7] != "" {
+		seconds, _ = strconv.ParseFloat(strings.Replace(m[7], ",", ".", 1), 64)
+	}
+	duration, ok := fixedDuration(float64(hours)*float64(time.Hour) + float64(minutes)*float64(time.Minute) + seconds*float64(time.Second))
+	if !ok {
+		return Period{}, ErrInvalidPeriod
+	}
+	period.Duration = duration
+	return period, nil
+}
+
+// parsePeriodTerms 解析简写或单词形式的时长项，省略符号的项沿用前一项的符号
+func parsePeriodTerms(s string) (Period, error) {
+	matches := periodTermPattern.FindAllStringSubmatchIndex(s, -1)
+	if len(matches) == 0 {
+		return Period{}, ErrInvalidPeriod
+	}
+
+	var period Period
+	sign := 1.0
+	last := 0
+	for _, m := range matches {
+		if !periodGapPattern.MatchString(strings.ToLower(s[last:m[0]])) {
+			return Period{}, ErrInvalidPeriod
+		}
+		last = m[1]
+
+		switch s[m[2]:m[3]] {
+		case "+":
+			sign = 1
+		case "-":
+			sign = -1
+		}
+		amount, err := strconv.ParseFloat(s[m[4]:m[5]], 64)
+		if err != nil {
+			return Period{}, ErrInvalidPeriod
+		}
+		word := s[m[6]:m[7]]
+		unit, ok := periodUnits[strings.ToLower(word)]
+		if word == "M" {
+			unit = "M"
+		}
+		if !ok {
+			return Period{}, errors.Wrapf(ErrInvalidPeriod, "未知的时间单位: %s", word)
+		}
+
+		if size, fixed := fixedUnits[unit]; fixed {
+			duration, ok := fixedDuration(float64(period.Duration) + sign*amount*float64(size))
+			if !ok {
+				return Period{}, errors.Wrap(ErrInvalidPeriod, "时长超出可表示范围")
+			}
+			period.Duration = duration
+			continue
+		}
+		if amount != math.Trunc(amount) || amount > math.MaxInt32 {
+			return Period{}, errors.Wrapf(ErrInvalidPeriod, "%s 必须为整数", word)
+		}
+		n := int(sign * amount)
+		switch unit {
+		case "y":
+			period.Years += n
+		case "M":
+			period.Months += n
+		case "w":
+			period.Days += 7 * n
+		case "d":
+			period.Days += n
+		}
+	}
+	if !periodGapPattern.MatchString(strings.ToLower(s[last:])) {
+		return Period{}, ErrInvalidPeriod
+	}
+	return period, nil
+}
+
+// fixedDuration 将纳秒数转换为 time.Duration，超出范围时返回 false
+func fixedDuration(nanoseconds float64) (time.Duration, bool) {
+	if math.Abs(nanoseconds) >= math.MaxInt64 {
+		return 0, false
+	}
+	return time.Duration(math.Round(nanoseconds)), true
+}
+
+// Negate 返回相反方向的时长
+func (p Period) Negate() Period {
+	return Period{Years: -p.Years, Months: -p.Months, Days: -p.Days, Duration: -p.Duration}
+}
+
+// IsZero 判断时长是否为零
+func (p Period) IsZero() bool {
+	return p == Period{}
+}
+
+// AddTo 将时长加到时间上：先按日历加年月（月末对齐，1 月 31 日加 1 个月为 2 月末），
+// 再按本地日期加天数（跨夏令时保持钟面时间），最后加精确时长
+func (p Period) AddTo(t time.Time) time.Time {
+	t = addMonths(t, p.Years*12+p.Months)
+	t = t.AddDate(0, 0, p.Days)
+	return t.Add(p.Duration)
+}
+
+// String 返回 ISO 8601 表示，如 P1Y2M3DT4H5M6S；全部为负时使用 -P 前缀
+func (p Period) String() string {
+	if p.IsZero() {
+		return "PT0S"
+	}
+	prefix := "P"
+	if p.Years <= 0 && p.Months <= 0 && p.Days <= 0 && p.Duration <= 0 {
+		prefix = "-P"
+		p = p.Negate()
+	}
+
+	var b strings.Builder
+	b.WriteString(prefix)
+	for _, part := range []struct {
+		value int
+		unit  string
+	}{{p.Years, "Y"}, {p.Months, "M"}, {p.Days, "D"}} {
+		if part.value != 0 {
+			fmt.Fprintf(&b, "%d%s", part.value, part.unit)
+		}
+	}
+	if p.Duration != 0 {
+		b.WriteString("T")
+		hours := p.Duration / time.Hour
+		minutes := p.Duration % time.Hour / time.Minute
+		rest := p.Duration % time.Minute
+		if hours != 0 {
+			fmt.Fprintf(&b, "%dH", hours)
+		}
+		if minutes != 0 {
+			fmt.Fprintf(&b, "%dM", minutes)
+		}
+		if rest != 0 {
+			b.WriteString(strconv.FormatFloat(rest.Seconds(), 'f', -1, 64) + "S")
+		}
+	}
+	return b.String()
+}
+
+// addMonths 按日历增加月份，目标月份天数不足时取月末
+func addMonths(t time.Time, months int) time.Time {
+	if months == 0 {
+		return t
+	}
+	year, month, day := t.Date()
+	hour, minute, second := t.Clock()
+	first := time.Date(year, month+time.Month(months), 1, 0, 0, 0, 0, t.Location())
+	if last := daysInMonth(first.Year(), first.Month()); day > last {
+		day = last
+	}
+	return time.Date(first.Year(), first.Month(), day, hour, minute, second, t.Nanosecond(), t.Location())
+}
+
+// daysInMonth 返回指定月份的天数
+func daysInMonth(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// ArithmeticResult 日期加减结果
+type ArithmeticResult struct {
+	Expression string `json:"expression"`
+	// Period 规范化后的 ISO 8601 时长
+	Period string               `json:"period"`
+	Start  *TimestampConversion `json:"start"`
+	Result *TimestampConversion `json:"result"`
+	// Weekday 结果在所选时区的星期
+	Weekday string `json:"weekday"`
+}
+
+// AddPeriod 在所选时区内对时间加减时长表达式（年月日按日历计算）
+func (c *Converter) AddPeriod(t time.Time, expr, format, timezone string) (*ArithmeticResult, error) {
+	loc, err := LoadLocation(timezone)
+	if err != nil {
+		return nil, err
+	}
+	period, err := ParsePeriod(expr)
+	if err != nil {
+		return nil, err
+	}
+	return c.arithmeticResult(t, period.AddTo(t.In(loc)), expr, period.String(), format, timezone)
+}
+
+// arithmeticResult 构建日期加减结果
+func (c *Converter) arithmeticResult(start, result time.Time, expr, period, format, timezone string) (*ArithmeticResult, error) {
+	startConversion, err := c.buildConversion(start, precisionUnit(start), format, timezone)
+	if err != nil {
+		return nil, err
+	}
+	resultConversion, err := c.buildConversion(result, precisionUnit(result), format, timezone)
+	if err != nil {
+		return nil, err
+	}
+	return &ArithmeticResult{
+		Expression: expr,
+		Period:     period,
+		Start:      startConversion,
+		Result:     resultConversion,
+		Weekday:    result.Weekday().String(),
+	}, nil
+}
+
+// TimeDifference 两个时刻之间的差值
+type TimeDifference struct {
+	From *TimestampConversion `json:"from"`
+	To   *TimestampConversion `json:"to"`
+	// Negative 结束时间早于开始时间
+	Negative bool `json:"negative"`
+	// 日历差值（在所选时区内按年、月、日、时、分、秒拆分）
+	Years       int `json:"years"`
+	Months      int `json:"months"`
+	Days        int `json:"days"`
+	Hours       int `json:"hours"`
+	Minutes     int `json:"minutes"`
+	Seconds     int `json:"seconds"`
+	Nanoseconds int `json:"nanoseconds"`
+	// Period 日历差值的 ISO 8601 表示
+	Period string `json:"period"`
+	// Duration Go time.Duration 表示，超过约 292 年时饱和
+	Duration  string `json:"duration"`
+	Humanized string `json:"humanized"`
+	// CalendarDays 两个本地日期之间相差的天数
+	CalendarDays      int     `json:"calendarDays"`
+	TotalWeeks        float64 `json:"totalWeeks"`
+	TotalDays         float64 `json:"totalDays"`
+	TotalHours        float64 `json:"totalHours"`
+	TotalMinutes      float64 `json:"totalMinutes"`
+	TotalSeconds      float64 `json:"totalSeconds"`
+	TotalMilliseconds int64   `json:"totalMilliseconds"`
+}
+
+// Difference 计算两个时刻的差值，日历差值按所选时区的本地日期计算
+func (c *Converter) Difference(from, to time.Time, format, timezone string) (*TimeDifference, error) {
+	loc, err := LoadLocation(timezone)
+	if err != nil {
+		return nil, err
+	}
+	fromConversion, err := c.buildConversion(from, precisionUnit(from), format, timezone)
+	if err != nil {
+		return nil, err
+	}
+	toConversion, err := c.buildConversion(to, precisionUnit(to), format, timezone)
+	if err != nil {
+		return nil, err
+	}
+
+	start, end := from.In(loc), to.In(loc)
+	result := &TimeDifference{From: fromConversion, To: toConversion}
+	if end.Before(start) {
+		start, end = end, start
+		result.Negative = true
+	}
+
+	period := calendarDiff(start, end)
+	rest := period.Duration
+	result.Years, result.Months, result.Days = period.Months/12, period.Months%12, period.Days
+	result.Hours = int(rest / time.Hour)
+	result.Minutes = int(rest % time.Hour / time.Minute)
+	result.Seconds = int(rest % time.Minute / time.Second)
+	result.Nanoseconds = int(rest % time.Second)
+	period = Period{Years: result.Years, Months: result.Months, Days: result.Days, Duration: rest}
+	if result.Negative {
+		period = period.Negate()
+	}
+	result.Period = period.String()
+
+	d := to.Sub(from)
+	result.Duration = d.String()
+	result.Humanized = HumanizeDuration(d)
+	result.CalendarDays = dayDiff(from.In(loc), to.In(loc))
+	result.TotalWeeks = d.Hours() / 24 / 7
+	result.TotalDays = d.Hours() / 24
+	result.TotalHours = d.Hours()
+	result.TotalMinutes = d.Minutes()
+	result.TotalSeconds = d.Seconds()
+	result.TotalMilliseconds = d.Milliseconds()
+	return result, nil
+}
+
+// calendarDiff 计算 start 到 end（start 不晚于 end）的日历差值，Months 含整年
+func calendarDiff(start, end time.Time) Period {
+	months := (end.Year()-start.Year())*12 + int(end.Month()-start.Month())
+	anchor := addMonths(start, months)
+	for months > 0 && anchor.After(end) {
+		months--
+		anchor = addMonths(start, months)
+	}
+
+	days := int(end.Sub(anchor) / (24 * time.Hour))
+	for days > 0 && anchor.AddDate(0, 0, days).After(end) {
+		days--
+	}
+	for !anchor.AddDate(0, 0, days+1).After(end) {
+		days++
+	}
+	return Period{Months: months, Days: days, Duration: end.Sub(anchor.AddDate(0, 0, days))}
+}
+
+// BusinessDayResult 工作日统计结果
+type BusinessDayResult struct {
+	StartDate string `json:"startDate"`
+	EndDate   string `json:"endDate"`
+	// CalendarDays 区间内的自然日数（含首尾）
+	CalendarDays int `json:"calendarDays"`
+	BusinessDays int `json:"businessDays"`
+	WeekendDays  int `json:"weekendDays"`
+	// Holidays 区间内落在工作日、因而被排除的节假日
+	Holidays []string `json:"holidays"`
+}
+
+// BusinessDays 统计两个时刻在所选时区的本地日期之间（含首尾）的工作日数
+// 周六、周日与 holidays 中的日期（2006-01-02）不计入，开始晚于结束时自动交换
+func (c *Converter) BusinessDays(from, to time.Time, timezone string, holidays []string) (*BusinessDayResult, error) {
+	loc, err := LoadLocation(timezone)
+	if err != nil {
+		return nil, err
+	}
+	holidaySet, err := parseHolidays(holidays)
+	if err != nil {
+		return nil, err
+	}
+
+	start, end := localDate(from.In(loc)), localDate(to.In(loc))
+	if end.Before(start) {
+		start, end = end, start
+	}
+
+	result := &BusinessDayResult{
+		StartDate: start.Format(time.DateOnly),
+		EndDate:   end.Format(time.DateOnly),
+		Holidays:  []string{},
+	}
+	for day := start; !day.After(end); day = day.AddDate(0, 0, 1) {
+		result.CalendarDays++
+		switch {
+		case isWeekend(day):
+			result.WeekendDays++
+		case holidaySet[day.Format(time.DateOnly)]:
+			result.Holidays = append(result.Holidays, day.Format(time.DateOnly))
+		default:
+			result.BusinessDays++
+		}
+	}
+	return result, nil
+}
+
+// AddBusinessDays 在所选时区内加减 days 个工作日，跳过周末与节假日，保留钟面时间
+func (c *Converter) AddBusinessDays(t time.Time, days int, format, timezone string, holidays []string) (*ArithmeticResult, error) {
+	loc, err := LoadLocation(timezone)
+	if err != nil {
+		return nil, err
+	}
+	holidaySet, err := parseHolidays(holidays)
+	if err != nil {
+		return nil, err
+	}
+
+	step := 1
+	if days < 0 {
+		step = -1
+	}
+	result := t.In(loc)
+	for remaining := days * step; remaining > 0; {
+		result = result.AddDate(0, 0, step)
+		if !isWeekend(result) && !holidaySet[result.Format(time.DateOnly)] {
+			remaining--
+		}
+	}
+	return c.arithmeticResult(t, result, fmt.Sprintf("%+d 个工作日", days), Period{Days: dayDiff(t.In(loc), result)}.String(), format, timezone)
+}
+
+// parseHolidays 解析节假日列表，每项可包含多个以逗号或空白分隔的日期
+func parseHolidays(holidays []string) (map[string]bool, error) {
+	set := make(map[string]bool)
+	for _, item := range holidays {
+		for _, value := range strings.FieldsFunc(item, func(r rune) bool {
+			return r == ',' || r == '，' || r == ';' || r == ' ' || r == '\t' || r == '\n' || r == '\r'
+		}) {
+			day, err := time.Parse(time.DateOnly, strings.ReplaceAll(value, "/", "-"))
+			if err != nil {
+				return nil, errors.Wrapf(ErrInvalidHoliday, "无效的节假日: %s（格式应为 2006-01-02）", value)
+			}
+			set[day.Format(time.DateOnly)] = true
+		}
+	}
+	return set, nil
+}
+
+// localDate 返回本地日期零点（UTC 表示，便于按天迭代不受夏令时影响）
+func localDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// isWeekend 判断是否为周六或周日
+func isWeekend(t time.Time) bool {
+	return t.Weekday() == time.Saturday || t.Weekday() == time.Sunday
+}
+
+// DurationInfo Go time.Duration 解析结果
+type DurationInfo struct {
+	Input string `json:"input"`
+	// Duration Go 规范表示，如 1h30m0s
+	Duration    string  `json:"duration"`
+	Nanoseconds int64   `json:"nanoseconds"`
+	Millis      float64 `json:"milliseconds"`
+	Seconds     float64 `json:"seconds"`
+	Minutes     float64 `json:"minutes"`
+	Hours       float64 `json:"hours"`
+	// ISO ISO 8601 表示（天数按 24 小时折算）
+	ISO         string `json:"iso"`
+	Humanized   string `json:"humanized"`
+	HumanizedEn string `json:"humanizedEn"`
+}
+
+// ParseDuration 解析 Go time.Duration 字符串（1h30m、1.5s、-250ms）
+// 纯数字按纳秒解释（Duration 的整数形式），也接受不含年月的时长表达式（3d4h、P2DT3H）
+func (c *Converter) ParseDuration(input string) (*DurationInfo, error) {
+	s := strings.TrimSpace(input)
+	if s == "" {
+		return nil, errors.Wrap(ErrInvalidDuration, "时长不能为空")
+	}
+
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		if n, convErr := strconv.ParseInt(s, 10, 64); convErr == nil {
+			d, err = time.Duration(n), nil
+		}
+	}
+	if err != nil {
+		period, periodErr := ParsePeriod(s)
+		if periodErr != nil || period.Years != 0 || period.Months != 0 {
+			return nil, errors.Wrapf(ErrInvalidDuration, "无效的时长: %s", input)
+		}
+		total, ok := fixedDuration(float64(period.Days)*float64(24*time.Hour) + float64(period.Duration))
+		if !ok {
+			return nil, errors.Wrapf(ErrInvalidDuration, "时长超出可表示范围: %s", input)
+		}
+		d = total
+	}
+
+	return &DurationInfo{
+		Input:       input,
+		Duration:    d.String(),
+		Nanoseconds: d.Nanoseconds(),
+		Millis:      float64(d) / float64(time.Millisecond),
+		Seconds:     d.Seconds(),
+		Minutes:     d.Minutes(),
+		Hours:       d.Hours(),
+		ISO:         durationPeriod(d).String(),
+		Humanized:   HumanizeDuration(d),
+		HumanizedEn: HumanizeDurationEn(d),
+	}, nil
+}
+
+// durationPeriod 将精确时长按 24 小时一天拆分为天数与剩余时长
+func durationPeriod(d time.Duration) Period {
+	day := 24 * time.Hour
+	return Period{Days: int(d / day), Duration: d % day}
+}
+
+// durationParts 将时长拆分为天、小时、分钟、秒、毫秒、微秒、纳秒
+func durationParts(d time.Duration) (bool, []int64) {
+	negative := d < 0
+	// 取绝对值时避免 math.MinInt64 溢出
+	abs := uint64(d)
+	if negative {
+		abs = uint64(-(d + 1)) + 1
+	}
+	units := []uint64{uint64(24 * time.Hour), uint64(time.Hour), uint64(time.Minute), uint64(time.Second), uint64(time.Millisecond), uint64(time.Microsecond), 1}
+	parts := make([]int64, len(units))
+	for i, unit := range units {
+		parts[i] = int64(abs / unit)
+		abs %= unit
+	}
+	return negative, parts
+}
+
+// HumanizeDuration 将时长转换为中文可读形式，如 1天2小时3分钟
+func HumanizeDuration(d time.Duration) string {
+	if d == 0 {
+		return "0秒"
+	}
+	negative, parts := durationParts(d)
+	names := []string{"天", "小时", "分钟", "秒", "毫秒", "微秒", "纳秒"}
+	var b strings.Builder
+	if negative {
+		b.WriteString("-")
+	}
+	for i, n := range parts {
+		if n != 0 {
+			b.WriteString(strconv.FormatInt(n, 10) + names[i])
+		}
+	}
+	return b.String()
+}
+
+// HumanizeDurationEn 将时长转换为英文可读形式，如 1 day 2 hours 3 minutes
+func HumanizeDurationEn(d time.Duration) string {
+	if d == 0 {
+		return "0 seconds"
+	}
+	negative, parts := durationParts(d)
+	names := []string{"day", "hour", "minute", "second", "millisecond", "microsecond", "nanosecond"}
+	words := make([]string, 0, len(parts)+1)
+	if negative {
+		words = append(words, "minus")
+	}
+	for i, n := range parts {
+		if n == 0 {
+			continue
+		}
+		name := names[i]
+		if n != 1 {
+			name += "s"
+		}
+		words = append(words, fmt.Sprintf("%d %s", n, name))
+	}
+	return strings.Join(words, " ")
+}
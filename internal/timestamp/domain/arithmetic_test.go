@@ -0,0 +1,224 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+func TestParsePeriod(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    Period
+		wantISO string
+	}{
+		{name: "简写", input: "+3d4h", want: Period{Days: 3, Duration: 4 * time.Hour}, wantISO: "P3DT4H"},
+		{name: "月与分钟区分大小写", input: "1M30m", want: Period{Months: 1, Duration: 30 * time.Minute}, wantISO: "P1MT30M"},
+		{name: "单词", input: "+1 month", want: Period{Months: 1}, wantISO: "P1M"},
+		{name: "多个单词项", input: "2 weeks and 3 days", want: Period{Days: 17}, wantISO: "P17D"},
+		{name: "负号沿用", input: "-1y2mo", want: Period{Years: -1, Months: -2}, wantISO: "-P1Y2M"},
+		{name: "混合符号", input: "+1d-2h", want: Period{Days: 1, Duration: -2 * time.Hour}, wantISO: "P1DT-2H"},
+		{name: "中文", input: "3天4小时", want: Period{Days: 3, Duration: 4 * time.Hour}, wantISO: "P3DT4H"},
+		{name: "ISO 8601", input: "P1Y2M3DT4H", want: Period{Years: 1, Months: 2, Days: 3, Duration: 4 * time.Hour}, wantISO: "P1Y2M3DT4H"},
+		{name: "ISO 8601 负数与周", input: "-P2W", want: Period{Days: -14}, wantISO: "-P14D"},
+		{name: "ISO 8601 小数秒", input: "PT1.5S", want: Period{Duration: 1500 * time.Millisecond}, wantISO: "PT1.5S"},
+		{name: "小数小时", input: "1.5h", want: Period{Duration: 90 * time.Minute}, wantISO: "PT1H30M"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParsePeriod(tt.input)
+			if err != nil {
+				t.Fatalf("ParsePeriod(%q) error = %v", tt.input, err)
+			}
+			if got != tt.want {
+				t.Errorf("ParsePeriod(%q) = %+v, want %+v", tt.input, got, tt.want)
+			}
+			if got.String() != tt.wantISO {
+				t.Errorf("ParsePeriod(%q).String() = %q, want %q", tt.input, got.String(), tt.wantISO)
+			}
+		})
+	}
+
+	for _, input := range []string{"", "P", "PT", "3 fortnights", "1.5 days", "abc", "3d foo", "P99999999999999999999Y", "P3000000000D", "P400000000W", "PT99999999999999999999H"} {
+		if _, err := ParsePeriod(input); !errors.Is(err, ErrInvalidPeriod) {
+			t.Errorf("ParsePeriod(%q) error = %v, want ErrInvalidPeriod", input, err)
+		}
+	}
+}
+
+func TestAddPeriod(t *testing.T) {
+	converter := NewConverter(nil)
+	tests := []struct {
+		name     string
+		start    time.Time
+		expr     string
+		timezone string
+		want     string
+	}{
+		{
+			name:     "月末对齐",
+			start:    time.Date(2024, 1, 31, 10, 0, 0, 0, time.UTC),
+			expr:     "+1 month",
+			timezone: "UTC",
+			want:     "2024-02-29 10:00:00",
+		},
+		{
+			name:     "闰日加一年",
+			start:    time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC),
+			expr:     "P1Y",
+			timezone: "UTC",
+			want:     "2025-02-28 00:00:00",
+		},
+		{
+			// 柏林 2024-03-31 进入夏令时，加一天保持钟面时间，加 24 小时则多走一小时
+			name:     "跨夏令时加天",
+			start:    time.Date(2024, 3, 30, 12, 0, 0, 0, mustLocation(t, "Europe/Berlin")),
+			expr:     "+1d",
+			timezone: "Europe/Berlin",
+			want:     "2024-03-31 12:00:00",
+		},
+		{
+			name:     "跨夏令时加小时",
+			start:    time.Date(2024, 3, 30, 12, 0, 0, 0, mustLocation(t, "Europe/Berlin")),
+			expr:     "+24h",
+			timezone: "Europe/Berlin",
+			want:     "2024-03-31 13:00:00",
+		},
+		{
+			name:     "减去组合时长",
+			start:    time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+			expr:     "-1d2h",
+			timezone: "Asia/Shanghai",
+			want:     "2024-02-29 06:00:00",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := converter.AddPeriod(tt.start, tt.expr, "DateTime", tt.timezone)
+			if err != nil {
+				t.Fatalf("AddPeriod() error = %v", err)
+			}
+			if result.Result.Time != tt.want {
+				t.Errorf("AddPeriod(%q) = %q, want %q", tt.expr, result.Result.Time, tt.want)
+			}
+		})
+	}
+}
+
+func TestDifference(t *testing.T) {
+	converter := NewConverter(nil)
+	from := time.Date(2024, 1, 31, 8, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 3, 2, 10, 30, 15, 0, time.UTC)
+
+	diff, err := converter.Difference(from, to, "DateTime", "UTC")
+	if err != nil {
+		t.Fatalf("Difference() error = %v", err)
+	}
+	if diff.Years != 1 || diff.Months != 1 || diff.Days != 2 || diff.Hours != 2 || diff.Minutes != 30 || diff.Seconds != 15 {
+		t.Errorf("Difference() calendar = %dy %dm %dd %dh %dm %ds", diff.Years, diff.Months, diff.Days, diff.Hours, diff.Minutes, diff.Seconds)
+	}
+	if diff.Period != "P1Y1M2DT2H30M15S" {
+		t.Errorf("Difference() period = %q", diff.Period)
+	}
+	if diff.CalendarDays != 396 || diff.Negative {
+		t.Errorf("Difference() calendarDays = %d, negative = %v", diff.CalendarDays, diff.Negative)
+	}
+
+	reversed, err := converter.Difference(to, from, "DateTime", "UTC")
+	if err != nil {
+		t.Fatalf("Difference() error = %v", err)
+	}
+	if !reversed.Negative || reversed.Period != "-P1Y1M2DT2H30M15S" || reversed.TotalSeconds != -diff.TotalSeconds {
+		t.Errorf("Difference() reversed = %+v", reversed)
+	}
+
+	// 跨夏令时的一天在日历上是 1 天，但只有 23 小时
+	berlin := mustLocation(t, "Europe/Berlin")
+	diff, err = converter.Difference(time.Date(2024, 3, 30, 12, 0, 0, 0, berlin), time.Date(2024, 3, 31, 12, 0, 0, 0, berlin), "DateTime", "Europe/Berlin")
+	if err != nil {
+		t.Fatalf("Difference() error = %v", err)
+	}
+	if diff.Days != 1 || diff.Hours != 0 || diff.TotalHours != 23 {
+		t.Errorf("Difference() across DST = %d days %d hours, total %v hours", diff.Days, diff.Hours, diff.TotalHours)
+	}
+}
+
+func TestBusinessDays(t *testing.T) {
+	converter := NewConverter(nil)
+	// 2024-09-30（周一）至 2024-10-11（周五），国庆 10 月 1-7 日
+	from := time.Date(2024, 9, 30, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 10, 11, 0, 0, 0, 0, time.UTC)
+	holidays := []string{"2024-10-01, 2024-10-02 2024-10-03", "2024/10/04", "2024-10-07"}
+
+	result, err := converter.BusinessDays(to, from, "UTC", holidays)
+	if err != nil {
+		t.Fatalf("BusinessDays() error = %v", err)
+	}
+	if result.CalendarDays != 12 || result.WeekendDays != 2 || result.BusinessDays != 5 || len(result.Holidays) != 5 {
+		t.Errorf("BusinessDays() = %+v", result)
+	}
+
+	added, err := converter.AddBusinessDays(from, 2, "DateTime", "UTC", holidays)
+	if err != nil {
+		t.Fatalf("AddBusinessDays() error = %v", err)
+	}
+	if added.Result.Time != "2024-10-09 00:00:00" {
+		t.Errorf("AddBusinessDays(+2) = %q, want 2024-10-09 00:00:00", added.Result.Time)
+	}
+
+	added, err = converter.AddBusinessDays(time.Date(2024, 10, 8, 9, 0, 0, 0, time.UTC), -1, "DateTime", "UTC", holidays)
+	if err != nil {
+		t.Fatalf("AddBusinessDays() error = %v", err)
+	}
+	if added.Result.Time != "2024-09-30 09:00:00" {
+		t.Errorf("AddBusinessDays(-1) = %q, want 2024-09-30 09:00:00", added.Result.Time)
+	}
+
+	if _, err := converter.BusinessDays(from, to, "UTC", []string{"10-01"}); !errors.Is(err, ErrInvalidHoliday) {
+		t.Errorf("BusinessDays() error = %v, want ErrInvalidHoliday", err)
+	}
+}
+
+func TestParseDuration(t *testing.T) {
+	converter := NewConverter(nil)
+	tests := []struct {
+		input       string
+		duration    string
+		humanized   string
+		humanizedEn string
+	}{
+		{input: "1h30m", duration: "1h30m0s", humanized: "1小时30分钟", humanizedEn: "1 hour 30 minutes"},
+		{input: "-250ms", duration: "-250ms", humanized: "-250毫秒", humanizedEn: "minus 250 milliseconds"},
+		{input: "1500000000", duration: "1.5s", humanized: "1秒500毫秒", humanizedEn: "1 second 500 milliseconds"},
+		{input: "3d4h", duration: "76h0m0s", humanized: "3天4小时", humanizedEn: "3 days 4 hours"},
+		{input: "0s", duration: "0s", humanized: "0秒", humanizedEn: "0 seconds"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			info, err := converter.ParseDuration(tt.input)
+			if err != nil {
+				t.Fatalf("ParseDuration(%q) error = %v", tt.input, err)
+			}
+			if info.Duration != tt.duration || info.Humanized != tt.humanized || info.HumanizedEn != tt.humanizedEn {
+				t.Errorf("ParseDuration(%q) = %+v", tt.input, info)
+			}
+		})
+	}
+
+	for _, input := range []string{"", "1 month", "soon"} {
+		if _, err := converter.ParseDuration(input); !errors.Is(err, ErrInvalidDuration) {
+			t.Errorf("ParseDuration(%q) error = %v, want ErrInvalidDuration", input, err)
+		}
+	}
+}
+
+func mustLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Fatalf("LoadLocation(%q) error = %v", name, err)
+	}
+	return loc
+}
@@ -0,0 +1,223 @@
+package domain
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+)
+
+// 批量转换方向
+const (
+	// BatchToTime 将文本中的时间戳替换为时间字符串
+	BatchToTime = "to-time"
+	// BatchToEpoch 将文本中的时间字符串替换为时间戳
+	BatchToEpoch = "to-epoch"
+)
+
+const (
+	// MaxBatchInputBytes 批量转换的最大输入长度
+	MaxBatchInputBytes = 2 << 20
+	// batchMinYear、batchMaxYear 识别嵌入时间戳时的年份范围，避免把 ID、端口等数字当作时间戳
+	batchMinYear = 1990
+	batchMaxYear = 2100
+)
+
+var (
+	// embeddedEpochPattern 文本中的候选时间戳：10 位秒（可带小数）、13 位毫秒、16 位微秒、19 位纳秒
+	embeddedEpochPattern = regexp.MustCompile(`\b(?:\d{19}|\d{16}|\d{13}|\d{10}(?:\.\d{1,9})?)\b`)
+	// embeddedTimePattern 文本中的候选时间字符串
+	embeddedTimePattern = regexp.MustCompile(strings.Join([]string{
+		// ISO 8601 / RFC 3339 / SQL DATETIME
+		`\b\d{4}-\d{2}-\d{2}(?:[T ]\d{2}:\d{2}(?::\d{2}(?:[.,]\d{1,9})?)?(?:Z|[+-]\d{2}:?\d{2}\b)?)?`,
+		// 斜杠日期
+		`\b\d{4}/\d{2}/\d{2}(?: \d{2}:\d{2}(?::\d{2}(?:\.\d{1,9})?)?)?`,
+		// Apache/nginx 访问日志
+		`\b\d{2}/[A-Z][a-z]{2}/\d{4}:\d{2}:\d{2}:\d{2} [+-]\d{4}`,
+		// RFC 1123 / RFC 2822
+		`\b(?:Mon|Tue|Wed|Thu|Fri|Sat|Sun), \d{1,2} [A-Z][a-z]{2} \d{4} \d{2}:\d{2}:\d{2} (?:[+-]\d{4}|[A-Z]{2,5})`,
+		// 中文日期
+		`\d{4}年\d{1,2}月\d{1,2}日(?: ?\d{1,2}:\d{2}(?::\d{2})?)?`,
+	}, "|"))
+)
+
+// BatchOptions 批量转换选项
+type BatchOptions struct {
+	// Direction 转换方向：to-time、to-epoch
+	Direction string `json:"direction"`
+	// Format to-time 时的输出格式
+	Format string `json:"format"`
+	// Timezone 输出时区，同时用于解释不含时区的时间字符串
+	Timezone string `json:"timezone"`
+	// Unit to-time 时为输入单位（auto 按位数识别），to-epoch 时为输出单位（auto 按精度选择）
+	Unit string `json:"unit"`
+}
+
+// BatchReplacement 一处替换
+type BatchReplacement struct {
+	// Line 行号（从 1 开始）
+	Line int `json:"line"`
+	// Column 列号（从 1 开始，按字符计）
+	Column      int    `json:"column"`
+	Original    string `json:"original"`
+	Replacement string `json:"replacement"`
+	Unit        string `json:"unit"`
+}
+
+// BatchLine 对照预览中的一行
+type BatchLine struct {
+	Number    int    `json:"number"`
+	Original  string `json:"original"`
+	Converted string `json:"converted"`
+	Changed   bool   `json:"changed"`
+}
+
+// BatchResult 批量转换结果
+type BatchResult struct {
+	Output       string             `json:"output"`
+	Lines        []BatchLine        `json:"lines"`
+	Replacements []BatchReplacement `json:"replacements"`
+	// Count 替换次数
+	Count int `json:"count"`
+	// Skipped 形似时间但无法解析或超出年份范围而未替换的片段数
+	Skipped int `json:"skipped"`
+}
+
+// BatchConverter 将文本（日志、CSV 列等）中嵌入的时间戳或时间字符串原位替换
+type BatchConverter struct {
+	converter *Converter
+	parser    *SmartParser
+}
+
+// NewBatchConverter 创建新的 BatchConverter 实例，formats 用于解析已保存的格式名称，可为 nil
+func NewBatchConverter(formats *FormatStore) *BatchConverter {
+	return &BatchConverter{
+		converter: NewConverter(formats),
+		parser:    NewSmartParser(formats),
+	}
+}
+
+// Convert 逐行查找并替换文本中的时间戳或时间字符串，返回替换后的文本与逐行对照
+func (b *BatchConverter) Convert(text string, opts BatchOptions) (*BatchResult, error) {
+	if strings.TrimSpace(text) == "" {
+		return nil, errors.WithStack(ErrEmptyTimeInput)
+	}
+	if len(text) > MaxBatchInputBytes {
+		return nil, errors.Wrapf(ErrBatchInputTooLarge, "输入不能超过 %d MB", MaxBatchInputBytes>>20)
+	}
+	loc, err := LoadLocation(opts.Timezone)
+	if err != nil {
+		return nil, err
+	}
+	if opts.Unit != "" && opts.Unit != UnitAuto {
+		if _, ok := unitNanos[opts.Unit]; !ok {
+			return nil, errors.Wrapf(ErrUnsupportedUnit, "不支持的时间戳单位: %s", opts.Unit)
+		}
+	}
+
+	var pattern *regexp.Regexp
+	var replace func(match string) (string, string, bool)
+	switch opts.Direction {
+	case BatchToTime, "":
+		pattern = embeddedEpochPattern
+		replace = func(match string) (string, string, bool) {
+			return b.epochToTime(match, opts)
+		}
+	case BatchToEpoch:
+		pattern = embeddedTimePattern
+		replace = func(match string) (string, string, bool) {
+			return b.timeToEpoch(match, opts.Unit, loc)
+		}
+	default:
+		return nil, errors.Wrapf(ErrInvalidBatchDirection, "不支持的转换方向: %s", opts.Direction)
+	}
+
+	result := &BatchResult{Replacements: []BatchReplacement{}}
+	lines := strings.Split(text, "\n")
+	converted := make([]string, len(lines))
+	result.Lines = make([]BatchLine, len(lines))
+	for i, line := range lines {
+		var out strings.Builder
+		last := 0
+		for _, span := range pattern.FindAllStringIndex(line, -1) {
+			match := line[span[0]:span[1]]
+			replacement, unit, ok := replace(match)
+			if !ok {
+				result.Skipped++
+				continue
+			}
+			out.WriteString(line[last:span[0]])
+			out.WriteString(replacement)
+			last = span[1]
+			result.Replacements = append(result.Replacements, BatchReplacement{
+				Line:        i + 1,
+				Column:      utf8.RuneCountInString(line[:span[0]]) + 1,
+				Original:    match,
+				Replacement: replacement,
+				Unit:        unit,
+			})
+		}
+		out.WriteString(line[last:])
+
+		converted[i] = out.String()
+		result.Lines[i] = BatchLine{Number: i + 1, Original: line, Converted: converted[i], Changed: converted[i] != line}
+	}
+
+	result.Output = strings.Join(converted, "\n")
+	result.Count = len(result.Replacements)
+	return result, nil
+}
+
+// epochToTime 将候选时间戳转换为时间字符串，超出年份范围时不替换
+func (b *BatchConverter) epochToTime(match string, opts BatchOptions) (string, string, bool) {
+	t, unit, err := b.converter.ParseTimestamp(match, opts.Unit)
+	if err != nil || !inBatchRange(t) {
+		return "", "", false
+	}
+	conversion, err := b.converter.buildConversion(t, unit, opts.Format, opts.Timezone)
+	if err != nil {
+		return "", "", false
+	}
+	return conversion.Time, unit, true
+}
+
+// timeToEpoch 将候选时间字符串转换为时间戳，unit 为 auto 时按小数秒精度选择单位
+func (b *BatchConverter) timeToEpoch(match, unit string, loc *time.Location) (string, string, bool) {
+	// 日志中常见的 15:04:05,123 小数秒，以及 2024-01-02 15:04:05+0800 这类空格分隔且带无冒号偏移的写法
+	fraction := strings.Replace(match, ",", ".", 1)
+	var t time.Time
+	var err error
+	for _, candidate := range []string{match, fraction, strings.Replace(fraction, " ", "T", 1)} {
+		if t, _, _, err = b.parser.parse(candidate, loc); err == nil {
+			break
+		}
+	}
+	if err != nil || !inBatchRange(t) {
+		return "", "", false
+	}
+
+	if unit == "" || unit == UnitAuto {
+		unit = precisionUnit(t)
+	}
+	var value int64
+	switch unit {
+	case UnitSeconds:
+		value = t.Unix()
+	case UnitMilliseconds:
+		value = t.UnixMilli()
+	case UnitMicroseconds:
+		value = t.UnixMicro()
+	default:
+		value = t.UnixNano()
+	}
+	return strconv.FormatInt(value, 10), unit, true
+}
+
+// inBatchRange 判断时间是否在批量识别的年份范围内
+func inBatchRange(t time.Time) bool {
+	year := t.UTC().Year()
+	return year >= batchMinYear && year <= batchMaxYear
+}
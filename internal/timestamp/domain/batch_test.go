@@ -0,0 +1,88 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestBatchConverterToTime(t *testing.T) {
+	converter := NewBatchConverter(nil)
+	text := strings.Join([]string{
+		"ts=1700000000 user=42 port=8080",
+		"ms:1700000000123,id:12345678901234567890",
+		"request 9999999999999 done",
+		"no timestamps here",
+	}, "\n")
+
+	got, err := converter.Convert(text, BatchOptions{Direction: BatchToTime, Format: "DateTime", Timezone: "UTC"})
+	if err != nil {
+		t.Fatalf("Convert error = %v", err)
+	}
+	wantOutput := strings.Join([]string{
+		"ts=2023-11-14 22:13:20 user=42 port=8080",
+		"ms:2023-11-14 22:13:20.123,id:12345678901234567890",
+		"request 9999999999999 done",
+		"no timestamps here",
+	}, "\n")
+	if got.Output != wantOutput {
+		t.Errorf("Output =\n%s\nwant\n%s", got.Output, wantOutput)
+	}
+	if got.Count != 2 || got.Skipped != 1 {
+		t.Errorf("Count = %d, Skipped = %d", got.Count, got.Skipped)
+	}
+	if r := got.Replacements[1]; r.Line != 2 || r.Column != 4 || r.Unit != UnitMilliseconds {
+		t.Errorf("Replacement = %+v", r)
+	}
+	if len(got.Lines) != 4 || !got.Lines[0].Changed || got.Lines[3].Changed {
+		t.Errorf("Lines = %+v", got.Lines)
+	}
+}
+
+func TestBatchConverterToEpoch(t *testing.T) {
+	converter := NewBatchConverter(nil)
+	tests := []struct {
+		name string
+		text string
+		unit string
+		want string
+	}{
+		{"RFC3339", "at 2023-11-14T22:13:20Z ok", "", "at 1700000000 ok"},
+		{"日志毫秒逗号", "2023-11-14 22:13:20,123 INFO start", "", "1700000000123 INFO start"},
+		{"无冒号偏移", "2023-11-15 06:13:20+0800 x", UnitSeconds, "1700000000 x"},
+		{"nginx", `1.2.3.4 - - [15/Nov/2023:06:13:20 +0800] "GET /"`, UnitMilliseconds, `1.2.3.4 - - [1700000000000] "GET /"`},
+		{"按时区解释", "date,2023-11-14 22:13:20", "", "date,1700000000"},
+		{"多处替换", "2023-11-14 2023-11-15", "", "1699920000 1700006400"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := converter.Convert(tt.text, BatchOptions{Direction: BatchToEpoch, Timezone: "UTC", Unit: tt.unit})
+			if err != nil {
+				t.Fatalf("Convert error = %v", err)
+			}
+			if got.Output != tt.want {
+				t.Errorf("Output = %q, want %q", got.Output, tt.want)
+			}
+		})
+	}
+}
+
+func TestBatchConverterErrors(t *testing.T) {
+	converter := NewBatchConverter(nil)
+	tests := []struct {
+		name string
+		text string
+		opts BatchOptions
+	}{
+		{"空输入", "  ", BatchOptions{}},
+		{"无效方向", "1700000000", BatchOptions{Direction: "sideways"}},
+		{"无效时区", "1700000000", BatchOptions{Timezone: "Mars/Base"}},
+		{"无效单位", "1700000000", BatchOptions{Unit: "days"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := converter.Convert(tt.text, tt.opts); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
@@ -11,10 +11,10 @@ type Converter struct {
 	formatter *Formatter
 }
 
-// NewConverter 创建新的 Converter 实例
-func NewConverter() *Converter {
+// NewConverter 创建新的 Converter 实例，formats 用于解析已保存的格式名称，可为 nil
+func NewConverter(formats *FormatStore) *Converter {
 	return &Converter{
-		formatter: NewFormatter(),
+		formatter: NewFormatter(formats),
 	}
 }
 
@@ -36,17 +36,25 @@ func (c *Converter) TimestampToTimeString(timestamp int64, format string, timezo
 
 // TimeStringToTimestamp 将时间字符串转换为时间戳
 func (c *Converter) TimeStringToTimestamp(timeStr string, format string, timezone string) (int64, error) {
-	// 加载时区
-	loc, err := time.LoadLocation(timezone)
+	t, err := c.parseTimeString(timeStr, format, timezone)
 	if err != nil {
-		// 如果时区无效，回退到 UTC
-		loc = time.UTC
+		return 0, err
+	}
+	return c.TimeToTimestamp(t), nil
+}
+
+// parseTimeString 按指定格式与时区解析时间字符串，保留小数秒
+func (c *Converter) parseTimeString(timeStr string, format string, timezone string) (time.Time, error) {
+	// 加载时区，无效时区返回错误并给出相近的候选
+	loc, err := LoadLocation(timezone)
+	if err != nil {
+		return time.Time{}, err
 	}
 
 	var t time.Time
 
 	// 检查格式是否包含时区信息
-	actualFormat := c.getActualFormat(format)
+	actualFormat := c.formatter.layout(format)
 	formatHasTimezone := c.formatRequiresTimezone(format)
 
 	if formatHasTimezone {
@@ -71,7 +79,7 @@ func (c *Converter) TimeStringToTimestamp(timeStr string, format string, timezon
 			}
 
 			if err != nil {
-				return 0, errors.Wrapf(lastErr, "failed to parse time")
+				return time.Time{}, errors.Wrapf(lastErr, "failed to parse time")
 			}
 		}
 	} else {
@@ -79,12 +87,11 @@ func (c *Converter) TimeStringToTimestamp(timeStr string, format string, timezon
 		// 直接使用选择的时区解析，不使用 time.Parse（因为 time.Parse 会使用 UTC）
 		t, err = time.ParseInLocation(actualFormat, timeStr, loc)
 		if err != nil {
-			return 0, errors.Wrapf(err, "failed to parse time")
+			return time.Time{}, errors.Wrapf(err, "failed to parse time")
 		}
 	}
 
-	// 转换为 UTC 时间戳
-	return c.TimeToTimestamp(t), nil
+	return t, nil
 }
 
 // formatRequiresTimezone 检查格式是否要求时区信息
@@ -152,54 +159,13 @@ func (c *Converter) getFormatsToTry(format string, timeStr string) []string {
 		formats = append(formats, "Mon, 02 Jan 2006 15:04:05")
 	default:
 		// 其他格式（DateTime、Date、Time）本身就不包含时区信息
-		actualFormat := c.getActualFormat(format)
+		actualFormat := c.formatter.layout(format)
 		formats = append(formats, actualFormat)
 	}
 
 	return formats
 }
 
-// getActualFormat 将格式名称转换为实际的 Go 时间格式字符串
-func (c *Converter) getActualFormat(format string) string {
-	switch format {
-	case "RFC3339":
-		return time.RFC3339
-	case "RFC3339Nano":
-		return time.RFC3339Nano
-	case "RFC822":
-		return time.RFC822
-	case "RFC822Z":
-		return time.RFC822Z
-	case "RFC1123":
-		return time.RFC1123
-	case "RFC1123Z":
-		return time.RFC1123Z
-	case "UnixDate":
-		return time.UnixDate
-	case "RubyDate":
-		return time.RubyDate
-	case "Kitchen":
-		return time.Kitchen
-	case "Stamp":
-		return time.Stamp
-	case "StampMilli":
-		return time.StampMilli
-	case "StampMicro":
-		return time.StampMicro
-	case "StampNano":
-		return time.StampNano
-	case "DateTime":
-		return "2006-01-02 15:04:05"
-	case "Date":
-		return "2006-01-02"
-	case "Time":
-		return "15:04:05"
-	default:
-		// 如果格式不是预定义的，直接使用原格式
-		return format
-	}
-}
-
 // TimestampToTimeStringMilli 将毫秒时间戳转换为时间字符串
 func (c *Converter) TimestampToTimeStringMilli(timestampMilli int64, format string, timezone string) (string, error) {
 	// 毫秒时间戳转换为 time.Time，并保留毫秒精度
@@ -217,10 +183,10 @@ func (c *Converter) TimestampToTimeStringMilli(timestampMilli int64, format stri
 
 // TimeStringToTimestampMilli 将时间字符串转换为毫秒时间戳
 func (c *Converter) TimeStringToTimestampMilli(timeStr string, format string, timezone string) (int64, error) {
-	timestamp, err := c.TimeStringToTimestamp(timeStr, format, timezone)
+	t, err := c.parseTimeString(timeStr, format, timezone)
 	if err != nil {
 		return 0, err
 	}
-	// 秒级时间戳转换为毫秒时间戳
-	return timestamp * 1000, nil
+	// 保留输入中的毫秒部分
+	return t.UnixMilli(), nil
 }
@@ -6,7 +6,7 @@ import (
 )
 
 func TestConverter_TimestampToTime(t *testing.T) {
-	converter := NewConverter()
+	converter := NewConverter(nil)
 
 	timestamp := int64(1609459200) // 2021-01-01 00:00:00 UTC
 	result := converter.TimestampToTime(timestamp)
@@ -18,7 +18,7 @@ func TestConverter_TimestampToTime(t *testing.T) {
 }
 
 func TestConverter_TimeToTimestamp(t *testing.T) {
-	converter := NewConverter()
+	converter := NewConverter(nil)
 
 	now := time.Now()
 	result := converter.TimeToTimestamp(now)
@@ -30,7 +30,7 @@ func TestConverter_TimeToTimestamp(t *testing.T) {
 }
 
 func TestConverter_TimestampToTimeString_WithSecondTimestamp(t *testing.T) {
-	converter := NewConverter()
+	converter := NewConverter(nil)
 	timestamp := int64(1723293026)
 
 	result, err := converter.TimestampToTimeString(timestamp, "DateTime", "UTC")
@@ -45,7 +45,7 @@ func TestConverter_TimestampToTimeString_WithSecondTimestamp(t *testing.T) {
 }
 
 func TestConverter_TimestampToTimeStringMilli_WithMilliTimestamp(t *testing.T) {
-	converter := NewConverter()
+	converter := NewConverter(nil)
 	timestampMilli := int64(1723293026123)
 
 	result, err := converter.TimestampToTimeStringMilli(timestampMilli, "DateTime", "UTC")
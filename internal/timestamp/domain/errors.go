@@ -16,4 +16,32 @@ var (
 	ErrInvalidHistoryRecord = TimestampError{Errmsg: "无效的历史记录"}
 	// ErrHistoryStoreUnavailable 历史存储不可用
 	ErrHistoryStoreUnavailable = TimestampError{Errmsg: "历史记录存储不可用"}
+	// ErrInvalidTimestamp 无效的时间戳
+	ErrInvalidTimestamp = TimestampError{Errmsg: "无效的时间戳"}
+	// ErrUnsupportedUnit 不支持的时间戳单位
+	ErrUnsupportedUnit = TimestampError{Errmsg: "不支持的时间戳单位"}
+	// ErrTimestampOutOfRange 时间戳超出可表示范围
+	ErrTimestampOutOfRange = TimestampError{Errmsg: "时间戳超出可表示范围"}
+	// ErrEmptyTimeInput 时间输入为空
+	ErrEmptyTimeInput = TimestampError{Errmsg: "时间不能为空"}
+	// ErrUnrecognizedTime 无法识别的时间格式
+	ErrUnrecognizedTime = TimestampError{Errmsg: "无法识别的时间格式"}
+	// ErrInvalidTimezone 无效的时区
+	ErrInvalidTimezone = TimestampError{Errmsg: "无效的时区"}
+	// ErrInvalidPeriod 无效的时长表达式
+	ErrInvalidPeriod = TimestampError{Errmsg: "无效的时长表达式"}
+	// ErrInvalidDuration 无效的 Go 时长
+	ErrInvalidDuration = TimestampError{Errmsg: "无效的时长"}
+	// ErrInvalidHoliday 无效的节假日日期
+	ErrInvalidHoliday = TimestampError{Errmsg: "无效的节假日日期"}
+	// ErrInvalidLayout 无效的时间格式
+	ErrInvalidLayout = TimestampError{Errmsg: "无效的时间格式"}
+	// ErrInvalidFormatName 无效的格式名称
+	ErrInvalidFormatName = TimestampError{Errmsg: "无效的格式名称"}
+	// ErrFormatNotFound 自定义格式不存在
+	ErrFormatNotFound = TimestampError{Errmsg: "自定义格式不存在"}
+	// ErrInvalidBatchDirection 无效的批量转换方向
+	ErrInvalidBatchDirection = TimestampError{Errmsg: "无效的批量转换方向"}
+	// ErrBatchInputTooLarge 批量转换输入过大
+	ErrBatchInputTooLarge = TimestampError{Errmsg: "批量转换输入过大"}
 )
@@ -0,0 +1,266 @@
+package domain
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	appconfig "github.com/cyrnicolase/dev-tools/internal/config"
+	"github.com/pkg/errors"
+)
+
+const (
+	// FormatsConfigFile 自定义时间格式配置文件名称
+	FormatsConfigFile = "timestamp_formats.json"
+	// maxFormatNameLength 格式名称的最大长度
+	maxFormatNameLength = 64
+)
+
+// builtinFormats 内置格式名称及对应的 Go 布局（按展示顺序）
+var builtinFormats = []FormatInfo{
+	{Name: "RFC3339", Layout: time.RFC3339, BuiltIn: true},
+	{Name: "RFC3339Nano", Layout: time.RFC3339Nano, BuiltIn: true},
+	{Name: "RFC822", Layout: time.RFC822, BuiltIn: true},
+	{Name: "RFC822Z", Layout: time.RFC822Z, BuiltIn: true},
+	{Name: "RFC1123", Layout: time.RFC1123, BuiltIn: true},
+	{Name: "RFC1123Z", Layout: time.RFC1123Z, BuiltIn: true},
+	{Name: "UnixDate", Layout: time.UnixDate, BuiltIn: true},
+	{Name: "RubyDate", Layout: time.RubyDate, BuiltIn: true},
+	{Name: "Kitchen", Layout: time.Kitchen, BuiltIn: true},
+	{Name: "Stamp", Layout: time.Stamp, BuiltIn: true},
+	{Name: "StampMilli", Layout: time.StampMilli, BuiltIn: true},
+	{Name: "StampMicro", Layout: time.StampMicro, BuiltIn: true},
+	{Name: "StampNano", Layout: time.StampNano, BuiltIn: true},
+	{Name: "DateTime", Layout: "2006-01-02 15:04:05", BuiltIn: true},
+	{Name: "Date", Layout: "2006-01-02", BuiltIn: true},
+	{Name: "Time", Layout: "15:04:05", BuiltIn: true},
+}
+
+// FormatInfo 可选的时间格式
+type FormatInfo struct {
+	Name   string `json:"name"`
+	Layout string `json:"layout"`
+	// Pattern 保存时输入的原始格式（内置格式为空）
+	Pattern string `json:"pattern,omitempty"`
+	// Syntax 原始格式的语法
+	Syntax  string `json:"syntax,omitempty"`
+	BuiltIn bool   `json:"builtIn"`
+}
+
+// CustomFormat 用户保存的命名格式
+type CustomFormat struct {
+	Name    string `json:"name"`
+	Layout  string `json:"layout"`
+	Pattern string `json:"pattern"`
+	Syntax  string `json:"syntax"`
+}
+
+// formatsConfig 自定义格式配置文件内容
+type formatsConfig struct {
+	Formats []CustomFormat `json:"formats"`
+}
+
+// FormatStore 自定义格式存储，保存在应用配置目录下
+type FormatStore struct {
+	path    string
+	formats []CustomFormat
+	mu      sync.RWMutex
+}
+
+// NewFormatStore 创建自定义格式存储并加载已保存的格式
+func NewFormatStore() *FormatStore {
+	path, err := getFormatsConfigPath()
+	if err != nil {
+		// 无法获取配置目录时仅在内存中保存
+		path = ""
+	}
+	return newFormatStore(path)
+}
+
+// newFormatStore 使用指定的配置文件路径创建存储
+func newFormatStore(path string) *FormatStore {
+	store := &FormatStore{path: path}
+	_ = store.Load()
+	return store
+}
+
+// getFormatsConfigPath 获取配置文件路径
+func getFormatsConfigPath() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+	return filepath.Join(homeDir, appconfig.AppConfigDirName, FormatsConfigFile), nil
+}
+
+// Load 从文件加载自定义格式，文件不存在时为空列表
+func (s *FormatStore) Load() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.formats = nil
+	if s.path == "" {
+		return nil
+	}
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return errors.WithStack(err)
+	}
+
+	var config formatsConfig
+	if err := json.Unmarshal(data, &config); err != nil {
+		return errors.WithStack(err)
+	}
+	s.formats = config.Formats
+	return nil
+}
+
+// List 返回已保存的自定义格式
+func (s *FormatStore) List() []CustomFormat {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return append([]CustomFormat(nil), s.formats...)
+}
+
+// Lookup 按名称查找自定义格式的 Go 布局
+func (s *FormatStore) Lookup(name string) (string, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, format := range s.formats {
+		if format.Name == name {
+			return format.Layout, true
+		}
+	}
+	return "", false
+}
+
+// Save 保存命名格式，同名格式会被覆盖；pattern 可以是任意支持的语法，保存时转换为 Go 布局
+func (s *FormatStore) Save(name, pattern, syntax string) (*CustomFormat, error) {
+	name = strings.TrimSpace(name)
+	switch {
+	case name == "":
+		return nil, errors.Wrap(ErrInvalidFormatName, "名称不能为空")
+	case len([]rune(name)) > maxFormatNameLength:
+		return nil, errors.Wrapf(ErrInvalidFormatName, "名称不能超过 %d 个字符", maxFormatNameLength)
+	case isBuiltinFormat(name):
+		return nil, errors.Wrapf(ErrInvalidFormatName, "%s 是内置格式名称", name)
+	}
+
+	translation, err := NewLayoutTranslator().Translate(pattern, syntax)
+	if err != nil {
+		return nil, err
+	}
+	format := CustomFormat{Name: name, Layout: translation.Go, Pattern: pattern, Syntax: translation.Syntax}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	formats := make([]CustomFormat, 0, len(s.formats)+1)
+	replaced := false
+	for _, existing := range s.formats {
+		if existing.Name == name {
+			formats = append(formats, format)
+			replaced = true
+			continue
+		}
+		formats = append(formats, existing)
+	}
+	if !replaced {
+		formats = append(formats, format)
+	}
+	if err := s.write(formats); err != nil {
+		return nil, err
+	}
+	s.formats = formats
+	return &format, nil
+}
+
+// Delete 删除命名格式
+func (s *FormatStore) Delete(name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	formats := make([]CustomFormat, 0, len(s.formats))
+	for _, existing := range s.formats {
+		if existing.Name != name {
+			formats = append(formats, existing)
+		}
+	}
+	if len(formats) == len(s.formats) {
+		return errors.Wrapf(ErrFormatNotFound, "格式不存在: %s", name)
+	}
+	if err := s.write(formats); err != nil {
+		return err
+	}
+	s.formats = formats
+	return nil
+}
+
+// write 将格式列表写入配置文件
+func (s *FormatStore) write(formats []CustomFormat) error {
+	if s.path == "" {
+		return nil
+	}
+	// 确保配置目录存在
+	if err := os.MkdirAll(filepath.Dir(s.path), appconfig.AppConfigDirMode); err != nil {
+		return errors.WithStack(err)
+	}
+	data, err := json.MarshalIndent(formatsConfig{Formats: formats}, "", "  ")
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if err := os.WriteFile(s.path, data, appconfig.AppConfigFileMode); err != nil {
+		return errors.WithStack(err)
+	}
+	return nil
+}
+
+// ListFormats 返回内置格式与已保存的自定义格式
+func (s *FormatStore) ListFormats() []FormatInfo {
+	formats := append([]FormatInfo(nil), builtinFormats...)
+	for _, format := range s.List() {
+		formats = append(formats, FormatInfo{
+			Name:    format.Name,
+			Layout:  format.Layout,
+			Pattern: format.Pattern,
+			Syntax:  format.Syntax,
+		})
+	}
+	return formats
+}
+
+// builtinLayout 按名称查找内置格式的 Go 布局
+func builtinLayout(name string) (string, bool) {
+	for _, format := range builtinFormats {
+		if format.Name == name {
+			return format.Layout, true
+		}
+	}
+	return "", false
+}
+
+// isBuiltinFormat 判断是否为内置格式名称
+func isBuiltinFormat(name string) bool {
+	_, ok := builtinLayout(name)
+	return ok
+}
+
+// resolveFormat 将格式解析为 Go 布局：依次匹配内置格式名称、已保存的格式名称（formats 可为 nil），
+// 再翻译 strftime、Java、moment.js 格式，否则作为 Go 布局使用
+func resolveFormat(formats *FormatStore, format string) string {
+	if layout, ok := builtinLayout(format); ok {
+		return layout
+	}
+	if formats != nil {
+		if layout, ok := formats.Lookup(format); ok {
+			return layout
+		}
+	}
+	return ResolveLayout(format)
+}
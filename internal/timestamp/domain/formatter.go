@@ -5,11 +5,15 @@ import (
 )
 
 // Formatter 提供时间格式化功能
-type Formatter struct{}
+type Formatter struct {
+	formats *FormatStore
+}
 
-// NewFormatter 创建新的 Formatter 实例
-func NewFormatter() *Formatter {
-	return &Formatter{}
+// NewFormatter 创建新的 Formatter 实例，formats 用于解析已保存的格式名称，可为 nil
+func NewFormatter(formats *FormatStore) *Formatter {
+	return &Formatter{
+		formats: formats,
+	}
 }
 
 // FormatTime 格式化时间为指定格式
@@ -18,54 +22,21 @@ func (f *Formatter) FormatTime(t time.Time, format string, timezone string) (str
 		format = time.RFC3339
 	}
 
-	// 加载时区
-	loc, err := time.LoadLocation(timezone)
+	// 加载时区，无效时区返回错误并给出相近的候选
+	loc, err := LoadLocation(timezone)
 	if err != nil {
-		// 如果时区无效，回退到 UTC
-		loc = time.UTC
+		return "", err
 	}
-	
+
 	// 将时间转换到指定时区
 	t = t.In(loc)
 
-	// 支持常用格式
-	switch format {
-	case "RFC3339":
-		return t.Format(time.RFC3339), nil
-	case "RFC3339Nano":
-		return t.Format(time.RFC3339Nano), nil
-	case "RFC822":
-		return t.Format(time.RFC822), nil
-	case "RFC822Z":
-		return t.Format(time.RFC822Z), nil
-	case "RFC1123":
-		return t.Format(time.RFC1123), nil
-	case "RFC1123Z":
-		return t.Format(time.RFC1123Z), nil
-	case "UnixDate":
-		return t.Format(time.UnixDate), nil
-	case "RubyDate":
-		return t.Format(time.RubyDate), nil
-	case "Kitchen":
-		return t.Format(time.Kitchen), nil
-	case "Stamp":
-		return t.Format(time.Stamp), nil
-	case "StampMilli":
-		return t.Format(time.StampMilli), nil
-	case "StampMicro":
-		return t.Format(time.StampMicro), nil
-	case "StampNano":
-		return t.Format(time.StampNano), nil
-	case "DateTime":
-		return t.Format("2006-01-02 15:04:05"), nil
-	case "Date":
-		return t.Format("2006-01-02"), nil
-	case "Time":
-		return t.Format("15:04:05"), nil
-	default:
-		// 尝试作为 Go 时间格式解析
-		return t.Format(format), nil
-	}
+	return t.Format(f.layout(format)), nil
+}
+
+// layout 将内置格式名称、已保存的格式名称、strftime/Java/moment.js 格式或 Go 时间格式解析为 Go 布局
+func (f *Formatter) layout(format string) string {
+	return resolveFormat(f.formats, format)
 }
 
 // FormatNow 格式化当前时间
@@ -0,0 +1,607 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/pkg/errors"
+)
+
+// 时间格式语法
+const (
+	// LayoutSyntaxAuto 自动识别
+	LayoutSyntaxAuto = "auto"
+	// LayoutSyntaxGo Go 参考时间布局：2006-01-02 15:04:05
+	LayoutSyntaxGo = "go"
+	// LayoutSyntaxStrftime C/Python strftime：%Y-%m-%d %H:%M:%S
+	LayoutSyntaxStrftime = "strftime"
+	// LayoutSyntaxJava Java DateTimeFormatter：yyyy-MM-dd HH:mm:ss.SSS
+	LayoutSyntaxJava = "java"
+	// LayoutSyntaxMoment moment.js / day.js：YYYY-MM-DD HH:mm:ss.SSS
+	LayoutSyntaxMoment = "moment"
+)
+
+// 格式中的时间字段
+const (
+	compLiteral      = ""
+	compYear         = "year"
+	compYear2        = "year2"
+	compMonth        = "month"
+	compMonthPad     = "month-pad"
+	compMonthShort   = "month-short"
+	compMonthLong    = "month-long"
+	compDay          = "day"
+	compDayPad       = "day-pad"
+	compDaySpace     = "day-space"
+	compYearDay      = "year-day"
+	compWeekdayShort = "weekday-short"
+	compWeekdayLong  = "weekday-long"
+	compHour         = "hour"
+	compHourPad      = "hour-pad"
+	compHour12       = "hour12"
+	compHour12Pad    = "hour12-pad"
+	compMinute       = "minute"
+	compMinutePad    = "minute-pad"
+	compSecond       = "second"
+	compSecondPad    = "second-pad"
+	compFrac3        = "frac3"
+	compFrac6        = "frac6"
+	compFrac9        = "frac9"
+	compFracTrim     = "frac-trim"
+	compAMPM         = "ampm"
+	compAMPMLower    = "ampm-lower"
+	compZoneName     = "zone-name"
+	compOffset       = "offset"
+	compOffsetColon  = "offset-colon"
+	compOffsetHour   = "offset-hour"
+	compOffsetZ      = "offset-z"
+	compOffsetZColon = "offset-z-colon"
+	compOffsetZHour  = "offset-z-hour"
+)
+
+// layoutToken 格式中的一段：时间字段或字面量
+type layoutToken struct {
+	comp    string
+	literal string
+}
+
+// goTokens Go 布局中的字段，按最长匹配优先排列
+var goTokens = []struct {
+	text string
+	comp string
+}{
+	{"January", compMonthLong}, {"Monday", compWeekdayLong}, {"2006", compYear},
+	{"Z07:00", compOffsetZColon}, {"-07:00", compOffsetColon}, {"Z0700", compOffsetZ}, {"-0700", compOffset},
+	{"Z07", compOffsetZHour}, {"-07", compOffsetHour},
+	{"Jan", compMonthShort}, {"Mon", compWeekdayShort}, {"MST", compZoneName},
+	{"002", compYearDay}, {"_2", compDaySpace},
+	{"01", compMonthPad}, {"02", compDayPad}, {"03", compHour12Pad}, {"04", compMinutePad}, {"05", compSecondPad}, {"06", compYear2},
+	{"15", compHourPad}, {"PM", compAMPM}, {"pm", compAMPMLower},
+	{"1", compMonth}, {"2", compDay}, {"3", compHour12}, {"4", compMinute}, {"5", compSecond},
+}
+
+// syntaxTables 各语法中字段的写法
+var syntaxTables = map[string]map[string]string{
+	LayoutSyntaxGo: {
+		compYear: "2006", compYear2: "06", compMonth: "1", compMonthPad: "01", compMonthShort: "Jan", compMonthLong: "January",
+		compDay: "2", compDayPad: "02", compDaySpace: "_2", compYearDay: "002", compWeekdayShort: "Mon", compWeekdayLong: "Monday",
+		compHourPad: "15", compHour12: "3", compHour12Pad: "03", compMinute: "4", compMinutePad: "04", compSecond: "5", compSecondPad: "05",
+		compFrac3: "000", compFrac6: "000000", compFrac9: "000000000", compFracTrim: "999999999",
+		compAMPM: "PM", compAMPMLower: "pm", compZoneName: "MST",
+		compOffset: "-0700", compOffsetColon: "-07:00", compOffsetHour: "-07", compOffsetZ: "Z0700", compOffsetZColon: "Z07:00", compOffsetZHour: "Z07",
+	},
+	LayoutSyntaxStrftime: {
+		compYear: "%Y", compYear2: "%y", compMonth: "%-m", compMonthPad: "%m", compMonthShort: "%b", compMonthLong: "%B",
+		compDay: "%-d", compDayPad: "%d", compDaySpace: "%e", compYearDay: "%j", compWeekdayShort: "%a", compWeekdayLong: "%A",
+		compHour: "%-H", compHourPad: "%H", compHour12: "%-I", compHour12Pad: "%I", compMinute: "%-M", compMinutePad: "%M", compSecond: "%-S", compSecondPad: "%S",
+		compFrac3: "%L", compFrac6: "%f", compFrac9: "%N",
+		compAMPM: "%p", compAMPMLower: "%P", compZoneName: "%Z", compOffset: "%z", compOffsetColon: "%:z",
+	},
+	LayoutSyntaxJava: {
+		compYear: "yyyy", compYear2: "yy", compMonth: "M", compMonthPad: "MM", compMonthShort: "MMM", compMonthLong: "MMMM",
+		compDay: "d", compDayPad: "dd", compYearDay: "DDD", compWeekdayShort: "EEE", compWeekdayLong: "EEEE",
+		compHour: "H", compHourPad: "HH", compHour12: "h", compHour12Pad: "hh", compMinute: "m", compMinutePad: "mm", compSecond: "s", compSecondPad: "ss",
+		compFrac3: "SSS", compFrac6: "SSSSSS", compFrac9: "SSSSSSSSS",
+		compAMPM: "a", compZoneName: "z",
+		compOffset: "Z", compOffsetColon: "xxx", compOffsetHour: "x", compOffsetZ: "XX", compOffsetZColon: "XXX", compOffsetZHour: "X",
+	},
+	LayoutSyntaxMoment: {
+		compYear: "YYYY", compYear2: "YY", compMonth: "M", compMonthPad: "MM", compMonthShort: "MMM", compMonthLong: "MMMM",
+		compDay: "D", compDayPad: "DD", compYearDay: "DDDD", compWeekdayShort: "ddd", compWeekdayLong: "dddd",
+		compHour: "H", compHourPad: "HH", compHour12: "h", compHour12Pad: "hh", compMinute: "m", compMinutePad: "mm", compSecond: "s", compSecondPad: "ss",
+		compFrac3: "SSS", compFrac6: "SSSSSS", compFrac9: "SSSSSSSSS",
+		compAMPM: "A", compAMPMLower: "a", compZoneName: "z", compOffset: "ZZ", compOffsetColon: "Z",
+	},
+}
+
+// approximations 目标语法不支持某字段时的近似写法
+var approximations = map[string]string{
+	compHour:         compHourPad,
+	compDaySpace:     compDay,
+	compFracTrim:     compFrac9,
+	compAMPMLower:    compAMPM,
+	compOffsetZ:      compOffset,
+	compOffsetZColon: compOffsetColon,
+	compOffsetZHour:  compOffsetHour,
+	compOffsetHour:   compOffset,
+	compFrac3:        compFrac9,
+	compFrac6:        compFrac9,
+}
+
+// LayoutTranslation 时间格式在各语法间的转换结果
+type LayoutTranslation struct {
+	Input string `json:"input"`
+	// Syntax 输入的语法（自动识别时为识别结果）
+	Syntax   string `json:"syntax"`
+	Go       string `json:"go"`
+	Strftime string `json:"strftime"`
+	Java     string `json:"java"`
+	Moment   string `json:"moment"`
+	// Example 使用转换后的 Go 布局格式化当前时间的示例
+	Example string `json:"example"`
+	// Warnings 无法精确转换的字段说明
+	Warnings []string `json:"warnings"`
+}
+
+// LayoutTranslator 在 Go 布局、strftime、Java DateTimeFormatter 与 moment.js 格式之间转换
+type LayoutTranslator struct{}
+
+// NewLayoutTranslator 创建新的 LayoutTranslator 实例
+func NewLayoutTranslator() *LayoutTranslator {
+	return &LayoutTranslator{}
+}
+
+// Translate 将格式转换为所有支持的语法，syntax 为空或 auto 时自动识别
+func (l *LayoutTranslator) Translate(pattern, syntax string) (*LayoutTranslation, error) {
+	if pattern == "" {
+		return nil, errors.Wrap(ErrInvalidLayout, "格式不能为空")
+	}
+	if syntax == "" || syntax == LayoutSyntaxAuto {
+		syntax = DetectLayoutSyntax(pattern)
+	}
+	tokens, warnings, err := parseLayout(pattern, syntax)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &LayoutTranslation{Input: pattern, Syntax: syntax, Warnings: warnings}
+	for _, target := range []struct {
+		syntax string
+		dest   *string
+	}{
+		{LayoutSyntaxGo, &result.Go},
+		{LayoutSyntaxStrftime, &result.Strftime},
+		{LayoutSyntaxJava, &result.Java},
+		{LayoutSyntaxMoment, &result.Moment},
+	} {
+		rendered, targetWarnings := renderLayout(tokens, target.syntax)
+		*target.dest = rendered
+		result.Warnings = append(result.Warnings, targetWarnings...)
+	}
+	return result, nil
+}
+
+// ToGoLayout 将任意语法的格式转换为 Go 布局
+func (l *LayoutTranslator) ToGoLayout(pattern, syntax string) (string, error) {
+	translation, err := l.Translate(pattern, syntax)
+	if err != nil {
+		return "", err
+	}
+	return translation.Go, nil
+}
+
+// DetectLayoutSyntax 识别格式的语法：含 % 为 strftime，含数字为 Go 布局，
+// 含 YYYY、DD、[ ]、A 等为 moment.js，其余含字母的为 Java DateTimeFormatter
+func DetectLayoutSyntax(pattern string) string {
+	switch {
+	case strings.Contains(pattern, "%"):
+		return LayoutSyntaxStrftime
+	case strings.ContainsAny(pattern, "0123456789"):
+		return LayoutSyntaxGo
+	case strings.Contains(pattern, "YY") || strings.Contains(pattern, "DD") || strings.Contains(pattern, "[") || strings.Contains(pattern, "A"):
+		return LayoutSyntaxMoment
+	case strings.IndexFunc(pattern, unicode.IsLetter) >= 0:
+		return LayoutSyntaxJava
+	default:
+		return LayoutSyntaxGo
+	}
+}
+
+// parseLayout 按语法将格式解析为字段序列
+func parseLayout(pattern, syntax string) ([]layoutToken, []string, error) {
+	switch syntax {
+	case LayoutSyntaxGo:
+		return parseGoLayout(pattern), nil, nil
+	case LayoutSyntaxStrftime:
+		return parseStrftime(pattern)
+	case LayoutSyntaxJava:
+		return parseLetterPattern(pattern, LayoutSyntaxJava)
+	case LayoutSyntaxMoment:
+		return parseLetterPattern(pattern, LayoutSyntaxMoment)
+	default:
+		return nil, nil, errors.Wrapf(ErrInvalidLayout, "不支持的格式语法: %s", syntax)
+	}
+}
+
+// parseGoLayout 解析 Go 布局，小数秒需紧跟在 . 或 , 之后
+func parseGoLayout(layout string) []layoutToken {
+	var tokens []layoutToken
+	for i := 0; i < len(layout); {
+		if c := layout[i]; (c == '.' || c == ',') && i+1 < len(layout) && (layout[i+1] == '0' || layout[i+1] == '9') {
+			j := i + 1
+			for j < len(layout) && layout[j] == layout[i+1] {
+				j++
+			}
+			if j == len(layout) || layout[j] < '0' || layout[j] > '9' {
+				tokens = appendLiteral(tokens, string(c))
+				tokens = append(tokens, layoutToken{comp: goFractionComp(layout[i+1], j-i-1)})
+				i = j
+				continue
+			}
+		}
+
+		matched := false
+		for _, token := range goTokens {
+			if strings.HasPrefix(layout[i:], token.text) {
+				tokens = append(tokens, layoutToken{comp: token.comp})
+				i += len(token.text)
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			tokens = appendLiteral(tokens, layout[i:i+1])
+			i++
+		}
+	}
+	return tokens
+}
+
+// goFractionComp 将 Go 的 .000/.999 小数秒映射为字段
+func goFractionComp(digit byte, width int) string {
+	if digit == '9' {
+		return compFracTrim
+	}
+	switch {
+	case width <= 3:
+		return compFrac3
+	case width <= 6:
+		return compFrac6
+	default:
+		return compFrac9
+	}
+}
+
+// strftimeDirectives strftime 指令与字段的对应关系，复合指令展开为多个字段
+var strftimeDirectives = map[byte][]layoutToken{
+	'Y': {{comp: compYear}}, 'y': {{comp: compYear2}}, 'm': {{comp: compMonthPad}}, 'b': {{comp: compMonthShort}}, 'h': {{comp: compMonthShort}},
+	'B': {{comp: compMonthLong}}, 'd': {{comp: compDayPad}}, 'e': {{comp: compDaySpace}}, 'j': {{comp: compYearDay}},
+	'a': {{comp: compWeekdayShort}}, 'A': {{comp: compWeekdayLong}}, 'H': {{comp: compHourPad}}, 'k': {{comp: compHour}},
+	'I': {{comp: compHour12Pad}}, 'l': {{comp: compHour12}}, 'M': {{comp: compMinutePad}}, 'S': {{comp: compSecondPad}},
+	'f': {{comp: compFrac6}}, 'L': {{comp: compFrac3}}, 'N': {{comp: compFrac9}},
+	'p': {{comp: compAMPM}}, 'P': {{comp: compAMPMLower}}, 'Z': {{comp: compZoneName}}, 'z': {{comp: compOffset}},
+	'F': {{comp: compYear}, {literal: "-"}, {comp: compMonthPad}, {literal: "-"}, {comp: compDayPad}},
+	'T': {{comp: compHourPad}, {literal: ":"}, {comp: compMinutePad}, {literal: ":"}, {comp: compSecondPad}},
+	'R': {{comp: compHourPad}, {literal: ":"}, {comp: compMinutePad}},
+	'D': {{comp: compMonthPad}, {literal: "/"}, {comp: compDayPad}, {literal: "/"}, {comp: compYear2}},
+	'r': {{comp: compHour12Pad}, {literal: ":"}, {comp: compMinutePad}, {literal: ":"}, {comp: compSecondPad}, {literal: " "}, {comp: compAMPM}},
+	'%': {{literal: "%"}}, 'n': {{literal: "\n"}}, 't': {{literal: "\t"}},
+}
+
+// unpaddedComps strftime 的 - 标志（%-d）对应的不补零字段
+var unpaddedComps = map[string]string{
+	compMonthPad: compMonth, compDayPad: compDay, compDaySpace: compDay, compHourPad: compHour,
+	compHour12Pad: compHour12, compMinutePad: compMinute, compSecondPad: compSecond,
+}
+
+// parseStrftime 解析 strftime 格式，支持 - 与 _ 标志以及 %:z
+func parseStrftime(pattern string) ([]layoutToken, []string, error) {
+	var tokens []layoutToken
+	for i := 0; i < len(pattern); i++ {
+		if pattern[i] != '%' {
+			tokens = appendLiteral(tokens, pattern[i:i+1])
+			continue
+		}
+		i++
+		if i >= len(pattern) {
+			return nil, nil, errors.Wrap(ErrInvalidLayout, "格式以单独的 % 结尾")
+		}
+
+		flag := byte(0)
+		if pattern[i] == '-' || pattern[i] == '_' || pattern[i] == ':' {
+			flag = pattern[i]
+			i++
+			if i >= len(pattern) {
+				return nil, nil, errors.Wrap(ErrInvalidLayout, "格式以不完整的指令结尾")
+			}
+		}
+		if flag == ':' {
+			if pattern[i] != 'z' {
+				return nil, nil, errors.Wrapf(ErrInvalidLayout, "不支持的 strftime 指令: %%:%c", pattern[i])
+			}
+			tokens = append(tokens, layoutToken{comp: compOffsetColon})
+			continue
+		}
+
+		directive, ok := strftimeDirectives[pattern[i]]
+		if !ok {
+			return nil, nil, errors.Wrapf(ErrInvalidLayout, "不支持的 strftime 指令: %%%c（如 %%s、%%u、%%U 等无法用 Go 布局表示）", pattern[i])
+		}
+		for _, token := range directive {
+			switch {
+			case flag == '-' && unpaddedComps[token.comp] != "":
+				token.comp = unpaddedComps[token.comp]
+			case flag == '_' && token.comp == compDayPad:
+				token.comp = compDaySpace
+			}
+			if token.comp == compLiteral {
+				tokens = appendLiteral(tokens, token.literal)
+			} else {
+				tokens = append(tokens, token)
+			}
+		}
+	}
+	return tokens, nil, nil
+}
+
+// parseLetterPattern 解析 Java DateTimeFormatter 或 moment.js 格式（按相同字母的连续长度区分字段）
+func parseLetterPattern(pattern, syntax string) ([]layoutToken, []string, error) {
+	var tokens []layoutToken
+	var warnings []string
+	runes := []rune(pattern)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+
+		// 字面量：Java 使用 '...'（'' 表示单引号），moment 使用 [...]
+		if syntax == LayoutSyntaxJava && r == '\'' {
+			if i+1 < len(runes) && runes[i+1] == '\'' {
+				tokens = appendLiteral(tokens, "'")
+				i += 2
+				continue
+			}
+			end := i + 1
+			var literal strings.Builder
+			for ; end < len(runes); end++ {
+				if runes[end] == '\'' {
+					if end+1 < len(runes) && runes[end+1] == '\'' {
+						literal.WriteRune('\'')
+						end++
+						continue
+					}
+					break
+				}
+				literal.WriteRune(runes[end])
+			}
+			if end >= len(runes) {
+				return nil, nil, errors.Wrap(ErrInvalidLayout, "单引号未闭合")
+			}
+			tokens = appendLiteral(tokens, literal.String())
+			i = end + 1
+			continue
+		}
+		if syntax == LayoutSyntaxMoment && r == '[' {
+			end := i + 1
+			for end < len(runes) && runes[end] != ']' {
+				end++
+			}
+			if end >= len(runes) {
+				return nil, nil, errors.Wrap(ErrInvalidLayout, "方括号未闭合")
+			}
+			tokens = appendLiteral(tokens, string(runes[i+1:end]))
+			i = end + 1
+			continue
+		}
+		if !unicode.IsLetter(r) || r > unicode.MaxASCII {
+			tokens = appendLiteral(tokens, string(r))
+			i++
+			continue
+		}
+
+		count := 1
+		for i+count < len(runes) && runes[i+count] == r {
+			count++
+		}
+		comp, warning, err := letterComp(r, count, syntax)
+		if err != nil {
+			return nil, nil, err
+		}
+		if warning != "" {
+			warnings = append(warnings, warning)
+		}
+		if comp == compLiteral {
+			tokens = appendLiteral(tokens, string(runes[i:i+count]))
+		} else {
+			tokens = append(tokens, layoutToken{comp: comp})
+		}
+		i += count
+	}
+	return tokens, warnings, nil
+}
+
+// letterComp 将连续字母映射为字段，返回近似转换的说明
+func letterComp(r rune, count int, syntax string) (string, string, error) {
+	pick := func(options ...string) string {
+		return options[min(count, len(options))-1]
+	}
+	text := strings.Repeat(string(r), count)
+	unsupported := func() (string, string, error) {
+		return "", "", errors.Wrapf(ErrInvalidLayout, "不支持的格式字段: %s", text)
+	}
+
+	switch r {
+	case 'M':
+		return pick(compMonth, compMonthPad, compMonthShort, compMonthLong), "", nil
+	case 'H':
+		return pick(compHour, compHourPad), "", nil
+	case 'h':
+		return pick(compHour12, compHour12Pad), "", nil
+	case 'm':
+		return pick(compMinute, compMinutePad), "", nil
+	case 's':
+		return pick(compSecond, compSecondPad), "", nil
+	case 'S':
+		comp := pick(compFrac3, compFrac3, compFrac3, compFrac6, compFrac6, compFrac6, compFrac9, compFrac9, compFrac9)
+		if count != 3 && count != 6 && count != 9 {
+			return comp, fmt.Sprintf("%s 按 %d 位小数秒处理", text, map[string]int{compFrac3: 3, compFrac6: 6, compFrac9: 9}[comp]), nil
+		}
+		return comp, "", nil
+	}
+
+	if syntax == LayoutSyntaxJava {
+		switch r {
+		case 'y', 'u':
+			if count == 2 {
+				return compYear2, "", nil
+			}
+			return compYear, "", nil
+		case 'L':
+			return pick(compMonth, compMonthPad, compMonthShort, compMonthLong), "", nil
+		case 'd':
+			return pick(compDay, compDayPad), "", nil
+		case 'D':
+			return compYearDay, "", nil
+		case 'E':
+			return pick(compWeekdayShort, compWeekdayShort, compWeekdayShort, compWeekdayLong), "", nil
+		case 'a':
+			return compAMPM, "", nil
+		case 'z':
+			return compZoneName, "", nil
+		case 'Z':
+			return pick(compOffset, compOffset, compOffset, compOffset, compOffsetColon), "", nil
+		case 'X':
+			return pick(compOffsetZHour, compOffsetZ, compOffsetZColon), "", nil
+		case 'x':
+			return pick(compOffsetHour, compOffset, compOffsetColon), "", nil
+		}
+		return unsupported()
+	}
+
+	switch r {
+	case 'Y':
+		if count == 2 {
+			return compYear2, "", nil
+		}
+		return compYear, "", nil
+	case 'D':
+		return pick(compDay, compDayPad, compYearDay, compYearDay), "", nil
+	case 'd':
+		if count < 3 {
+			return unsupported()
+		}
+		return pick(compWeekdayShort, compWeekdayShort, compWeekdayShort, compWeekdayLong), "", nil
+	case 'A':
+		return compAMPM, "", nil
+	case 'a':
+		return compAMPMLower, "", nil
+	case 'Z':
+		return pick(compOffsetColon, compOffset), "", nil
+	case 'z':
+		return compZoneName, "", nil
+	case 'X', 'x', 'Q', 'W', 'w', 'E', 'e', 'G', 'g', 'k':
+		return unsupported()
+	}
+	// moment 中其余字母按原样输出
+	return compLiteral, "", nil
+}
+
+// renderLayout 将字段序列渲染为指定语法，不支持的字段使用近似写法并给出说明
+func renderLayout(tokens []layoutToken, syntax string) (string, []string) {
+	table := syntaxTables[syntax]
+	var b strings.Builder
+	var warnings []string
+	for i, token := range tokens {
+		if token.comp == compLiteral {
+			b.WriteString(escapeLiteral(token.literal, syntax))
+			continue
+		}
+
+		comp := token.comp
+		text, ok := table[comp]
+		for !ok {
+			next, has := approximations[comp]
+			if !has {
+				break
+			}
+			comp = next
+			text, ok = table[comp]
+		}
+		if !ok {
+			warnings = append(warnings, fmt.Sprintf("%s 不支持字段 %s，已省略", syntaxName(syntax), token.comp))
+			continue
+		}
+		if comp != token.comp {
+			warnings = append(warnings, fmt.Sprintf("%s 不支持字段 %s，已使用 %s 近似", syntaxName(syntax), token.comp, comp))
+		}
+		// Go 布局中的小数秒必须紧跟在 . 或 , 之后
+		if syntax == LayoutSyntaxGo && isFraction(comp) && (i == 0 || !strings.HasSuffix(tokens[i-1].literal, ".") && !strings.HasSuffix(tokens[i-1].literal, ",")) {
+			text = "." + text
+			warnings = append(warnings, "Go 布局的小数秒必须以 . 或 , 开头，已补充 .")
+		}
+		b.WriteString(text)
+	}
+	return b.String(), warnings
+}
+
+// escapeLiteral 按语法转义字面量
+func escapeLiteral(literal, syntax string) string {
+	switch syntax {
+	case LayoutSyntaxStrftime:
+		return strings.ReplaceAll(literal, "%", "%%")
+	case LayoutSyntaxJava:
+		if strings.IndexFunc(literal, func(r rune) bool { return unicode.IsLetter(r) || strings.ContainsRune("'[]{}#", r) }) < 0 {
+			return literal
+		}
+		return "'" + strings.ReplaceAll(literal, "'", "''") + "'"
+	case LayoutSyntaxMoment:
+		if strings.IndexFunc(literal, unicode.IsLetter) < 0 {
+			return literal
+		}
+		return "[" + literal + "]"
+	}
+	return literal
+}
+
+// isFraction 判断是否为小数秒字段
+func isFraction(comp string) bool {
+	return comp == compFrac3 || comp == compFrac6 || comp == compFrac9 || comp == compFracTrim
+}
+
+// syntaxName 语法的展示名称
+func syntaxName(syntax string) string {
+	switch syntax {
+	case LayoutSyntaxGo:
+		return "Go 布局"
+	case LayoutSyntaxStrftime:
+		return "strftime"
+	case LayoutSyntaxJava:
+		return "Java DateTimeFormatter"
+	case LayoutSyntaxMoment:
+		return "moment.js"
+	}
+	return syntax
+}
+
+// ResolveLayout 将格式转换为 Go 布局：strftime、Java、moment.js 格式会被翻译，
+// Go 布局或无法识别的格式原样返回
+func ResolveLayout(format string) string {
+	syntax := DetectLayoutSyntax(format)
+	if syntax == LayoutSyntaxGo {
+		return format
+	}
+	tokens, _, err := parseLayout(format, syntax)
+	if err != nil {
+		return format
+	}
+	layout, _ := renderLayout(tokens, LayoutSyntaxGo)
+	return layout
+}
+
+// appendLiteral 追加字面量，与前一段字面量合并
+func appendLiteral(tokens []layoutToken, literal string) []layoutToken {
+	if n := len(tokens); n > 0 && tokens[n-1].comp == compLiteral {
+		tokens[n-1].literal += literal
+		return tokens
+	}
+	return append(tokens, layoutToken{literal: literal})
+}
@@ -0,0 +1,204 @@
+package domain
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestLayoutTranslatorTranslate(t *testing.T) {
+	translator := NewLayoutTranslator()
+	tests := []struct {
+		name     string
+		pattern  string
+		syntax   string
+		wantSyn  string
+		goLayout string
+		strftime string
+		java     string
+		moment   string
+	}{
+		{
+			name:     "strftime 日期时间",
+			pattern:  "%Y-%m-%d %H:%M:%S",
+			wantSyn:  LayoutSyntaxStrftime,
+			goLayout: "2006-01-02 15:04:05",
+			strftime: "%Y-%m-%d %H:%M:%S",
+			java:     "yyyy-MM-dd HH:mm:ss",
+			moment:   "YYYY-MM-DD HH:mm:ss",
+		},
+		{
+			name:     "Java 毫秒与字面量",
+			pattern:  "yyyy-MM-dd'T'HH:mm:ss.SSSXXX",
+			wantSyn:  LayoutSyntaxJava,
+			goLayout: "2006-01-02T15:04:05.000Z07:00",
+			strftime: "%Y-%m-%dT%H:%M:%S.%L%:z",
+			java:     "yyyy-MM-dd'T'HH:mm:ss.SSSXXX",
+			moment:   "YYYY-MM-DD[T]HH:mm:ss.SSSZ",
+		},
+		{
+			name:     "moment 12 小时制",
+			pattern:  "MMM D, YYYY h:mm A",
+			wantSyn:  LayoutSyntaxMoment,
+			goLayout: "Jan 2, 2006 3:04 PM",
+			strftime: "%b %-d, %Y %-I:%M %p",
+			java:     "MMM d, yyyy h:mm a",
+			moment:   "MMM D, YYYY h:mm A",
+		},
+		{
+			name:     "Go 布局",
+			pattern:  time.RFC1123Z,
+			wantSyn:  LayoutSyntaxGo,
+			goLayout: time.RFC1123Z,
+			strftime: "%a, %d %b %Y %H:%M:%S %z",
+			java:     "EEE, dd MMM yyyy HH:mm:ss Z",
+			moment:   "ddd, DD MMM YYYY HH:mm:ss ZZ",
+		},
+		{
+			name:     "strftime 复合指令",
+			pattern:  "%F %T",
+			wantSyn:  LayoutSyntaxStrftime,
+			goLayout: "2006-01-02 15:04:05",
+			strftime: "%Y-%m-%d %H:%M:%S",
+			java:     "yyyy-MM-dd HH:mm:ss",
+			moment:   "YYYY-MM-DD HH:mm:ss",
+		},
+		{
+			name:     "指定语法",
+			pattern:  "dd/MM/yyyy",
+			syntax:   LayoutSyntaxJava,
+			wantSyn:  LayoutSyntaxJava,
+			goLayout: "02/01/2006",
+			strftime: "%d/%m/%Y",
+			java:     "dd/MM/yyyy",
+			moment:   "DD/MM/YYYY",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := translator.Translate(tt.pattern, tt.syntax)
+			if err != nil {
+				t.Fatalf("Translate(%q) error = %v", tt.pattern, err)
+			}
+			if got.Syntax != tt.wantSyn {
+				t.Errorf("Syntax = %q, want %q", got.Syntax, tt.wantSyn)
+			}
+			if got.Go != tt.goLayout {
+				t.Errorf("Go = %q, want %q", got.Go, tt.goLayout)
+			}
+			if got.Strftime != tt.strftime {
+				t.Errorf("Strftime = %q, want %q", got.Strftime, tt.strftime)
+			}
+			if got.Java != tt.java {
+				t.Errorf("Java = %q, want %q", got.Java, tt.java)
+			}
+			if got.Moment != tt.moment {
+				t.Errorf("Moment = %q, want %q", got.Moment, tt.moment)
+			}
+		})
+	}
+}
+
+func TestLayoutTranslatorWarningsAndErrors(t *testing.T) {
+	translator := NewLayoutTranslator()
+
+	got, err := translator.Translate("%H:%M", "")
+	if err != nil {
+		t.Fatalf("Translate error = %v", err)
+	}
+	if len(got.Warnings) != 0 {
+		t.Errorf("unexpected warnings: %v", got.Warnings)
+	}
+
+	// Go 没有不补零的 24 小时制
+	got, err = translator.Translate("%-H:%M", "")
+	if err != nil {
+		t.Fatalf("Translate error = %v", err)
+	}
+	if got.Go != "15:04" || len(got.Warnings) == 0 {
+		t.Errorf("Go = %q, warnings = %v", got.Go, got.Warnings)
+	}
+
+	for _, pattern := range []string{"", "%s", "%", "yyyy-'MM"} {
+		if _, err := translator.Translate(pattern, ""); err == nil {
+			t.Errorf("Translate(%q) expected error", pattern)
+		}
+	}
+}
+
+func TestResolveLayout(t *testing.T) {
+	ts := time.Date(2024, 3, 5, 14, 7, 9, 123456789, time.UTC)
+	tests := []struct {
+		format string
+		want   string
+	}{
+		{"%Y/%m/%d %H:%M", "2024/03/05 14:07"},
+		{"yyyy-MM-dd HH:mm:ss.SSS", "2024-03-05 14:07:09.123"},
+		{"YYYY-MM-DD", "2024-03-05"},
+		{"2006.01.02", "2024.03.05"},
+		{"Monday", "Tuesday"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			if got := ts.Format(ResolveLayout(tt.format)); got != tt.want {
+				t.Errorf("ResolveLayout(%q) formatted = %q, want %q", tt.format, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), FormatsConfigFile)
+	store := newFormatStore(path)
+
+	if _, err := store.Save("RFC3339", "%Y", ""); err == nil {
+		t.Error("expected error for built-in name")
+	}
+	if _, err := store.Save(" ", "%Y", ""); err == nil {
+		t.Error("expected error for empty name")
+	}
+
+	saved, err := store.Save("日志", "yyyy-MM-dd HH:mm:ss,SSS", "")
+	if err != nil {
+		t.Fatalf("Save error = %v", err)
+	}
+	if saved.Layout != "2006-01-02 15:04:05,000" {
+		t.Errorf("Layout = %q", saved.Layout)
+	}
+	if _, err := store.Save("日志", "%F", ""); err != nil {
+		t.Fatalf("Save error = %v", err)
+	}
+
+	// 重新加载后仍然存在，同名格式被覆盖
+	reloaded := newFormatStore(path)
+	if layout, ok := reloaded.Lookup("日志"); !ok || layout != "2006-01-02" {
+		t.Errorf("Lookup = %q, %v", layout, ok)
+	}
+	formats := reloaded.ListFormats()
+	if len(formats) != len(builtinFormats)+1 || formats[len(formats)-1].BuiltIn {
+		t.Errorf("ListFormats = %+v", formats)
+	}
+
+	// 注入的存储用于解析格式名称，未注入时名称按普通格式处理
+	ts := time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC)
+	if got, _ := NewFormatter(reloaded).FormatTime(ts, "日志", "UTC"); got != "2024-03-05" {
+		t.Errorf("FormatTime(saved name) = %q", got)
+	}
+	if got, _ := NewFormatter(nil).FormatTime(ts, "日志", "UTC"); got != "日志" {
+		t.Errorf("FormatTime(without store) = %q", got)
+	}
+	if got, _ := NewConverter(reloaded).TimeStringToTimestamp("2024-03-05", "日志", "UTC"); got != ts.Unix() {
+		t.Errorf("TimeStringToTimestamp(saved name) = %d", got)
+	}
+
+	if err := reloaded.Delete("日志"); err != nil {
+		t.Fatalf("Delete error = %v", err)
+	}
+	if err := reloaded.Delete("日志"); err == nil {
+		t.Error("expected error deleting missing format")
+	}
+	if len(newFormatStore(path).List()) != 0 {
+		t.Error("expected empty list after delete")
+	}
+}
@@ -0,0 +1,205 @@
+package domain
+
+import (
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// 时间戳单位
+const (
+	UnitAuto         = "auto"
+	UnitSeconds      = "s"
+	UnitMilliseconds = "ms"
+	UnitMicroseconds = "us"
+	UnitNanoseconds  = "ns"
+)
+
+// 自动识别单位时各单位的数值上限（绝对值），秒级上限约为公元 5138 年
+const (
+	secondsUpperBound      = 1e11
+	millisecondsUpperBound = 1e14
+	microsecondsUpperBound = 1e17
+)
+
+// 可表示的秒级时间戳范围：0001-01-01T00:00:00Z 至 9999-12-31T23:59:59Z
+const (
+	minTimestampSeconds = -62135596800
+	maxTimestampSeconds = 253402300799
+)
+
+// unitNanos 各单位对应的纳秒数
+var unitNanos = map[string]int64{
+	UnitSeconds:      int64(time.Second),
+	UnitMilliseconds: int64(time.Millisecond),
+	UnitMicroseconds: int64(time.Microsecond),
+	UnitNanoseconds:  1,
+}
+
+// TimestampConversion 时间戳在各单位下的表示
+// 数值以字符串返回，避免纳秒时间戳超出 JavaScript 安全整数范围而丢失精度
+type TimestampConversion struct {
+	Unit         string `json:"unit"`
+	Detected     bool   `json:"detected"`
+	Seconds      string `json:"seconds"`
+	Milliseconds string `json:"milliseconds"`
+	Microseconds string `json:"microseconds"`
+	Nanoseconds  string `json:"nanoseconds"`
+	Time         string `json:"time"`
+	RFC3339Nano  string `json:"rfc3339Nano"`
+}
+
+// DetectUnit 根据数值大小识别时间戳单位
+func DetectUnit(value int64) string {
+	abs := math.Abs(float64(value))
+	switch {
+	case abs < secondsUpperBound:
+		return UnitSeconds
+	case abs < millisecondsUpperBound:
+		return UnitMilliseconds
+	case abs < microsecondsUpperBound:
+		return UnitMicroseconds
+	default:
+		return UnitNanoseconds
+	}
+}
+
+// ParseTimestamp 解析时间戳字符串，unit 为 UnitAuto 时根据数值大小识别单位
+// 支持小数（如 1723293026.123）以及 "_"、"," 千位分隔符，返回时间与实际单位
+func (c *Converter) ParseTimestamp(input, unit string) (time.Time, string, error) {
+	cleaned := strings.NewReplacer("_", "", ",", "", " ", "").Replace(strings.TrimSpace(input))
+	if cleaned == "" {
+		return time.Time{}, "", errors.WithStack(ErrInvalidTimestamp)
+	}
+
+	intPart, fracPart, _ := strings.Cut(cleaned, ".")
+	negative := strings.HasPrefix(intPart, "-")
+	value, err := strconv.ParseInt(intPart, 10, 64)
+	if err != nil || (fracPart != "" && !isDigits(fracPart)) {
+		return time.Time{}, "", errors.Wrapf(ErrInvalidTimestamp, "无效的时间戳: %s", input)
+	}
+
+	if unit == "" || unit == UnitAuto {
+		unit = DetectUnit(value)
+	}
+	perUnit, ok := unitNanos[unit]
+	if !ok {
+		return time.Time{}, "", errors.Wrapf(ErrUnsupportedUnit, "不支持的时间戳单位: %s", unit)
+	}
+
+	// 拆分为秒与纳秒分别计算，超过 2262 年的秒级时间戳不会溢出 int64 纳秒
+	unitsPerSecond := int64(time.Second) / perUnit
+	sec, nsec := value/unitsPerSecond, value%unitsPerSecond*perUnit
+	if sec < minTimestampSeconds || sec > maxTimestampSeconds {
+		return time.Time{}, "", errors.Wrapf(ErrTimestampOutOfRange, "时间戳 %s 超出可表示范围", input)
+	}
+
+	// 小数部分按单位换算为纳秒，超出纳秒精度的位数截断
+	if fracPart != "" {
+		digits := len(strconv.FormatInt(perUnit, 10)) - 1
+		if len(fracPart) > digits {
+			fracPart = fracPart[:digits]
+		}
+		if fracPart != "" {
+			frac, _ := strconv.ParseInt(fracPart+strings.Repeat("0", digits-len(fracPart)), 10, 64)
+			if negative {
+				frac = -frac
+			}
+			nsec += frac
+		}
+	}
+
+	return time.Unix(sec, nsec), unit, nil
+}
+
+// ConvertTimestamp 将时间戳转换为时间字符串，同时返回秒、毫秒、微秒、纳秒四种表示
+func (c *Converter) ConvertTimestamp(input, unit, format, timezone string) (*TimestampConversion, error) {
+	detected := unit == "" || unit == UnitAuto
+	t, actualUnit, err := c.ParseTimestamp(input, unit)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := c.buildConversion(t, actualUnit, format, timezone)
+	if err != nil {
+		return nil, err
+	}
+	result.Detected = detected
+	return result, nil
+}
+
+// TimeStringToTimestamps 将时间字符串转换为秒、毫秒、微秒、纳秒四种时间戳，保留完整的小数秒精度
+func (c *Converter) TimeStringToTimestamps(timeStr, format, timezone string) (*TimestampConversion, error) {
+	t, err := c.parseTimeString(timeStr, format, timezone)
+	if err != nil {
+		return nil, err
+	}
+	return c.buildConversion(t, precisionUnit(t), format, timezone)
+}
+
+// buildConversion 组装各单位的表示，DateTime 格式按单位补齐小数秒位数
+func (c *Converter) buildConversion(t time.Time, unit, format, timezone string) (*TimestampConversion, error) {
+	if format == "DateTime" {
+		switch unit {
+		case UnitMilliseconds:
+			format = "2006-01-02 15:04:05.000"
+		case UnitMicroseconds:
+			format = "2006-01-02 15:04:05.000000"
+		case UnitNanoseconds:
+			format = "2006-01-02 15:04:05.000000000"
+		}
+	}
+	formatted, err := c.formatter.FormatTime(t, format, timezone)
+	if err != nil {
+		return nil, err
+	}
+	rfc3339Nano, err := c.formatter.FormatTime(t, "RFC3339Nano", timezone)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TimestampConversion{
+		Unit:         unit,
+		Seconds:      strconv.FormatInt(t.Unix(), 10),
+		Milliseconds: scaledUnix(t, int64(time.Millisecond)),
+		Microseconds: scaledUnix(t, int64(time.Microsecond)),
+		Nanoseconds:  scaledUnix(t, 1),
+		Time:         formatted,
+		RFC3339Nano:  rfc3339Nano,
+	}, nil
+}
+
+// scaledUnix 返回以 perUnit 纳秒为单位的 Unix 时间戳，超出 int64 纳秒范围的时间同样精确
+func scaledUnix(t time.Time, perUnit int64) string {
+	value := new(big.Int).Mul(big.NewInt(t.Unix()), big.NewInt(int64(time.Second)/perUnit))
+	return value.Add(value, big.NewInt(int64(t.Nanosecond())/perUnit)).String()
+}
+
+// precisionUnit 返回能无损表示该时间的最大单位
+func precisionUnit(t time.Time) string {
+	nanos := t.Nanosecond()
+	switch {
+	case nanos == 0:
+		return UnitSeconds
+	case nanos%int(time.Millisecond) == 0:
+		return UnitMilliseconds
+	case nanos%int(time.Microsecond) == 0:
+		return UnitMicroseconds
+	default:
+		return UnitNanoseconds
+	}
+}
+
+// isDigits 判断字符串是否全部由数字组成
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return s != ""
+}
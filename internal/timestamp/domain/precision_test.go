@@ -0,0 +1,87 @@
+package domain
+
+import (
+	"testing"
+)
+
+func TestDetectUnit(t *testing.T) {
+	tests := []struct {
+		value int64
+		want  string
+	}{
+		{value: 1723293026, want: UnitSeconds},
+		{value: 1723293026123, want: UnitMilliseconds},
+		{value: 1723293026123456, want: UnitMicroseconds},
+		{value: 1723293026123456789, want: UnitNanoseconds},
+		{value: -86400, want: UnitSeconds},
+		{value: 0, want: UnitSeconds},
+	}
+
+	for _, tt := range tests {
+		if got := DetectUnit(tt.value); got != tt.want {
+			t.Errorf("DetectUnit(%d) = %s, want %s", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestConverter_ConvertTimestamp(t *testing.T) {
+	converter := NewConverter(nil)
+
+	tests := []struct {
+		name     string
+		input    string
+		unit     string
+		wantUnit string
+		wantTime string
+		wantNano string
+	}{
+		{name: "seconds", input: "1723293026", unit: UnitAuto, wantUnit: UnitSeconds, wantTime: "2024-08-10 12:30:26", wantNano: "1723293026000000000"},
+		{name: "millis", input: "1723293026123", unit: UnitAuto, wantUnit: UnitMilliseconds, wantTime: "2024-08-10 12:30:26.123", wantNano: "1723293026123000000"},
+		{name: "micros", input: "1723293026123456", unit: UnitAuto, wantUnit: UnitMicroseconds, wantTime: "2024-08-10 12:30:26.123456", wantNano: "1723293026123456000"},
+		{name: "nanos", input: "1723293026123456789", unit: UnitAuto, wantUnit: UnitNanoseconds, wantTime: "2024-08-10 12:30:26.123456789", wantNano: "1723293026123456789"},
+		{name: "explicit unit", input: "1723293026", unit: UnitMilliseconds, wantUnit: UnitMilliseconds, wantTime: "1970-01-20 22:41:33.026", wantNano: "1723293026000000"},
+		{name: "decimal seconds", input: "1723293026.5", unit: UnitAuto, wantUnit: UnitSeconds, wantTime: "2024-08-10 12:30:26", wantNano: "1723293026500000000"},
+		{name: "separators", input: "1_723_293_026", unit: UnitAuto, wantUnit: UnitSeconds, wantTime: "2024-08-10 12:30:26", wantNano: "1723293026000000000"},
+		{name: "seconds beyond 2262", input: "10000000000", unit: UnitAuto, wantUnit: UnitSeconds, wantTime: "2286-11-20 17:46:40", wantNano: "10000000000000000000"},
+		{name: "largest auto seconds", input: "99999999999", unit: UnitAuto, wantUnit: UnitSeconds, wantTime: "5138-11-16 09:46:39", wantNano: "99999999999000000000"},
+		{name: "negative", input: "-1.5", unit: UnitSeconds, wantUnit: UnitSeconds, wantTime: "1969-12-31 23:59:58", wantNano: "-1500000000"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := converter.ConvertTimestamp(tt.input, tt.unit, "DateTime", "UTC")
+			if err != nil {
+				t.Fatalf("ConvertTimestamp() error = %v", err)
+			}
+			if got.Unit != tt.wantUnit || got.Time != tt.wantTime || got.Nanoseconds != tt.wantNano {
+				t.Errorf("ConvertTimestamp() = %+v", got)
+			}
+		})
+	}
+
+	for _, invalid := range []string{"", "abc", "12.3x", "99999999999999999999"} {
+		if _, err := converter.ConvertTimestamp(invalid, UnitAuto, "DateTime", "UTC"); err == nil {
+			t.Errorf("ConvertTimestamp(%q) should fail", invalid)
+		}
+	}
+	if _, err := converter.ConvertTimestamp("9223372036854775807", UnitSeconds, "DateTime", "UTC"); err == nil {
+		t.Errorf("ConvertTimestamp() should reject overflowing values")
+	}
+}
+
+func TestConverter_TimeStringToTimestamps(t *testing.T) {
+	converter := NewConverter(nil)
+
+	got, err := converter.TimeStringToTimestamps("2024-08-10T12:30:26.123456789Z", "RFC3339Nano", "UTC")
+	if err != nil {
+		t.Fatalf("TimeStringToTimestamps() error = %v", err)
+	}
+	if got.Seconds != "1723293026" || got.Milliseconds != "1723293026123" || got.Microseconds != "1723293026123456" || got.Nanoseconds != "1723293026123456789" {
+		t.Errorf("TimeStringToTimestamps() = %+v", got)
+	}
+
+	milli, err := converter.TimeStringToTimestampMilli("2024-08-10 12:30:26.123", "DateTime", "UTC")
+	if err != nil || milli != 1723293026123 {
+		t.Errorf("TimeStringToTimestampMilli() = %d, %v", milli, err)
+	}
+}
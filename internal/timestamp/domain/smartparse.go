@@ -0,0 +1,418 @@
+package domain
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// 智能解析的匹配类型
+const (
+	ParseKindLayout    = "layout"    // 按时间格式匹配
+	ParseKindRelative  = "relative"  // 相对时间表达式
+	ParseKindTimestamp = "timestamp" // 数字时间戳
+)
+
+// namedLayout 带名称的时间格式
+type namedLayout struct {
+	Name   string
+	Layout string
+}
+
+// smartLayouts 智能解析依次尝试的格式，越精确的格式越靠前
+var smartLayouts = []namedLayout{
+	// ISO 8601 / RFC 3339
+	{Name: "RFC3339", Layout: time.RFC3339},
+	{Name: "ISO 8601（无冒号时区）", Layout: "2006-01-02T15:04:05Z0700"},
+	{Name: "ISO 8601（本地时间）", Layout: "2006-01-02T15:04:05"},
+	{Name: "ISO 8601（到分钟）", Layout: "2006-01-02T15:04Z07:00"},
+	{Name: "ISO 8601（本地时间，到分钟）", Layout: "2006-01-02T15:04"},
+	{Name: "ISO 8601 基本格式", Layout: "20060102T150405Z0700"},
+	{Name: "ISO 8601 基本格式（本地时间）", Layout: "20060102T150405"},
+	// Go time.Time.String() 与 SQL
+	{Name: "Go time.String", Layout: "2006-01-02 15:04:05 -0700 MST"},
+	{Name: "日期时间（带时区）", Layout: "2006-01-02 15:04:05Z07:00"},
+	{Name: "日期时间（带偏移）", Layout: "2006-01-02 15:04:05 -0700"},
+	{Name: "SQL DATETIME", Layout: "2006-01-02 15:04:05"},
+	{Name: "SQL DATETIME（到分钟）", Layout: "2006-01-02 15:04"},
+	{Name: "SQL DATE", Layout: "2006-01-02"},
+	{Name: "斜杠日期时间", Layout: "2006/01/02 15:04:05"},
+	{Name: "斜杠日期时间（到分钟）", Layout: "2006/01/02 15:04"},
+	{Name: "斜杠日期", Layout: "2006/01/02"},
+	// RFC 2822 / RFC 1123 / RFC 850
+	{Name: "RFC2822", Layout: "Mon, 2 Jan 2006 15:04:05 -0700"},
+	{Name: "RFC1123", Layout: time.RFC1123},
+	{Name: "RFC2822（无星期）", Layout: "2 Jan 2006 15:04:05 -0700"},
+	{Name: "RFC850", Layout: time.RFC850},
+	{Name: "RFC822Z", Layout: time.RFC822Z},
+	{Name: "RFC822", Layout: time.RFC822},
+	// Apache/nginx 访问日志
+	{Name: "Apache/nginx 日志", Layout: "02/Jan/2006:15:04:05 -0700"},
+	// Unix date / Java Date.toString / syslog
+	{Name: "UnixDate", Layout: time.UnixDate},
+	{Name: "RubyDate", Layout: time.RubyDate},
+	{Name: "ANSIC", Layout: time.ANSIC},
+	{Name: "Stamp（syslog）", Layout: time.Stamp},
+	// 中文
+	{Name: "中文日期时间", Layout: "2006年1月2日 15:04:05"},
+	{Name: "中文日期时间（到分钟）", Layout: "2006年1月2日 15:04"},
+	{Name: "中文日期时间（时分秒）", Layout: "2006年1月2日 15时04分05秒"},
+	{Name: "中文日期时间（时分）", Layout: "2006年1月2日 15时04分"},
+	{Name: "中文日期", Layout: "2006年1月2日"},
+	// 仅时间（日期取今天）
+	{Name: "时间", Layout: "15:04:05"},
+	{Name: "时间（到分钟）", Layout: "15:04"},
+	{Name: "Kitchen", Layout: time.Kitchen},
+}
+
+var (
+	// spacePattern 连续空白
+	spacePattern = regexp.MustCompile(`\s+`)
+	// monotonicPattern Go time.String() 输出中的单调时钟部分
+	monotonicPattern = regexp.MustCompile(`\s+m=[+-][0-9.]+$`)
+	// chineseTimeGapPattern 中文日期与时间之间缺少空格的情况（2024年1月2日15:04）
+	chineseTimeGapPattern = regexp.MustCompile(`日(\d)`)
+	// nowOffsetPattern now 表达式：now、now-15m、now+1h30m、now-1d/d
+	nowOffsetPattern = regexp.MustCompile(`^now((?:\s*[+-](?:\s*\d+\s*(?:ms|s|m|h|d|w|M|y))+)*)(?:/([smhdwMy]))?$`)
+	// offsetTermPattern now 表达式中的单个偏移项，省略符号时沿用前一项的符号（now+1h30m）
+	offsetTermPattern = regexp.MustCompile(`([+-]?)\s*(\d+)\s*(ms|s|m|h|d|w|M|y)`)
+	// agoPattern 英文相对时间：15 minutes ago、in 2 hours
+	agoPattern = regexp.MustCompile(`^(?:(\d+)\s*([a-z]+)\s+ago|in\s+(\d+)\s*([a-z]+))$`)
+	// chineseAgoPattern 中文相对时间：3天前、2小时后
+	chineseAgoPattern = regexp.MustCompile(`^(\d+)\s*(秒|分钟|分|小时|天|日|周|星期|个月|月|年)(前|后|以前|以后|之前|之后)$`)
+	// dayWordPattern 日期关键字加可选时间：yesterday 09:00、今天 10:30
+	dayWordPattern = regexp.MustCompile(`^(now|today|yesterday|tomorrow|今天|昨天|明天|前天|后天)(?:\s*(\d{1,2}:\d{2}(?::\d{2})?))?$`)
+	// weekdayPattern 星期表达式：next monday、last fri 10:00
+	weekdayPattern = regexp.MustCompile(`^(next|last|this)\s+([a-z]+)(?:\s+(\d{1,2}:\d{2}(?::\d{2})?))?$`)
+)
+
+// englishUnits 英文时间单位与 now 表达式单位的对应关系
+var englishUnits = map[string]string{
+	"second": "s", "seconds": "s", "sec": "s", "secs": "s",
+	"minute": "m", "minutes": "m", "min": "m", "mins": "m",
+	"hour": "h", "hours": "h", "hr": "h", "hrs": "h",
+	"day": "d", "days": "d",
+	"week": "w", "weeks": "w",
+	"month": "M", "months": "M",
+	"year": "y", "years": "y",
+}
+
+// chineseUnits 中文时间单位与 now 表达式单位的对应关系
+var chineseUnits = map[string]string{
+	"秒": "s", "分钟": "m", "分": "m", "小时": "h", "天": "d", "日": "d",
+	"周": "w", "星期": "w", "个月": "M", "月": "M", "年": "y",
+}
+
+// weekdays 英文星期名称
+var weekdays = map[string]time.Weekday{
+	"sunday": time.Sunday, "sun": time.Sunday,
+	"monday": time.Monday, "mon": time.Monday,
+	"tuesday": time.Tuesday, "tue": time.Tuesday, "tues": time.Tuesday,
+	"wednesday": time.Wednesday, "wed": time.Wednesday,
+	"thursday": time.Thursday, "thu": time.Thursday, "thur": time.Thursday, "thurs": time.Thursday,
+	"friday": time.Friday, "fri": time.Friday,
+	"saturday": time.Saturday, "sat": time.Saturday,
+}
+
+// SmartParseResult 智能解析结果
+type SmartParseResult struct {
+	Input string `json:"input"`
+	Kind  string `json:"kind"`
+	// Matched 匹配到的格式名称或相对时间表达式说明
+	Matched string `json:"matched"`
+	// Layout 匹配到的 Go 时间格式，相对时间与时间戳为空
+	Layout string `json:"layout"`
+	// HasZone 输入中是否包含时区信息，不包含时按所选时区解释
+	HasZone    bool                 `json:"hasZone"`
+	Conversion *TimestampConversion `json:"conversion"`
+}
+
+// SmartParser 自动识别多种时间格式与相对时间表达式
+type SmartParser struct {
+	converter *Converter
+	now       func() time.Time
+}
+
+// NewSmartParser 创建新的 SmartParser 实例，formats 用于解析已保存的格式名称，可为 nil
+func NewSmartParser(formats *FormatStore) *SmartParser {
+	return &SmartParser{
+		converter: NewConverter(formats),
+		now:       time.Now,
+	}
+}
+
+// Parse 自动识别时间字符串，依次尝试时间戳、相对时间表达式与内置格式
+// 输入不含时区信息时按 timezone 解释，结果按 format 与 timezone 输出
+func (p *SmartParser) Parse(input, format, timezone string) (*SmartParseResult, error) {
+	loc, err := LoadLocation(timezone)
+	if err != nil {
+		return nil, err
+	}
+
+	t, unit, result, err := p.parse(input, loc)
+	if err != nil {
+		return nil, err
+	}
+	conversion, err := p.converter.buildConversion(t, unit, format, timezone)
+	if err != nil {
+		return nil, err
+	}
+	result.Conversion = conversion
+	return result, nil
+}
+
+// ParseTime 自动识别时间字符串并返回对应时刻，输入不含时区信息时按 timezone 解释
+func (p *SmartParser) ParseTime(input, timezone string) (time.Time, error) {
+	loc, err := LoadLocation(timezone)
+	if err != nil {
+		return time.Time{}, err
+	}
+	t, _, _, err := p.parse(input, loc)
+	return t, err
+}
+
+// parse 识别时间字符串，返回时刻、精度单位与不含转换结果的解析信息
+func (p *SmartParser) parse(input string, loc *time.Location) (time.Time, string, *SmartParseResult, error) {
+	normalized := normalizeTimeInput(input)
+	if normalized == "" {
+		return time.Time{}, "", nil, errors.WithStack(ErrEmptyTimeInput)
+	}
+
+	result := &SmartParseResult{Input: input}
+	var t time.Time
+	unit := ""
+
+	if parsed, layout, ok := p.parseCompactDate(normalized, loc); ok {
+		t = parsed
+		result.Kind, result.Matched, result.Layout = ParseKindLayout, layout.Name, layout.Layout
+	} else if parsed, detectedUnit, err := p.converter.ParseTimestamp(normalized, UnitAuto); err == nil && isNumeric(normalized) {
+		t, unit = parsed, detectedUnit
+		result.Kind, result.Matched, result.HasZone = ParseKindTimestamp, "Unix 时间戳（"+detectedUnit+"）", true
+	} else if parsed, description, ok := p.parseRelative(normalized, loc); ok {
+		t = parsed
+		result.Kind, result.Matched = ParseKindRelative, description
+	} else if parsed, layout, ok := p.parseLayouts(normalized, loc); ok {
+		t = parsed
+		result.Kind, result.Matched, result.Layout = ParseKindLayout, layout.Name, layout.Layout
+		result.HasZone = layoutHasZone(layout.Layout)
+	} else {
+		return time.Time{}, "", nil, errors.Wrapf(ErrUnrecognizedTime, "无法识别的时间: %s", input)
+	}
+
+	if unit == "" {
+		unit = precisionUnit(t)
+	}
+	return t, unit, result, nil
+}
+
+// compactLayouts 纯数字的紧凑日期格式，优先于时间戳识别
+var compactLayouts = []namedLayout{
+	{Name: "紧凑日期时间", Layout: "20060102150405"},
+	{Name: "紧凑日期", Layout: "20060102"},
+}
+
+// parseCompactDate 识别 8 位或 14 位纯数字日期（20240102、20240102150405），年份限定在 1900-2199
+func (p *SmartParser) parseCompactDate(input string, loc *time.Location) (time.Time, namedLayout, bool) {
+	for _, layout := range compactLayouts {
+		if len(input) != len(layout.Layout) || !isDigits(input) {
+			continue
+		}
+		t, err := time.ParseInLocation(layout.Layout, input, loc)
+		if err == nil && t.Year() >= 1900 && t.Year() < 2200 {
+			return t, layout, true
+		}
+	}
+	return time.Time{}, namedLayout{}, false
+}
+
+// normalizeTimeInput 去除引号、方括号、单调时钟后缀并合并空白
+func normalizeTimeInput(input string) string {
+	s := strings.TrimSpace(input)
+	s = strings.Trim(s, "\"'`")
+	if strings.HasPrefix(s, "[") && strings.HasSuffix(s, "]") {
+		s = s[1 : len(s)-1]
+	}
+	s = monotonicPattern.ReplaceAllString(s, "")
+	s = chineseTimeGapPattern.ReplaceAllString(s, "日 $1")
+	return spacePattern.ReplaceAllString(strings.TrimSpace(s), " ")
+}
+
+// isNumeric 判断输入是否为数字时间戳（允许小数与负号）
+func isNumeric(s string) bool {
+	s = strings.TrimPrefix(s, "-")
+	intPart, fracPart, hasFrac := strings.Cut(s, ".")
+	return isDigits(intPart) && (!hasFrac || isDigits(fracPart))
+}
+
+// parseLayouts 依次尝试内置格式
+func (p *SmartParser) parseLayouts(input string, loc *time.Location) (time.Time, namedLayout, bool) {
+	now := p.now().In(loc)
+	for _, layout := range smartLayouts {
+		t, err := time.ParseInLocation(layout.Layout, input, loc)
+		if err != nil {
+			continue
+		}
+		switch {
+		case !strings.Contains(layout.Layout, "2006") && !strings.Contains(layout.Layout, "06") && strings.Contains(layout.Layout, "Jan"):
+			// 缺少年份的格式（syslog）取当前年份
+			t = time.Date(now.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), loc)
+		case !strings.Contains(layout.Layout, "2006") && !strings.Contains(layout.Layout, "Jan"):
+			// 仅时间的格式取今天的日期
+			t = time.Date(now.Year(), now.Month(), now.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), loc)
+		}
+		return t, layout, true
+	}
+	return time.Time{}, namedLayout{}, false
+}
+
+// layoutHasZone 判断格式是否包含时区信息
+func layoutHasZone(layout string) bool {
+	return strings.Contains(layout, "Z07") || strings.Contains(layout, "-07") || strings.Contains(layout, "MST")
+}
+
+// parseRelative 解析相对时间表达式，返回时间与表达式说明
+func (p *SmartParser) parseRelative(input string, loc *time.Location) (time.Time, string, bool) {
+	now := p.now().In(loc)
+
+	if m := nowOffsetPattern.FindStringSubmatch(input); m != nil {
+		t := now
+		sign := "+"
+		for _, term := range offsetTermPattern.FindAllStringSubmatch(m[1], -1) {
+			if term[1] != "" {
+				sign = term[1]
+			}
+			n, _ := strconv.Atoi(term[2])
+			if sign == "-" {
+				n = -n
+			}
+			t = addUnit(t, term[3], n)
+		}
+		if m[2] != "" {
+			t = truncateToUnit(t, m[2])
+		}
+		return t, "相对时间: " + input, true
+	}
+
+	lower := strings.ToLower(input)
+	if m := agoPattern.FindStringSubmatch(lower); m != nil {
+		amount, unitWord, sign := m[1], m[2], -1
+		if amount == "" {
+			amount, unitWord, sign = m[3], m[4], 1
+		}
+		unit, ok := englishUnits[unitWord]
+		if !ok {
+			return time.Time{}, "", false
+		}
+		n, _ := strconv.Atoi(amount)
+		return addUnit(now, unit, sign*n), "相对时间: " + input, true
+	}
+
+	if m := chineseAgoPattern.FindStringSubmatch(input); m != nil {
+		n, _ := strconv.Atoi(m[1])
+		if strings.HasSuffix(m[3], "前") {
+			n = -n
+		}
+		return addUnit(now, chineseUnits[m[2]], n), "相对时间: " + input, true
+	}
+
+	if m := dayWordPattern.FindStringSubmatch(lower); m != nil {
+		if m[1] == "now" && m[2] == "" {
+			return now, "当前时间", true
+		}
+		offsets := map[string]int{"today": 0, "now": 0, "今天": 0, "yesterday": -1, "昨天": -1, "前天": -2, "tomorrow": 1, "明天": 1, "后天": 2}
+		day := now.AddDate(0, 0, offsets[m[1]])
+		t, ok := atClock(day, m[2], loc)
+		return t, "相对日期: " + input, ok
+	}
+
+	if m := weekdayPattern.FindStringSubmatch(lower); m != nil {
+		weekday, ok := weekdays[m[2]]
+		if !ok {
+			return time.Time{}, "", false
+		}
+		diff := int(weekday - now.Weekday())
+		switch m[1] {
+		case "next":
+			// next monday 指下一个周一（不含今天）
+			if diff <= 0 {
+				diff += 7
+			}
+		case "last":
+			if diff >= 0 {
+				diff -= 7
+			}
+		}
+		t, ok := atClock(now.AddDate(0, 0, diff), m[3], loc)
+		return t, "相对日期: " + input, ok
+	}
+
+	return time.Time{}, "", false
+}
+
+// atClock 将日期与时刻组合，未指定时刻时取当天零点
+func atClock(day time.Time, clock string, loc *time.Location) (time.Time, bool) {
+	hour, minute, second := 0, 0, 0
+	if clock != "" {
+		parts := strings.Split(clock, ":")
+		hour, _ = strconv.Atoi(parts[0])
+		minute, _ = strconv.Atoi(parts[1])
+		if len(parts) > 2 {
+			second, _ = strconv.Atoi(parts[2])
+		}
+		if hour > 23 || minute > 59 || second > 59 {
+			return time.Time{}, false
+		}
+	}
+	return time.Date(day.Year(), day.Month(), day.Day(), hour, minute, second, 0, loc), true
+}
+
+// addUnit 按单位增加时间，月与年按日历计算
+func addUnit(t time.Time, unit string, n int) time.Time {
+	switch unit {
+	case "ms":
+		return t.Add(time.Duration(n) * time.Millisecond)
+	case "s":
+		return t.Add(time.Duration(n) * time.Second)
+	case "m":
+		return t.Add(time.Duration(n) * time.Minute)
+	case "h":
+		return t.Add(time.Duration(n) * time.Hour)
+	case "d":
+		return t.AddDate(0, 0, n)
+	case "w":
+		return t.AddDate(0, 0, 7*n)
+	case "M":
+		return t.AddDate(0, n, 0)
+	case "y":
+		return t.AddDate(n, 0, 0)
+	default:
+		return t
+	}
+}
+
+// truncateToUnit 将时间截断到单位的起点（now/d 表示今天零点），周从周一开始
+func truncateToUnit(t time.Time, unit string) time.Time {
+	loc := t.Location()
+	switch unit {
+	case "s":
+		return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), 0, loc)
+	case "m":
+		return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), 0, 0, loc)
+	case "h":
+		return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, loc)
+	case "d":
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+	case "w":
+		offset := (int(t.Weekday()) + 6) % 7
+		return time.Date(t.Year(), t.Month(), t.Day()-offset, 0, 0, 0, 0, loc)
+	case "M":
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, loc)
+	case "y":
+		return time.Date(t.Year(), time.January, 1, 0, 0, 0, 0, loc)
+	default:
+		return t
+	}
+}
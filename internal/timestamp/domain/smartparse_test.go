@@ -0,0 +1,92 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func newTestSmartParser() *SmartParser {
+	parser := NewSmartParser(nil)
+	// 2024-01-03 10:20:30 UTC，星期三
+	parser.now = func() time.Time { return time.Date(2024, 1, 3, 10, 20, 30, 0, time.UTC) }
+	return parser
+}
+
+func TestSmartParser_ParseLayouts(t *testing.T) {
+	parser := newTestSmartParser()
+
+	tests := []struct {
+		input       string
+		wantMatched string
+		wantTime    string
+	}{
+		{input: "2024-01-02T15:04:05+08:00", wantMatched: "RFC3339", wantTime: "2024-01-02T07:04:05Z"},
+		{input: "2024-01-02T15:04:05.123456Z", wantMatched: "RFC3339", wantTime: "2024-01-02T15:04:05.123456Z"},
+		{input: "2024-01-02T15:04:05+0800", wantMatched: "ISO 8601（无冒号时区）", wantTime: "2024-01-02T07:04:05Z"},
+		{input: "20240102T150405Z", wantMatched: "ISO 8601 基本格式", wantTime: "2024-01-02T15:04:05Z"},
+		{input: "2024-01-02 15:04:05", wantMatched: "SQL DATETIME", wantTime: "2024-01-02T15:04:05Z"},
+		{input: "2024-01-02 15:04:05.5 +0800 CST m=+0.001", wantMatched: "Go time.String", wantTime: "2024-01-02T07:04:05.5Z"},
+		{input: "Tue, 2 Jan 2024 15:04:05 +0800", wantMatched: "RFC2822", wantTime: "2024-01-02T07:04:05Z"},
+		{input: "[02/Jan/2024:15:04:05 +0800]", wantMatched: "Apache/nginx 日志", wantTime: "2024-01-02T07:04:05Z"},
+		{input: "Tue Jan  2 15:04:05 2024", wantMatched: "ANSIC", wantTime: "2024-01-02T15:04:05Z"},
+		{input: "Jan  2 15:04:05", wantMatched: "Stamp（syslog）", wantTime: "2024-01-02T15:04:05Z"},
+		{input: "2024年1月2日 15:04", wantMatched: "中文日期时间（到分钟）", wantTime: "2024-01-02T15:04:00Z"},
+		{input: "2024年1月2日15时04分05秒", wantMatched: "中文日期时间（时分秒）", wantTime: "2024-01-02T15:04:05Z"},
+		{input: "20240102", wantMatched: "紧凑日期", wantTime: "2024-01-02T00:00:00Z"},
+		{input: "1704207845", wantMatched: "Unix 时间戳（s）", wantTime: "2024-01-02T15:04:05Z"},
+		{input: "09:15", wantMatched: "时间（到分钟）", wantTime: "2024-01-03T09:15:00Z"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := parser.Parse(tt.input, "RFC3339Nano", "UTC")
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if got.Matched != tt.wantMatched || got.Conversion.Time != tt.wantTime {
+				t.Errorf("Parse() = %s / %s, want %s / %s", got.Matched, got.Conversion.Time, tt.wantMatched, tt.wantTime)
+			}
+		})
+	}
+
+	if _, err := parser.Parse("not a date", "RFC3339", "UTC"); err == nil {
+		t.Errorf("Parse() should reject unknown input")
+	}
+}
+
+func TestSmartParser_ParseRelative(t *testing.T) {
+	parser := newTestSmartParser()
+
+	tests := []struct {
+		input    string
+		wantTime string
+	}{
+		{input: "now", wantTime: "2024-01-03T10:20:30Z"},
+		{input: "now-15m", wantTime: "2024-01-03T10:05:30Z"},
+		{input: "now+1h30m", wantTime: "2024-01-03T11:50:30Z"},
+		{input: "now - 2h", wantTime: "2024-01-03T08:20:30Z"},
+		{input: "now-1d/d", wantTime: "2024-01-02T00:00:00Z"},
+		{input: "now-1M", wantTime: "2023-12-03T10:20:30Z"},
+		{input: "yesterday 09:00", wantTime: "2024-01-02T09:00:00Z"},
+		{input: "Tomorrow", wantTime: "2024-01-04T00:00:00Z"},
+		{input: "next monday", wantTime: "2024-01-08T00:00:00Z"},
+		{input: "last wed 18:30", wantTime: "2023-12-27T18:30:00Z"},
+		{input: "this friday", wantTime: "2024-01-05T00:00:00Z"},
+		{input: "15 minutes ago", wantTime: "2024-01-03T10:05:30Z"},
+		{input: "in 2 days", wantTime: "2024-01-05T10:20:30Z"},
+		{input: "3天前", wantTime: "2023-12-31T10:20:30Z"},
+		{input: "昨天 08:00", wantTime: "2024-01-02T08:00:00Z"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := parser.Parse(tt.input, "RFC3339", "UTC")
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if got.Kind != ParseKindRelative || got.Conversion.Time != tt.wantTime {
+				t.Errorf("Parse() = %s %s, want %s", got.Kind, got.Conversion.Time, tt.wantTime)
+			}
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package domain
+
+import (
+	_ "embed"
+	"sort"
+	"strings"
+	"time"
+	// 内嵌时区数据库，保证在没有系统时区数据的平台（如 Windows）上也能加载 IANA 时区
+	_ "time/tzdata"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// maxTimezoneSuggestions 无效时区时给出的候选数量
+	maxTimezoneSuggestions = 5
+)
+
+//go:embed zones.txt
+var zonesData string
+
+// zoneNames IANA 时区名称列表，用于给出相近的候选
+var zoneNames = strings.Fields(zonesData)
+
+// ListTimezones 返回所有已知的 IANA 时区名称
+func ListTimezones() []string {
+	result := make([]string, len(zoneNames))
+	copy(result, zoneNames)
+	return result
+}
+
+// LoadLocation 加载时区，名称无效时返回包含相近候选的错误
+// 空字符串与 "UTC" 表示 UTC，"Local" 表示系统本地时区
+func LoadLocation(name string) (*time.Location, error) {
+	loc, err := time.LoadLocation(strings.TrimSpace(name))
+	if err == nil {
+		return loc, nil
+	}
+	suggestions := SuggestTimezones(name)
+	if len(suggestions) == 0 {
+		return nil, errors.Wrapf(ErrInvalidTimezone, "未知时区: %s", name)
+	}
+	return nil, errors.Wrapf(ErrInvalidTimezone, "未知时区: %s，是否要输入: %s", name, strings.Join(suggestions, ", "))
+}
+
+// SuggestTimezones 返回与输入相近的时区名称，按相似度排序
+// 依次考虑：忽略大小写后相同、城市名相同、包含关系以及编辑距离
+func SuggestTimezones(name string) []string {
+	query := normalizeZoneName(name)
+	if query == "" {
+		return nil
+	}
+
+	type candidate struct {
+		name  string
+		score int
+	}
+	var candidates []candidate
+	for _, zone := range zoneNames {
+		full := normalizeZoneName(zone)
+		city := full[strings.LastIndex(full, "/")+1:]
+		score := -1
+		switch {
+		case full == query:
+			score = 0
+		case city == query:
+			score = 1
+		case strings.Contains(full, query) || (len(city) >= 4 && strings.Contains(query, city)):
+			score = 2
+		default:
+			distance := min(levenshtein(query, full), levenshtein(query, city))
+			// 允许的编辑距离随长度增加，至多 3
+			if distance <= min(3, max(1, len(query)/4)) {
+				score = 2 + distance
+			}
+		}
+		if score >= 0 {
+			candidates = append(candidates, candidate{name: zone, score: score})
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].score < candidates[j].score })
+	result := make([]string, 0, maxTimezoneSuggestions)
+	for _, c := range candidates {
+		if len(result) == maxTimezoneSuggestions {
+			break
+		}
+		result = append(result, c.name)
+	}
+	return result
+}
+
+// normalizeZoneName 统一大小写与分隔符，便于比较
+func normalizeZoneName(name string) string {
+	name = strings.ToLower(strings.TrimSpace(name))
+	return strings.NewReplacer(" ", "_", "-", "_").Replace(name)
+}
+
+// levenshtein 计算两个字符串的编辑距离
+func levenshtein(a, b string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
@@ -0,0 +1,177 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// DefaultWorkStartHour 默认工作时间开始（本地时间）
+	DefaultWorkStartHour = 9
+	// DefaultWorkEndHour 默认工作时间结束（本地时间）
+	DefaultWorkEndHour = 18
+)
+
+// DefaultWorldClockZones 未指定时区列表时使用的默认时区（上海、柏林、旧金山）
+var DefaultWorldClockZones = []string{"Asia/Shanghai", "Europe/Berlin", "America/Los_Angeles"}
+
+// ZoneTime 某一时刻在指定时区的表示
+type ZoneTime struct {
+	Zone         string `json:"zone"`
+	Time         string `json:"time"`
+	Offset       string `json:"offset"`
+	OffsetSecond int    `json:"offsetSecond"`
+	Abbreviation string `json:"abbreviation"`
+	IsDST        bool   `json:"isDst"`
+	// DayOffset 本地日期相对于第一个时区日期的天数差（-1 表示前一天）
+	DayOffset int `json:"dayOffset"`
+}
+
+// WorldClock 提供多时区时间展示与会议时间规划
+type WorldClock struct {
+	formatter *Formatter
+}
+
+// NewWorldClock 创建新的 WorldClock 实例，formats 用于解析已保存的格式名称，可为 nil
+func NewWorldClock(formats *FormatStore) *WorldClock {
+	return &WorldClock{
+		formatter: NewFormatter(formats),
+	}
+}
+
+// Render 将同一时刻按时区列表依次展示，包含 UTC 偏移、夏令时标记与时区缩写
+// zones 为空时使用 DefaultWorldClockZones
+func (w *WorldClock) Render(t time.Time, zones []string, format string) ([]ZoneTime, error) {
+	if len(zones) == 0 {
+		zones = DefaultWorldClockZones
+	}
+	locations, err := loadLocations(zones)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]ZoneTime, len(zones))
+	reference := t.In(locations[0])
+	for i, loc := range locations {
+		local := t.In(loc)
+		formatted, err := w.formatter.FormatTime(t, format, loc.String())
+		if err != nil {
+			return nil, err
+		}
+		abbreviation, offset := local.Zone()
+		result[i] = ZoneTime{
+			Zone:         zones[i],
+			Time:         formatted,
+			Offset:       formatOffset(offset),
+			OffsetSecond: offset,
+			Abbreviation: abbreviation,
+			IsDST:        local.IsDST(),
+			DayOffset:    dayDiff(reference, local),
+		}
+	}
+	return result, nil
+}
+
+// MeetingCell 会议规划表中某一时刻在某个时区的情况
+type MeetingCell struct {
+	Zone      string `json:"zone"`
+	Time      string `json:"time"`
+	DayOffset int    `json:"dayOffset"`
+	IsWorking bool   `json:"isWorking"`
+}
+
+// MeetingSlot 会议规划表中的一行（第一个时区的一个整点）
+type MeetingSlot struct {
+	UTC        string        `json:"utc"`
+	Cells      []MeetingCell `json:"cells"`
+	AllWorking bool          `json:"allWorking"`
+}
+
+// MeetingPlan 会议规划结果
+type MeetingPlan struct {
+	Date  string        `json:"date"`
+	Zones []ZoneTime    `json:"zones"`
+	Slots []MeetingSlot `json:"slots"`
+	// OverlapSlots 所有时区都处于工作时间的行下标
+	OverlapSlots []int `json:"overlapSlots"`
+}
+
+// Plan 以第一个时区的日期 date（2006-01-02）为基准，按小时列出各时区本地时间与工作时间重叠情况
+// workStart、workEnd 为本地工作时间的起止小时，取值不合法时使用 9-18 点；zones 为空时使用 DefaultWorldClockZones
+func (w *WorldClock) Plan(date string, zones []string, workStart, workEnd int) (*MeetingPlan, error) {
+	if len(zones) == 0 {
+		zones = DefaultWorldClockZones
+	}
+	if workStart < 0 || workEnd > 24 || workStart >= workEnd {
+		workStart, workEnd = DefaultWorkStartHour, DefaultWorkEndHour
+	}
+	locations, err := loadLocations(zones)
+	if err != nil {
+		return nil, err
+	}
+
+	day, err := time.ParseInLocation("2006-01-02", date, locations[0])
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse date")
+	}
+
+	plan := &MeetingPlan{Date: date, Slots: []MeetingSlot{}, OverlapSlots: []int{}}
+	if plan.Zones, err = w.Render(day.Add(12*time.Hour), zones, "DateTime"); err != nil {
+		return nil, err
+	}
+
+	// 按绝对时间逐小时推进，夏令时切换日的行数为 23 或 25
+	next := day.AddDate(0, 0, 1)
+	for t := day; t.Before(next); t = t.Add(time.Hour) {
+		slot := MeetingSlot{UTC: t.UTC().Format(time.RFC3339), AllWorking: true}
+		for i, loc := range locations {
+			local := t.In(loc)
+			minutes := local.Hour()*60 + local.Minute()
+			working := minutes >= workStart*60 && minutes < workEnd*60 && local.Weekday() != time.Saturday && local.Weekday() != time.Sunday
+			slot.Cells = append(slot.Cells, MeetingCell{
+				Zone:      zones[i],
+				Time:      local.Format("15:04"),
+				DayOffset: dayDiff(t.In(locations[0]), local),
+				IsWorking: working,
+			})
+			slot.AllWorking = slot.AllWorking && working
+		}
+		if slot.AllWorking {
+			plan.OverlapSlots = append(plan.OverlapSlots, len(plan.Slots))
+		}
+		plan.Slots = append(plan.Slots, slot)
+	}
+	return plan, nil
+}
+
+// loadLocations 批量加载时区，任意一个无效时返回错误
+func loadLocations(zones []string) ([]*time.Location, error) {
+	locations := make([]*time.Location, len(zones))
+	for i, zone := range zones {
+		loc, err := LoadLocation(zone)
+		if err != nil {
+			return nil, err
+		}
+		locations[i] = loc
+	}
+	return locations, nil
+}
+
+// formatOffset 格式化 UTC 偏移，如 +08:00、-03:30
+func formatOffset(offsetSeconds int) string {
+	sign := '+'
+	if offsetSeconds < 0 {
+		sign = '-'
+		offsetSeconds = -offsetSeconds
+	}
+	return fmt.Sprintf("%c%02d:%02d", sign, offsetSeconds/3600, offsetSeconds%3600/60)
+}
+
+// dayDiff 计算两个本地日期之间相差的天数
+func dayDiff(reference, local time.Time) int {
+	refDate := time.Date(reference.Year(), reference.Month(), reference.Day(), 0, 0, 0, 0, time.UTC)
+	localDate := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, time.UTC)
+	return int(localDate.Sub(refDate).Hours() / 24)
+}
@@ -0,0 +1,111 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+func TestLoadLocationSuggestions(t *testing.T) {
+	if _, err := LoadLocation("Asia/Shanghai"); err != nil {
+		t.Fatalf("LoadLocation(Asia/Shanghai) error = %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{name: "大小写错误", input: "asia/shanghai", want: "Asia/Shanghai"},
+		{name: "仅城市名", input: "Berlin", want: "Europe/Berlin"},
+		{name: "空格代替下划线", input: "Los Angeles", want: "America/Los_Angeles"},
+		{name: "拼写错误", input: "Europe/Berlni", want: "Europe/Berlin"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := LoadLocation(tt.input)
+			if !errors.Is(err, ErrInvalidTimezone) {
+				t.Fatalf("LoadLocation(%q) error = %v, want ErrInvalidTimezone", tt.input, err)
+			}
+			if !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("LoadLocation(%q) error = %q, want suggestion %q", tt.input, err.Error(), tt.want)
+			}
+		})
+	}
+}
+
+func TestInvalidTimezoneNoLongerFallsBack(t *testing.T) {
+	if _, err := NewFormatter(nil).FormatTime(time.Unix(0, 0), "DateTime", "Mars/Olympus"); !errors.Is(err, ErrInvalidTimezone) {
+		t.Errorf("FormatTime error = %v, want ErrInvalidTimezone", err)
+	}
+	if _, err := NewConverter(nil).TimeStringToTimestamp("2024-01-01 00:00:00", "DateTime", "Mars/Olympus"); !errors.Is(err, ErrInvalidTimezone) {
+		t.Errorf("TimeStringToTimestamp error = %v, want ErrInvalidTimezone", err)
+	}
+}
+
+func TestWorldClockRender(t *testing.T) {
+	clock := NewWorldClock(nil)
+	// 2024-07-01 02:00 UTC：上海 10:00，柏林 04:00（夏令时），旧金山前一天 19:00（夏令时）
+	instant := time.Date(2024, 7, 1, 2, 0, 0, 0, time.UTC)
+	zones := []string{"Asia/Shanghai", "Europe/Berlin", "America/Los_Angeles"}
+
+	result, err := clock.Render(instant, zones, "DateTime")
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+
+	want := []ZoneTime{
+		{Zone: "Asia/Shanghai", Time: "2024-07-01 10:00:00", Offset: "+08:00", OffsetSecond: 8 * 3600, Abbreviation: "CST"},
+		{Zone: "Europe/Berlin", Time: "2024-07-01 04:00:00", Offset: "+02:00", OffsetSecond: 2 * 3600, Abbreviation: "CEST", IsDST: true},
+		{Zone: "America/Los_Angeles", Time: "2024-06-30 19:00:00", Offset: "-07:00", OffsetSecond: -7 * 3600, Abbreviation: "PDT", IsDST: true, DayOffset: -1},
+	}
+	for i := range want {
+		if result[i] != want[i] {
+			t.Errorf("Render()[%d] = %+v, want %+v", i, result[i], want[i])
+		}
+	}
+
+	if _, err := clock.Render(instant, []string{"Asia/Shanghai", "Europe/Berln"}, "DateTime"); !errors.Is(err, ErrInvalidTimezone) {
+		t.Errorf("Render() with invalid zone error = %v, want ErrInvalidTimezone", err)
+	}
+}
+
+func TestWorldClockPlan(t *testing.T) {
+	clock := NewWorldClock(nil)
+	zones := []string{"Asia/Shanghai", "Europe/Berlin", "America/Los_Angeles"}
+
+	plan, err := clock.Plan("2024-07-02", zones, 9, 18)
+	if err != nil {
+		t.Fatalf("Plan() error = %v", err)
+	}
+	if len(plan.Slots) != 24 {
+		t.Fatalf("Plan() slots = %d, want 24", len(plan.Slots))
+	}
+	// 上海、柏林与旧金山的 9-18 点没有共同时段
+	if len(plan.OverlapSlots) != 0 {
+		t.Errorf("Plan() overlap = %v, want none", plan.OverlapSlots)
+	}
+
+	// 上海 15:00 = 柏林 09:00，两地均处于工作时间
+	plan, err = clock.Plan("2024-07-02", zones[:2], 9, 18)
+	if err != nil {
+		t.Fatalf("Plan() error = %v", err)
+	}
+	if got, want := plan.OverlapSlots, []int{15, 16, 17}; len(got) != len(want) || got[0] != want[0] || got[2] != want[2] {
+		t.Errorf("Plan() overlap = %v, want %v", got, want)
+	}
+	if cell := plan.Slots[15].Cells[1]; cell.Time != "09:00" || !cell.IsWorking {
+		t.Errorf("Plan() slot 15 Berlin cell = %+v", cell)
+	}
+
+	// 柏林夏令时结束当天只有 25 个小时
+	plan, err = clock.Plan("2024-10-27", []string{"Europe/Berlin"}, 9, 18)
+	if err != nil {
+		t.Fatalf("Plan() error = %v", err)
+	}
+	if len(plan.Slots) != 25 {
+		t.Errorf("Plan() slots on DST end = %d, want 25", len(plan.Slots))
+	}
+}
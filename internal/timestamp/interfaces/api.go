@@ -52,6 +52,91 @@ func (a *API) GetCurrentTimestampMilli() int64 {
 	return a.service.GetCurrentTimestampMilli()
 }
 
+// ConvertTimestamp 时间戳转时间字符串，并返回四种单位的时间戳
+func (a *API) ConvertTimestamp(input, unit, format, timezone string) (*domain.TimestampConversion, error) {
+	return a.service.ConvertTimestamp(input, unit, format, timezone)
+}
+
+// TimeStringToTimestamps 时间字符串转四种单位的时间戳
+func (a *API) TimeStringToTimestamps(timeStr, format, timezone string) (*domain.TimestampConversion, error) {
+	return a.service.TimeStringToTimestamps(timeStr, format, timezone)
+}
+
+// SmartParse 自动识别时间格式或相对时间表达式
+func (a *API) SmartParse(input, format, timezone string) (*domain.SmartParseResult, error) {
+	return a.service.SmartParse(input, format, timezone)
+}
+
+// WorldClock 将同一时刻展示在多个时区
+func (a *API) WorldClock(input, format string, zones []string) ([]domain.ZoneTime, error) {
+	return a.service.WorldClock(input, format, zones)
+}
+
+// MeetingPlanner 规划多时区会议时间
+func (a *API) MeetingPlanner(date string, zones []string, workStart, workEnd int) (*domain.MeetingPlan, error) {
+	return a.service.MeetingPlanner(date, zones, workStart, workEnd)
+}
+
+// SuggestTimezones 返回与输入相近的时区名称
+func (a *API) SuggestTimezones(name string) []string {
+	return a.service.SuggestTimezones(name)
+}
+
+// ListTimezones 返回所有已知的 IANA 时区名称
+func (a *API) ListTimezones() []string {
+	return a.service.ListTimezones()
+}
+
+// AddPeriod 对时间加减时长表达式
+func (a *API) AddPeriod(input, expr, format, timezone string) (*domain.ArithmeticResult, error) {
+	return a.service.AddPeriod(input, expr, format, timezone)
+}
+
+// Difference 计算两个时间的差值
+func (a *API) Difference(from, to, format, timezone string) (*domain.TimeDifference, error) {
+	return a.service.Difference(from, to, format, timezone)
+}
+
+// BusinessDays 统计两个日期之间的工作日数
+func (a *API) BusinessDays(from, to, timezone string, holidays []string) (*domain.BusinessDayResult, error) {
+	return a.service.BusinessDays(from, to, timezone, holidays)
+}
+
+// AddBusinessDays 对时间加减工作日
+func (a *API) AddBusinessDays(input string, days int, format, timezone string, holidays []string) (*domain.ArithmeticResult, error) {
+	return a.service.AddBusinessDays(input, days, format, timezone, holidays)
+}
+
+// ParseDuration 解析 Go time.Duration 字符串
+func (a *API) ParseDuration(input string) (*domain.DurationInfo, error) {
+	return a.service.ParseDuration(input)
+}
+
+// TranslateLayout 在 Go 布局、strftime、Java 与 moment.js 格式之间转换
+func (a *API) TranslateLayout(pattern, syntax, timezone string) (*domain.LayoutTranslation, error) {
+	return a.service.TranslateLayout(pattern, syntax, timezone)
+}
+
+// ListFormats 获取内置格式与自定义格式
+func (a *API) ListFormats() []domain.FormatInfo {
+	return a.service.ListFormats()
+}
+
+// SaveFormat 保存命名格式
+func (a *API) SaveFormat(name, pattern, syntax string) ([]domain.FormatInfo, error) {
+	return a.service.SaveFormat(name, pattern, syntax)
+}
+
+// DeleteFormat 删除命名格式
+func (a *API) DeleteFormat(name string) ([]domain.FormatInfo, error) {
+	return a.service.DeleteFormat(name)
+}
+
+// BatchConvert 批量转换文本中的时间戳或时间字符串
+func (a *API) BatchConvert(text string, opts domain.BatchOptions) (*domain.BatchResult, error) {
+	return a.service.BatchConvert(text, opts)
+}
+
 // ListTimestampHistory 获取历史记录
 func (a *API) ListTimestampHistory() ([]domain.HistoryRecord, error) {
 	return a.service.ListTimestampHistory()
@@ -1,15 +1,35 @@
 package application
 
 import (
+	"encoding/json"
+	"strings"
+
 	historydomain "github.com/cyrnicolase/dev-tools/internal/history/domain"
+	jsondomain "github.com/cyrnicolase/dev-tools/internal/json/domain"
 	"github.com/cyrnicolase/dev-tools/internal/url/domain"
 	"github.com/pkg/errors"
 )
 
+// CurlParseResult curl 命令解析结果
+type CurlParseResult struct {
+	*domain.CurlRequest
+	// FormattedBody JSON 请求体的格式化结果，表单请求体转换为 JSON 后的结果
+	FormattedBody string `json:"formattedBody"`
+}
+
 // Service URL 工具服务层
 type Service struct {
 	encoder        *domain.Encoder
 	decoder        *domain.Decoder
+	parser         *domain.Parser
+	builder        *domain.Builder
+	queryJSON      *domain.QueryJSONConverter
+	jsonFormatter  *jsondomain.Formatter
+	idnConverter   *domain.IDNConverter
+	scriptAnalyzer *domain.ScriptAnalyzer
+	normalizer     *domain.Normalizer
+	curlParser     *domain.CurlParser
+	codeGenerator  *domain.CodeGenerator
 	historyStore   *historydomain.ToolHistoryStore
 	historyInitErr error
 }
@@ -22,6 +42,15 @@ func NewService() *Service {
 	return &Service{
 		encoder:        domain.NewEncoder(),
 		decoder:        domain.NewDecoder(),
+		parser:         domain.NewParser(),
+		builder:        domain.NewBuilder(),
+		queryJSON:      domain.NewQueryJSONConverter(),
+		jsonFormatter:  jsondomain.NewFormatter(),
+		idnConverter:   domain.NewIDNConverter(),
+		scriptAnalyzer: domain.NewScriptAnalyzer(),
+		normalizer:     domain.NewNormalizer(),
+		curlParser:     domain.NewCurlParser(),
+		codeGenerator:  domain.NewCodeGenerator(),
 		historyStore:   historyStore,
 		historyInitErr: historyErr,
 	}
@@ -37,6 +66,123 @@ func (s *Service) Decode(input string) (string, error) {
 	return s.decoder.Decode(input)
 }
 
+// ListModes 返回所有支持的编码模式
+func (s *Service) ListModes() []domain.ModeInfo {
+	return domain.ListModes()
+}
+
+// EncodeMode 使用指定模式编码
+func (s *Service) EncodeMode(mode, input string) (string, error) {
+	return s.encoder.EncodeMode(mode, input)
+}
+
+// DecodeMode 使用指定模式解码
+func (s *Service) DecodeMode(mode, input string) (string, error) {
+	return s.decoder.DecodeMode(mode, input)
+}
+
+// DecodeRecursive 递归解码多次编码的字符串
+func (s *Service) DecodeRecursive(mode, input string) (*domain.RecursiveDecodeResult, error) {
+	return s.decoder.DecodeRecursive(mode, input)
+}
+
+// Parse 将 URL 拆分为各组成部分
+func (s *Service) Parse(rawURL string) (*domain.URLComponents, error) {
+	return s.parser.Parse(rawURL)
+}
+
+// Build 将各组成部分重新组装为 URL
+func (s *Service) Build(components *domain.URLComponents) (string, error) {
+	return s.builder.Build(components)
+}
+
+// ListConventions 返回所有支持的嵌套键约定
+func (s *Service) ListConventions() []domain.ConventionInfo {
+	return domain.ListConventions()
+}
+
+// QueryToJSON 将查询字符串或表单请求体按指定约定转换为格式化的 JSON
+func (s *Service) QueryToJSON(input, convention string) (string, error) {
+	value, err := s.queryJSON.ToValue(input, convention)
+	if err != nil {
+		return "", err
+	}
+	return s.jsonFormatter.FormatValue(value)
+}
+
+// JSONToQuery 将 JSON 对象按指定约定转换为查询字符串
+func (s *Service) JSONToQuery(input, convention string) (string, error) {
+	decoder := json.NewDecoder(strings.NewReader(input))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return "", errors.Wrapf(err, "failed to parse JSON")
+	}
+
+	params, err := s.queryJSON.FromValue(value, convention)
+	if err != nil {
+		return "", err
+	}
+	return domain.BuildNestedQuery(params), nil
+}
+
+// ConvertIDN 在国际化域名的 Unicode 与 Punycode 形式之间转换
+func (s *Service) ConvertIDN(input string) (*domain.IDNResult, error) {
+	return s.idnConverter.Convert(input)
+}
+
+// AnalyzeScripts 分析字符串中的文字系统混用与易混淆字符
+func (s *Service) AnalyzeScripts(input string) *domain.ScriptReport {
+	return s.scriptAnalyzer.AnalyzeHost(input)
+}
+
+// Normalize 使用指定形式进行 Unicode 规范化
+func (s *Service) Normalize(input, form string) (*domain.NormalizationResult, error) {
+	return s.normalizer.Normalize(input, form)
+}
+
+// ParseCurl 解析 curl 命令，JSON 与表单请求体同时给出格式化后的 JSON
+func (s *Service) ParseCurl(command string) (*CurlParseResult, error) {
+	req, err := s.curlParser.Parse(command)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &CurlParseResult{CurlRequest: req}
+	switch req.BodyType {
+	case domain.BodyTypeJSON:
+		// 请求体不是合法 JSON 时保留原文，不视为错误
+		if formatted, err := s.formatJSONBody(req.Body); err == nil {
+			result.FormattedBody = formatted
+		}
+	case domain.BodyTypeForm:
+		if formatted, err := s.QueryToJSON(req.Body, domain.ConventionFlat); err == nil {
+			result.FormattedBody = formatted
+		}
+	}
+	return result, nil
+}
+
+// ConvertCurl 将 curl 命令转换为指定目标的代码
+func (s *Service) ConvertCurl(command, target string) (string, error) {
+	req, err := s.curlParser.Parse(command)
+	if err != nil {
+		return "", err
+	}
+	return s.codeGenerator.Generate(req, target)
+}
+
+// formatJSONBody 格式化 JSON 请求体，保留数字精度
+func (s *Service) formatJSONBody(body string) (string, error) {
+	decoder := json.NewDecoder(strings.NewReader(body))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return "", errors.WithStack(err)
+	}
+	return s.jsonFormatter.FormatValue(value)
+}
+
 // ListHistory 获取历史记录
 func (s *Service) ListHistory() ([]historydomain.ToolHistoryRecord, error) {
 	if s.historyInitErr != nil || s.historyStore == nil {
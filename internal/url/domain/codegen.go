@@ -0,0 +1,336 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// 代码生成目标
+const (
+	TargetGo     = "go"     // Go net/http
+	TargetPython = "python" // Python requests
+	TargetFetch  = "fetch"  // JavaScript fetch
+	TargetHTTPie = "httpie" // HTTPie 命令行
+)
+
+// shellSafePattern 无需引号的 shell 参数
+var shellSafePattern = regexp.MustCompile(`^[A-Za-z0-9_./:=@%+,-]+$`)
+
+// CodeGenerator 将解析后的请求转换为各语言的代码
+type CodeGenerator struct{}
+
+// NewCodeGenerator 创建新的 CodeGenerator 实例
+func NewCodeGenerator() *CodeGenerator {
+	return &CodeGenerator{}
+}
+
+// Generate 生成指定目标的请求代码
+func (g *CodeGenerator) Generate(req *CurlRequest, target string) (string, error) {
+	switch target {
+	case TargetGo:
+		return g.generateGo(req), nil
+	case TargetPython:
+		return g.generatePython(req), nil
+	case TargetFetch:
+		return g.generateFetch(req), nil
+	case TargetHTTPie:
+		return g.generateHTTPie(req), nil
+	default:
+		return "", errors.Wrapf(ErrUnsupportedTarget, "不支持的代码生成目标: %s", target)
+	}
+}
+
+// cookieHeader 将 Cookie 组装为请求头的值
+func cookieHeader(cookies []QueryParam) string {
+	pairs := make([]string, len(cookies))
+	for i, cookie := range cookies {
+		pairs[i] = cookie.Key + "=" + cookie.Value
+	}
+	return strings.Join(pairs, "; ")
+}
+
+// generateGo 生成 Go net/http 代码
+func (g *CodeGenerator) generateGo(req *CurlRequest) string {
+	imports := map[string]bool{"fmt": true, "io": true, "net/http": true}
+	var body strings.Builder
+
+	bodyVar := "nil"
+	switch {
+	case req.BodyType == BodyTypeMultipart:
+		imports["bytes"], imports["mime/multipart"] = true, true
+		body.WriteString("\tvar buf bytes.Buffer\n\twriter := multipart.NewWriter(&buf)\n")
+		for _, field := range req.FormFields {
+			if field.IsFile {
+				imports["os"], imports["path/filepath"] = true, true
+				// 每个文件放在独立的代码块中，避免多个文件字段时变量重复声明
+				fmt.Fprintf(&body, "\t{\n\t\tfile, err := os.Open(%s)\n\t\tif err != nil {\n\t\t\tpanic(err)\n\t\t}\n", strconv.Quote(field.Value))
+				fmt.Fprintf(&body, "\t\tpart, err := writer.CreateFormFile(%s, filepath.Base(file.Name()))\n\t\tif err != nil {\n\t\t\tpanic(err)\n\t\t}\n", strconv.Quote(field.Name))
+				body.WriteString("\t\tif _, err := io.Copy(part, file); err != nil {\n\t\t\tpanic(err)\n\t\t}\n\t\tfile.Close()\n\t}\n")
+			} else {
+				fmt.Fprintf(&body, "\twriter.WriteField(%s, %s)\n", strconv.Quote(field.Name), strconv.Quote(field.Value))
+			}
+		}
+		body.WriteString("\twriter.Close()\n\n")
+		bodyVar = "&buf"
+	case req.BodyFile != "":
+		imports["os"] = true
+		fmt.Fprintf(&body, "\tbody, err := os.Open(%s)\n\tif err != nil {\n\t\tpanic(err)\n\t}\n\tdefer body.Close()\n\n", strconv.Quote(req.BodyFile))
+		bodyVar = "body"
+	case req.Body != "":
+		imports["strings"] = true
+		fmt.Fprintf(&body, "\tbody := strings.NewReader(%s)\n\n", goStringLiteral(req.Body))
+		bodyVar = "body"
+	}
+
+	fmt.Fprintf(&body, "\treq, err := http.NewRequest(%s, %s, %s)\n\tif err != nil {\n\t\tpanic(err)\n\t}\n",
+		strconv.Quote(req.Method), strconv.Quote(req.URL), bodyVar)
+	for _, header := range req.Headers {
+		fmt.Fprintf(&body, "\treq.Header.Add(%s, %s)\n", strconv.Quote(header.Name), strconv.Quote(header.Value))
+	}
+	if req.BodyType == BodyTypeMultipart {
+		body.WriteString("\treq.Header.Set(\"Content-Type\", writer.FormDataContentType())\n")
+	} else if req.BodyType == BodyTypeForm && req.HeaderValue("Content-Type") == "" {
+		body.WriteString("\treq.Header.Set(\"Content-Type\", \"application/x-www-form-urlencoded\")\n")
+	}
+	if req.BasicAuth != nil {
+		fmt.Fprintf(&body, "\treq.SetBasicAuth(%s, %s)\n", strconv.Quote(req.BasicAuth.Username), strconv.Quote(req.BasicAuth.Password))
+	}
+	for _, cookie := range req.Cookies {
+		fmt.Fprintf(&body, "\treq.AddCookie(&http.Cookie{Name: %s, Value: %s})\n", strconv.Quote(cookie.Key), strconv.Quote(cookie.Value))
+	}
+	body.WriteString("\n")
+
+	client := "http.DefaultClient"
+	if req.Insecure {
+		imports["crypto/tls"] = true
+		body.WriteString("\tclient := &http.Client{\n\t\tTransport: &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}},\n\t}\n")
+		client = "client"
+	}
+	// Go 默认跟随重定向；curl 未指定 -L 时不跟随
+	if !req.FollowRedirects {
+		if client == "client" {
+			body.WriteString("\tclient.CheckRedirect = func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }\n")
+		} else {
+			body.WriteString("\tclient := &http.Client{\n\t\tCheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse },\n\t}\n")
+			client = "client"
+		}
+	}
+	fmt.Fprintf(&body, "\tresp, err := %s.Do(req)\n\tif err != nil {\n\t\tpanic(err)\n\t}\n\tdefer resp.Body.Close()\n\n", client)
+	body.WriteString("\tdata, err := io.ReadAll(resp.Body)\n\tif err != nil {\n\t\tpanic(err)\n\t}\n")
+	body.WriteString("\tfmt.Println(resp.Status)\n\tfmt.Println(string(data))\n")
+
+	packages := make([]string, 0, len(imports))
+	for pkg := range imports {
+		packages = append(packages, pkg)
+	}
+	sort.Strings(packages)
+
+	var sb strings.Builder
+	sb.WriteString("package main\n\nimport (\n")
+	for _, pkg := range packages {
+		fmt.Fprintf(&sb, "\t%q\n", pkg)
+	}
+	sb.WriteString(")\n\nfunc main() {\n")
+	sb.WriteString(body.String())
+	sb.WriteString("}\n")
+	return sb.String()
+}
+
+// goStringLiteral 生成 Go 字符串字面量，多行内容优先使用反引号
+func goStringLiteral(s string) string {
+	if strings.Contains(s, "\n") && !strings.Contains(s, "`") && !strings.Contains(s, "\r") {
+		return "`" + s + "`"
+	}
+	return strconv.Quote(s)
+}
+
+// generatePython 生成 Python requests 代码
+func (g *CodeGenerator) generatePython(req *CurlRequest) string {
+	var sb strings.Builder
+	sb.WriteString("import requests\n\n")
+
+	var args []string
+	headers := req.Headers
+	// requests 发送字符串 data 时不会补 Content-Type，需与 curl -d 的默认值保持一致
+	if req.BodyType == BodyTypeForm && req.HeaderValue("Content-Type") == "" {
+		headers = append(headers[:len(headers):len(headers)], Header{Name: "Content-Type", Value: "application/x-www-form-urlencoded"})
+	}
+	if len(headers) > 0 {
+		sb.WriteString("headers = {\n")
+		for _, header := range headers {
+			fmt.Fprintf(&sb, "    %s: %s,\n", strconv.Quote(header.Name), strconv.Quote(header.Value))
+		}
+		sb.WriteString("}\n\n")
+		args = append(args, "headers=headers")
+	}
+	if len(req.Cookies) > 0 {
+		sb.WriteString("cookies = {\n")
+		for _, cookie := range req.Cookies {
+			fmt.Fprintf(&sb, "    %s: %s,\n", strconv.Quote(cookie.Key), strconv.Quote(cookie.Value))
+		}
+		sb.WriteString("}\n\n")
+		args = append(args, "cookies=cookies")
+	}
+
+	switch {
+	case req.BodyType == BodyTypeMultipart:
+		var data, files []string
+		for _, field := range req.FormFields {
+			if field.IsFile {
+				files = append(files, fmt.Sprintf("    %s: open(%s, \"rb\"),\n", strconv.Quote(field.Name), strconv.Quote(field.Value)))
+			} else {
+				data = append(data, fmt.Sprintf("    %s: %s,\n", strconv.Quote(field.Name), strconv.Quote(field.Value)))
+			}
+		}
+		if len(data) > 0 {
+			sb.WriteString("data = {\n" + strings.Join(data, "") + "}\n\n")
+			args = append(args, "data=data")
+		}
+		if len(files) > 0 {
+			sb.WriteString("files = {\n" + strings.Join(files, "") + "}\n\n")
+			args = append(args, "files=files")
+		}
+	case req.BodyFile != "":
+		fmt.Fprintf(&sb, "with open(%s, \"rb\") as f:\n    data = f.read()\n\n", strconv.Quote(req.BodyFile))
+		args = append(args, "data=data")
+	case req.Body != "":
+		fmt.Fprintf(&sb, "data = %s\n\n", strconv.Quote(req.Body))
+		args = append(args, "data=data")
+	}
+
+	if req.BasicAuth != nil {
+		args = append(args, fmt.Sprintf("auth=(%s, %s)", strconv.Quote(req.BasicAuth.Username), strconv.Quote(req.BasicAuth.Password)))
+	}
+	if req.Insecure {
+		args = append(args, "verify=False")
+	}
+	// requests 默认跟随重定向（HEAD 除外）
+	if !req.FollowRedirects && req.Method != "HEAD" {
+		args = append(args, "allow_redirects=False")
+	}
+
+	call := "requests." + strings.ToLower(req.Method) + "(" + strconv.Quote(req.URL)
+	switch req.Method {
+	case "GET", "POST", "PUT", "PATCH", "DELETE", "HEAD", "OPTIONS":
+	default:
+		call = "requests.request(" + strconv.Quote(req.Method) + ", " + strconv.Quote(req.URL)
+	}
+	for _, arg := range args {
+		call += ", " + arg
+	}
+	sb.WriteString("response = " + call + ")\n")
+	sb.WriteString("print(response.status_code)\nprint(response.text)\n")
+	return sb.String()
+}
+
+// generateFetch 生成 JavaScript fetch 代码
+func (g *CodeGenerator) generateFetch(req *CurlRequest) string {
+	var sb strings.Builder
+	headers := make([]Header, 0, len(req.Headers)+2)
+	headers = append(headers, req.Headers...)
+	if req.BasicAuth != nil {
+		headers = append(headers, Header{Name: "Authorization", Value: req.BasicAuth.BasicAuthHeader()})
+	}
+	if len(req.Cookies) > 0 {
+		headers = append(headers, Header{Name: "Cookie", Value: cookieHeader(req.Cookies)})
+	}
+	if req.BodyType == BodyTypeForm && req.HeaderValue("Content-Type") == "" {
+		headers = append(headers, Header{Name: "Content-Type", Value: "application/x-www-form-urlencoded"})
+	}
+
+	bodyExpr := ""
+	switch {
+	case req.BodyType == BodyTypeMultipart:
+		sb.WriteString("const form = new FormData();\n")
+		for _, field := range req.FormFields {
+			if field.IsFile {
+				fmt.Fprintf(&sb, "form.append(%s, fileInput.files[0]); // %s\n", strconv.Quote(field.Name), field.Value)
+			} else {
+				fmt.Fprintf(&sb, "form.append(%s, %s);\n", strconv.Quote(field.Name), strconv.Quote(field.Value))
+			}
+		}
+		sb.WriteString("\n")
+		bodyExpr = "form"
+	case req.BodyFile != "":
+		bodyExpr = "fileInput.files[0] /* " + req.BodyFile + " */"
+	case req.BodyType == BodyTypeJSON && looksLikeJSON(req.Body):
+		bodyExpr = "JSON.stringify(" + req.Body + ")"
+	case req.Body != "":
+		bodyExpr = strconv.Quote(req.Body)
+	}
+
+	fmt.Fprintf(&sb, "const response = await fetch(%s, {\n", strconv.Quote(req.URL))
+	fmt.Fprintf(&sb, "  method: %s,\n", strconv.Quote(req.Method))
+	if len(headers) > 0 {
+		sb.WriteString("  headers: {\n")
+		for _, header := range headers {
+			fmt.Fprintf(&sb, "    %s: %s,\n", strconv.Quote(header.Name), strconv.Quote(header.Value))
+		}
+		sb.WriteString("  },\n")
+	}
+	if bodyExpr != "" {
+		fmt.Fprintf(&sb, "  body: %s,\n", bodyExpr)
+	}
+	if !req.FollowRedirects {
+		sb.WriteString("  redirect: \"manual\",\n")
+	}
+	sb.WriteString("});\n\nconsole.log(response.status);\nconsole.log(await response.text());\n")
+	return sb.String()
+}
+
+// generateHTTPie 生成 HTTPie 命令
+func (g *CodeGenerator) generateHTTPie(req *CurlRequest) string {
+	args := []string{"http"}
+	if req.BodyType == BodyTypeMultipart {
+		args = append(args, "--multipart")
+	}
+	if req.Insecure {
+		args = append(args, "--verify=no")
+	}
+	if req.FollowRedirects {
+		args = append(args, "--follow")
+	}
+	if req.BasicAuth != nil {
+		args = append(args, "-a", shellQuote(req.BasicAuth.Username+":"+req.BasicAuth.Password))
+	}
+	if req.Body != "" && req.BodyType != BodyTypeMultipart {
+		args = append(args, "--raw", shellQuote(req.Body))
+	}
+	args = append(args, req.Method, shellQuote(req.URL))
+
+	for _, header := range req.Headers {
+		args = append(args, shellQuote(header.Name+":"+header.Value))
+	}
+	if req.BodyType == BodyTypeForm && req.HeaderValue("Content-Type") == "" {
+		args = append(args, shellQuote("Content-Type:application/x-www-form-urlencoded"))
+	}
+	if len(req.Cookies) > 0 {
+		args = append(args, shellQuote("Cookie:"+cookieHeader(req.Cookies)))
+	}
+	for _, field := range req.FormFields {
+		separator := "="
+		if field.IsFile {
+			separator = "@"
+		}
+		args = append(args, shellQuote(field.Name+separator+field.Value))
+	}
+
+	command := strings.Join(args, " ")
+	if req.BodyFile != "" {
+		command += " < " + shellQuote(req.BodyFile)
+	}
+	return command
+}
+
+// shellQuote 按需为 shell 参数添加单引号
+func shellQuote(s string) string {
+	if shellSafePattern.MatchString(s) {
+		return s
+	}
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
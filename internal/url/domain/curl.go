@@ -0,0 +1,444 @@
+package domain
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// 请求体类型
+const (
+	BodyTypeNone      = ""
+	BodyTypeJSON      = "json"
+	BodyTypeForm      = "form"
+	BodyTypeMultipart = "multipart"
+	BodyTypeRaw       = "raw"
+)
+
+// Header 请求头（保留顺序与重复项）
+type Header struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// BasicAuth HTTP Basic 认证信息
+type BasicAuth struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+// FormField multipart 表单字段，IsFile 为 true 时 Value 为文件路径
+type FormField struct {
+	Name   string `json:"name"`
+	Value  string `json:"value"`
+	IsFile bool   `json:"isFile"`
+}
+
+// CurlRequest 从 curl 命令解析出的请求
+type CurlRequest struct {
+	Method     string         `json:"method"`
+	URL        string         `json:"url"`
+	Components *URLComponents `json:"components"`
+	Headers    []Header       `json:"headers"`
+	Body       string         `json:"body"`
+	BodyType   string         `json:"bodyType"`
+	// BodyFile 通过 -d @file 引用的请求体文件
+	BodyFile        string       `json:"bodyFile"`
+	FormFields      []FormField  `json:"formFields"`
+	BasicAuth       *BasicAuth   `json:"basicAuth"`
+	Cookies         []QueryParam `json:"cookies"`
+	Insecure        bool         `json:"insecure"`
+	FollowRedirects bool         `json:"followRedirects"`
+	Compressed      bool         `json:"compressed"`
+	// IgnoredOptions 无法转换为请求属性而被忽略的选项（如 -s、-o）
+	IgnoredOptions []string `json:"ignoredOptions"`
+}
+
+// HeaderValue 返回指定请求头的值（不区分大小写），不存在时返回空字符串
+func (r *CurlRequest) HeaderValue(name string) string {
+	for _, header := range r.Headers {
+		if strings.EqualFold(header.Name, name) {
+			return header.Value
+		}
+	}
+	return ""
+}
+
+// curlValueOptions 需要参数值的 curl 选项，值为规范化后的长选项名
+var curlValueOptions = map[string]string{
+	"-X": "--request", "--request": "--request",
+	"-H": "--header", "--header": "--header",
+	"-d": "--data", "--data": "--data", "--data-ascii": "--data",
+	"--data-raw": "--data-raw", "--data-binary": "--data-binary", "--data-urlencode": "--data-urlencode",
+	"--json": "--json", "--url": "--url",
+	"-F": "--form", "--form": "--form", "--form-string": "--form-string",
+	"-u": "--user", "--user": "--user",
+	"-b": "--cookie", "--cookie": "--cookie",
+	"-A": "--user-agent", "--user-agent": "--user-agent",
+	"-e": "--referer", "--referer": "--referer",
+	// 以下选项有参数值但不影响请求内容
+	"-o": "", "--output": "", "-m": "", "--max-time": "", "--connect-timeout": "", "-x": "", "--proxy": "",
+	"-w": "", "--write-out": "", "--retry": "", "-c": "", "--cookie-jar": "", "--cacert": "", "--cert": "",
+	"--key": "", "-r": "", "--range": "", "--limit-rate": "", "-T": "", "--upload-file": "", "--resolve": "",
+}
+
+// curlFlagOptions 不需要参数值的 curl 选项
+var curlFlagOptions = map[string]string{
+	"-G": "--get", "--get": "--get",
+	"-I": "--head", "--head": "--head",
+	"-k": "--insecure", "--insecure": "--insecure",
+	"-L": "--location", "--location": "--location",
+	"--compressed": "--compressed",
+}
+
+// CurlParser 提供 curl 命令解析功能
+type CurlParser struct {
+	parser *Parser
+}
+
+// NewCurlParser 创建新的 CurlParser 实例
+func NewCurlParser() *CurlParser {
+	return &CurlParser{parser: NewParser()}
+}
+
+// Parse 解析 curl 命令，支持 bash 与 Windows cmd 的续行写法
+func (p *CurlParser) Parse(command string) (*CurlRequest, error) {
+	args, err := splitShellArgs(command)
+	if err != nil {
+		return nil, err
+	}
+	if len(args) == 0 || (args[0] != "curl" && !strings.HasSuffix(args[0], "/curl") && args[0] != "curl.exe") {
+		return nil, errors.Wrapf(ErrInvalidCurl, "命令必须以 curl 开头")
+	}
+
+	req := &CurlRequest{
+		Headers:        []Header{},
+		Cookies:        []QueryParam{},
+		FormFields:     []FormField{},
+		IgnoredOptions: []string{},
+	}
+	var dataParts []string
+	var explicitMethod string
+	useGet, head, jsonShortcut := false, false, false
+
+	for i := 1; i < len(args); i++ {
+		name, value, hasValue := splitOption(args[i])
+		if name == "" {
+			if req.URL == "" {
+				req.URL = args[i]
+			} else {
+				req.IgnoredOptions = append(req.IgnoredOptions, args[i])
+			}
+			continue
+		}
+
+		if option, ok := curlFlagOptions[name]; ok && !hasValue {
+			switch option {
+			case "--get":
+				useGet = true
+			case "--head":
+				head = true
+			case "--insecure":
+				req.Insecure = true
+			case "--location":
+				req.FollowRedirects = true
+			case "--compressed":
+				req.Compressed = true
+			}
+			continue
+		}
+
+		option, ok := curlValueOptions[name]
+		if !ok {
+			// 组合的短选项（如 -sSL）逐个处理
+			if expanded := expandShortFlags(name); expanded != nil {
+				args = append(args[:i+1], append(expanded, args[i+1:]...)...)
+				continue
+			}
+			req.IgnoredOptions = append(req.IgnoredOptions, args[i])
+			continue
+		}
+		if !hasValue {
+			if i+1 >= len(args) {
+				return nil, errors.Wrapf(ErrInvalidCurl, "选项 %s 缺少参数值", name)
+			}
+			i++
+			value = args[i]
+		}
+
+		switch option {
+		case "--request":
+			explicitMethod = strings.ToUpper(value)
+		case "--header":
+			headerName, headerValue, _ := strings.Cut(value, ":")
+			req.Headers = append(req.Headers, Header{Name: strings.TrimSpace(headerName), Value: strings.TrimSpace(headerValue)})
+		case "--data", "--data-binary":
+			if strings.HasPrefix(value, "@") {
+				req.BodyFile = value[1:]
+				continue
+			}
+			if option == "--data" {
+				// -d 会去除换行，与 curl 行为一致
+				value = strings.NewReplacer("\r", "", "\n", "").Replace(value)
+			}
+			dataParts = append(dataParts, value)
+		case "--data-raw":
+			dataParts = append(dataParts, value)
+		case "--json":
+			jsonShortcut = true
+			dataParts = append(dataParts, value)
+		case "--data-urlencode":
+			dataParts = append(dataParts, urlencodeData(value))
+		case "--form", "--form-string":
+			fieldName, fieldValue, _ := strings.Cut(value, "=")
+			isFile := option == "--form" && strings.HasPrefix(fieldValue, "@")
+			if isFile {
+				fieldValue = strings.SplitN(fieldValue[1:], ";", 2)[0]
+			}
+			req.FormFields = append(req.FormFields, FormField{Name: fieldName, Value: fieldValue, IsFile: isFile})
+		case "--user":
+			username, password, _ := strings.Cut(value, ":")
+			req.BasicAuth = &BasicAuth{Username: username, Password: password}
+		case "--cookie":
+			req.Cookies = append(req.Cookies, parseCookies(value)...)
+		case "--user-agent":
+			req.Headers = append(req.Headers, Header{Name: "User-Agent", Value: value})
+		case "--referer":
+			req.Headers = append(req.Headers, Header{Name: "Referer", Value: value})
+		case "--url":
+			req.URL = value
+		default:
+			req.IgnoredOptions = append(req.IgnoredOptions, name)
+		}
+	}
+
+	if req.URL == "" {
+		return nil, errors.Wrapf(ErrInvalidCurl, "未找到请求 URL")
+	}
+	if !strings.Contains(req.URL, "://") {
+		req.URL = "http://" + req.URL
+	}
+
+	body := strings.Join(dataParts, "&")
+	if useGet && body != "" {
+		separator := "?"
+		if strings.Contains(req.URL, "?") {
+			separator = "&"
+		}
+		req.URL += separator + body
+		body = ""
+	}
+
+	components, err := p.parser.Parse(req.URL)
+	if err != nil {
+		return nil, err
+	}
+	req.Components = components
+
+	req.Body = body
+	if jsonShortcut {
+		if req.HeaderValue("Content-Type") == "" {
+			req.Headers = append(req.Headers, Header{Name: "Content-Type", Value: "application/json"})
+		}
+		if req.HeaderValue("Accept") == "" {
+			req.Headers = append(req.Headers, Header{Name: "Accept", Value: "application/json"})
+		}
+	}
+	req.BodyType = detectBodyType(req)
+
+	switch {
+	case explicitMethod != "":
+		req.Method = explicitMethod
+	case head:
+		req.Method = "HEAD"
+	case req.Body != "" || req.BodyFile != "" || len(req.FormFields) > 0:
+		req.Method = "POST"
+	default:
+		req.Method = "GET"
+	}
+	return req, nil
+}
+
+// BasicAuthHeader 返回 Basic 认证请求头的值
+func (a *BasicAuth) BasicAuthHeader() string {
+	return "Basic " + base64.StdEncoding.EncodeToString([]byte(a.Username+":"+a.Password))
+}
+
+// detectBodyType 根据 Content-Type 判断请求体类型，与 curl 一致不按内容猜测
+// （--json 已补上 application/json 请求头）
+func detectBodyType(req *CurlRequest) string {
+	contentType := strings.ToLower(req.HeaderValue("Content-Type"))
+	switch {
+	case len(req.FormFields) > 0:
+		return BodyTypeMultipart
+	case req.Body == "" && req.BodyFile == "":
+		return BodyTypeNone
+	case strings.Contains(contentType, "json"):
+		return BodyTypeJSON
+	case contentType == "" || strings.Contains(contentType, "x-www-form-urlencoded"):
+		// curl -d 的默认 Content-Type 为 application/x-www-form-urlencoded
+		return BodyTypeForm
+	default:
+		return BodyTypeRaw
+	}
+}
+
+// looksLikeJSON 判断请求体是否为 JSON 对象或数组
+func looksLikeJSON(body string) bool {
+	body = strings.TrimSpace(body)
+	return (strings.HasPrefix(body, "{") || strings.HasPrefix(body, "[")) && json.Valid([]byte(body))
+}
+
+// splitOption 拆分选项名与内联参数值（--header=x、-XPOST）
+func splitOption(arg string) (name, value string, hasValue bool) {
+	if !strings.HasPrefix(arg, "-") || arg == "-" {
+		return "", "", false
+	}
+	if strings.HasPrefix(arg, "--") {
+		if name, value, found := strings.Cut(arg, "="); found {
+			return name, value, true
+		}
+		return arg, "", false
+	}
+	if len(arg) > 2 {
+		if _, ok := curlValueOptions[arg[:2]]; ok {
+			return arg[:2], arg[2:], true
+		}
+	}
+	return arg, "", false
+}
+
+// expandShortFlags 将组合短选项拆分为单独的选项，如 -sSLk → -s -S -L -k
+func expandShortFlags(arg string) []string {
+	if strings.HasPrefix(arg, "--") || len(arg) <= 2 {
+		return nil
+	}
+	expanded := make([]string, 0, len(arg)-1)
+	for i := 1; i < len(arg); i++ {
+		flag := "-" + string(arg[i])
+		if _, ok := curlValueOptions[flag]; ok {
+			// 需要参数值的短选项只能出现在最后，剩余部分作为参数值
+			return append(expanded, flag+arg[i+1:])
+		}
+		expanded = append(expanded, flag)
+	}
+	return expanded
+}
+
+// urlencodeData 按 curl --data-urlencode 的规则编码：content、=content、name=content、@file、name@file
+func urlencodeData(value string) string {
+	if name, content, found := strings.Cut(value, "="); found {
+		if name == "" {
+			return url.QueryEscape(content)
+		}
+		return name + "=" + url.QueryEscape(content)
+	}
+	if strings.Contains(value, "@") {
+		return value
+	}
+	return url.QueryEscape(value)
+}
+
+// parseCookies 解析 "a=1; b=2" 形式的 Cookie，不含 "=" 的值视为 Cookie 文件而忽略
+func parseCookies(value string) []QueryParam {
+	cookies := []QueryParam{}
+	if !strings.Contains(value, "=") {
+		return cookies
+	}
+	for _, part := range strings.Split(value, ";") {
+		name, val, _ := strings.Cut(strings.TrimSpace(part), "=")
+		if name != "" {
+			cookies = append(cookies, QueryParam{Key: name, Value: val})
+		}
+	}
+	return cookies
+}
+
+// splitShellArgs 按 shell 规则拆分命令行参数，支持单引号、双引号、$'...' 与续行
+func splitShellArgs(command string) ([]string, error) {
+	// 续行：bash 的 "\" 与 Windows cmd 的 "^"
+	command = strings.NewReplacer("\\\r\n", " ", "\\\n", " ", "^\r\n", " ", "^\n", " ").Replace(command)
+
+	var args []string
+	var current strings.Builder
+	inArg := false
+	for i := 0; i < len(command); i++ {
+		ch := command[i]
+		switch {
+		case ch == ' ' || ch == '\t' || ch == '\n' || ch == '\r':
+			if inArg {
+				args = append(args, current.String())
+				current.Reset()
+				inArg = false
+			}
+		case ch == '\'':
+			end := strings.IndexByte(command[i+1:], '\'')
+			if end < 0 {
+				return nil, errors.Wrapf(ErrInvalidCurl, "单引号未闭合")
+			}
+			current.WriteString(command[i+1 : i+1+end])
+			i += end + 1
+			inArg = true
+		case ch == '$' && i+1 < len(command) && command[i+1] == '\'':
+			end, text := readANSIQuoted(command[i+2:])
+			if end < 0 {
+				return nil, errors.Wrapf(ErrInvalidCurl, "$'...' 引号未闭合")
+			}
+			current.WriteString(text)
+			i += end + 2
+			inArg = true
+		case ch == '"':
+			i++
+			for ; i < len(command) && command[i] != '"'; i++ {
+				if command[i] == '\\' && i+1 < len(command) && strings.IndexByte("\"\\$`", command[i+1]) >= 0 {
+					i++
+				}
+				current.WriteByte(command[i])
+			}
+			if i >= len(command) {
+				return nil, errors.Wrapf(ErrInvalidCurl, "双引号未闭合")
+			}
+			inArg = true
+		case ch == '\\' && i+1 < len(command):
+			i++
+			current.WriteByte(command[i])
+			inArg = true
+		default:
+			current.WriteByte(ch)
+			inArg = true
+		}
+	}
+	if inArg {
+		args = append(args, current.String())
+	}
+	return args, nil
+}
+
+// readANSIQuoted 读取 $'...' 内容并处理转义，返回结束引号的位置与解析后的文本
+func readANSIQuoted(s string) (int, string) {
+	var sb strings.Builder
+	for i := 0; i < len(s); i++ {
+		switch {
+		case s[i] == '\'':
+			return i, sb.String()
+		case s[i] == '\\' && i+1 < len(s):
+			i++
+			switch s[i] {
+			case 'n':
+				sb.WriteByte('\n')
+			case 't':
+				sb.WriteByte('\t')
+			case 'r':
+				sb.WriteByte('\r')
+			default:
+				sb.WriteByte(s[i])
+			}
+		default:
+			sb.WriteByte(s[i])
+		}
+	}
+	return -1, ""
+}
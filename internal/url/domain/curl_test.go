@@ -0,0 +1,139 @@
+package domain
+
+import (
+	"go/format"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestCurlParser_Parse(t *testing.T) {
+	parser := NewCurlParser()
+
+	command := `curl 'https://api.example.com/v1/users?page=2' \
+  -X PUT \
+  -H 'Content-Type: application/json' \
+  -H "Authorization: Bearer abc" \
+  --data-raw '{"name":"bob","tags":["a"]}' \
+  -u admin:s3cret -b 'sid=1; theme=dark' -sSLk --compressed`
+
+	req, err := parser.Parse(command)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if req.Method != "PUT" || req.URL != "https://api.example.com/v1/users?page=2" {
+		t.Errorf("Parse() method/url = %s %s", req.Method, req.URL)
+	}
+	wantHeaders := []Header{{Name: "Content-Type", Value: "application/json"}, {Name: "Authorization", Value: "Bearer abc"}}
+	if !reflect.DeepEqual(req.Headers, wantHeaders) {
+		t.Errorf("Parse() headers = %+v", req.Headers)
+	}
+	if req.BodyType != BodyTypeJSON || req.Body != `{"name":"bob","tags":["a"]}` {
+		t.Errorf("Parse() body = %s (%s)", req.Body, req.BodyType)
+	}
+	if req.BasicAuth == nil || req.BasicAuth.Username != "admin" || req.BasicAuth.Password != "s3cret" {
+		t.Errorf("Parse() basic auth = %+v", req.BasicAuth)
+	}
+	if len(req.Cookies) != 2 || req.Cookies[1].Key != "theme" {
+		t.Errorf("Parse() cookies = %+v", req.Cookies)
+	}
+	if !req.Insecure || !req.FollowRedirects || !req.Compressed {
+		t.Errorf("Parse() flags = %+v", req)
+	}
+	if req.Components.Host != "api.example.com" || req.Components.Query[0].Value != "2" {
+		t.Errorf("Parse() components = %+v", req.Components)
+	}
+}
+
+func TestCurlParser_ParseVariants(t *testing.T) {
+	parser := NewCurlParser()
+
+	tests := []struct {
+		name       string
+		command    string
+		wantMethod string
+		wantURL    string
+		wantBody   string
+		wantType   string
+	}{
+		{name: "implicit post", command: "curl -d a=1 -d b=2 example.com/form", wantMethod: "POST", wantURL: "http://example.com/form", wantBody: "a=1&b=2", wantType: BodyTypeForm},
+		{name: "json-looking data", command: `curl -d '{"a":1}' https://example.com/api`, wantMethod: "POST", wantURL: "https://example.com/api", wantBody: `{"a":1}`, wantType: BodyTypeForm},
+		{name: "json shortcut", command: `curl --json '{"a":1}' https://example.com/api`, wantMethod: "POST", wantURL: "https://example.com/api", wantBody: `{"a":1}`, wantType: BodyTypeJSON},
+		{name: "get with data", command: "curl -G --data-urlencode 'q=a b' https://example.com/search", wantMethod: "GET", wantURL: "https://example.com/search?q=a+b", wantType: BodyTypeNone},
+		{name: "head", command: "curl -I https://example.com", wantMethod: "HEAD", wantURL: "https://example.com", wantType: BodyTypeNone},
+		{name: "attached method", command: `curl -XDELETE "https://example.com/items/1"`, wantMethod: "DELETE", wantURL: "https://example.com/items/1", wantType: BodyTypeNone},
+		{name: "ansi quoting", command: `curl https://example.com --data-binary $'line1\nline2' -H 'Content-Type: text/plain'`, wantMethod: "POST", wantURL: "https://example.com", wantBody: "line1\nline2", wantType: BodyTypeRaw},
+		{name: "multipart", command: "curl -F name=bob -F avatar=@photo.png https://example.com/upload", wantMethod: "POST", wantURL: "https://example.com/upload", wantType: BodyTypeMultipart},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := parser.Parse(tt.command)
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if req.Method != tt.wantMethod || req.URL != tt.wantURL || req.Body != tt.wantBody || req.BodyType != tt.wantType {
+				t.Errorf("Parse() = %s %s body=%q type=%s", req.Method, req.URL, req.Body, req.BodyType)
+			}
+		})
+	}
+
+	for _, invalid := range []string{"wget https://example.com", "curl -H 'X: 1'", "curl 'https://example.com"} {
+		if _, err := parser.Parse(invalid); err == nil {
+			t.Errorf("Parse(%q) should fail", invalid)
+		}
+	}
+}
+
+func TestCodeGenerator_Generate(t *testing.T) {
+	req, err := NewCurlParser().Parse(`curl -X POST https://example.com/api -H 'Content-Type: application/json' -d '{"a":1}' -u u:p -b 'sid=1' -F file=@a.txt -F file2=@b.txt -k`)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	generator := NewCodeGenerator()
+
+	code, err := generator.Generate(req, TargetGo)
+	if err != nil {
+		t.Fatalf("Generate(go) error = %v", err)
+	}
+	if _, err := format.Source([]byte(code)); err != nil {
+		t.Errorf("Generate(go) produced invalid Go code: %v\n%s", err, code)
+	}
+
+	req, _ = NewCurlParser().Parse(`curl https://example.com/api -H 'Content-Type: application/json' -d '{"a":1}' -u u:p -L`)
+	tests := []struct {
+		target string
+		want   []string
+	}{
+		{target: TargetGo, want: []string{`http.NewRequest("POST", "https://example.com/api", body)`, `req.SetBasicAuth("u", "p")`}},
+		{target: TargetPython, want: []string{`requests.post("https://example.com/api", headers=headers, data=data, auth=("u", "p"))`}},
+		{target: TargetFetch, want: []string{`body: JSON.stringify({"a":1})`, `"Authorization": "Basic dTpw"`}},
+		{target: TargetHTTPie, want: []string{`http --follow -a u:p --raw '{"a":1}' POST https://example.com/api Content-Type:application/json`}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.target, func(t *testing.T) {
+			code, err := generator.Generate(req, tt.target)
+			if err != nil {
+				t.Fatalf("Generate() error = %v", err)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(code, want) {
+					t.Errorf("Generate() missing %q in\n%s", want, code)
+				}
+			}
+		})
+	}
+
+	// 未声明 Content-Type 的 -d 与 curl 一致按表单发送
+	req, _ = NewCurlParser().Parse(`curl -d '{"a":1}' https://example.com/api`)
+	for _, target := range []string{TargetGo, TargetPython, TargetFetch, TargetHTTPie} {
+		code, _ := generator.Generate(req, target)
+		if !strings.Contains(code, "application/x-www-form-urlencoded") || strings.Contains(code, "JSON.stringify") {
+			t.Errorf("Generate(%s) for -d should send a form body:\n%s", target, code)
+		}
+	}
+
+	if _, err := generator.Generate(req, "ruby"); err == nil {
+		t.Errorf("Generate() should reject unknown targets")
+	}
+}
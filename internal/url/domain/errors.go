@@ -0,0 +1,39 @@
+package domain
+
+// URLError URL 工具错误类型
+type URLError struct {
+	Errmsg string
+}
+
+// Error 实现 error 接口
+func (e URLError) Error() string {
+	return e.Errmsg
+}
+
+// 预定义的错误
+var (
+	// ErrEmptyInput 输入为空
+	ErrEmptyInput = URLError{Errmsg: "输入不能为空"}
+	// ErrInvalidURL 无效的 URL
+	ErrInvalidURL = URLError{Errmsg: "无效的 URL"}
+	// ErrInvalidScheme 无效的协议
+	ErrInvalidScheme = URLError{Errmsg: "无效的协议"}
+	// ErrInvalidPort 无效的端口
+	ErrInvalidPort = URLError{Errmsg: "无效的端口"}
+	// ErrUnsupportedMode 不支持的编码模式
+	ErrUnsupportedMode = URLError{Errmsg: "不支持的编码模式"}
+	// ErrUnsupportedConvention 不支持的嵌套键约定
+	ErrUnsupportedConvention = URLError{Errmsg: "不支持的嵌套键约定"}
+	// ErrQueryConflict 查询参数的嵌套结构冲突
+	ErrQueryConflict = URLError{Errmsg: "查询参数结构冲突"}
+	// ErrInvalidQueryValue 无法转换为查询参数的值
+	ErrInvalidQueryValue = URLError{Errmsg: "无法转换为查询参数的值"}
+	// ErrInvalidHostname 无效的国际化域名
+	ErrInvalidHostname = URLError{Errmsg: "无效的国际化域名"}
+	// ErrUnsupportedNormForm 不支持的 Unicode 规范化形式
+	ErrUnsupportedNormForm = URLError{Errmsg: "不支持的规范化形式"}
+	// ErrInvalidCurl 无法解析的 curl 命令
+	ErrInvalidCurl = URLError{Errmsg: "无法解析的 curl 命令"}
+	// ErrUnsupportedTarget 不支持的代码生成目标
+	ErrUnsupportedTarget = URLError{Errmsg: "不支持的代码生成目标"}
+)
@@ -0,0 +1,177 @@
+package domain
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// 同形异义风险等级
+const (
+	RiskNone                  = "none"                    // 单一文字系统且无易混淆字符
+	RiskMixedScript           = "mixed-script"            // 同一标签混用多种文字系统
+	RiskWholeScriptConfusable = "whole-script-confusable" // 整个标签由可冒充拉丁字母的字符组成
+)
+
+// confusables 常见可冒充拉丁字母的字符（摘自 Unicode confusables.txt 中最常被滥用的部分）
+var confusables = map[rune]rune{
+	// 西里尔字母
+	'а': 'a', 'в': 'b', 'с': 'c', 'ԁ': 'd', 'е': 'e', 'һ': 'h', 'і': 'i', 'ј': 'j',
+	'к': 'k', 'ӏ': 'l', 'м': 'm', 'п': 'n', 'о': 'o', 'р': 'p', 'ԛ': 'q', 'г': 'r',
+	'ѕ': 's', 'т': 't', 'ѵ': 'v', 'ԝ': 'w', 'х': 'x', 'у': 'y',
+	'А': 'A', 'В': 'B', 'С': 'C', 'Е': 'E', 'Н': 'H', 'І': 'I', 'Ј': 'J', 'К': 'K',
+	'М': 'M', 'О': 'O', 'Р': 'P', 'Ѕ': 'S', 'Т': 'T', 'Х': 'X', 'Ү': 'Y',
+	// 希腊字母
+	'α': 'a', 'ε': 'e', 'ι': 'i', 'κ': 'k', 'ν': 'v', 'ο': 'o', 'ρ': 'p', 'τ': 't',
+	'χ': 'x', 'γ': 'y', 'υ': 'u', 'Α': 'A', 'Β': 'B', 'Ε': 'E', 'Ζ': 'Z', 'Η': 'H', 'Ι': 'I',
+	'Κ': 'K', 'Μ': 'M', 'Ν': 'N', 'Ο': 'O', 'Ρ': 'P', 'Τ': 'T', 'Υ': 'Y', 'Χ': 'X',
+	// 亚美尼亚字母
+	'օ': 'o', 'ս': 'u', 'հ': 'h', 'ո': 'n', 'ց': 'g', 'զ': 'q',
+	// 拉丁扩展与符号
+	'ı': 'i', 'ȷ': 'j', 'ℓ': 'l', 'ɑ': 'a', 'ɡ': 'g', 'ⅰ': 'i', 'ⅼ': 'l',
+}
+
+// allowedScriptSets UTS 39 "Highly Restrictive" 级别允许的文字系统组合
+var allowedScriptSets = [][]string{
+	{"Han", "Hiragana", "Katakana", "Latin"},
+	{"Bopomofo", "Han", "Latin"},
+	{"Han", "Hangul", "Latin"},
+}
+
+// ConfusableChar 易混淆字符
+type ConfusableChar struct {
+	Char      string `json:"char"`
+	CodePoint string `json:"codePoint"`
+	Script    string `json:"script"`
+	LooksLike string `json:"looksLike"`
+}
+
+// LabelScripts 单个标签的文字系统分析
+type LabelScripts struct {
+	Label       string           `json:"label"`
+	Scripts     []string         `json:"scripts"`
+	Risk        string           `json:"risk"`
+	Confusables []ConfusableChar `json:"confusables"`
+}
+
+// ScriptReport 文字系统分析结果
+type ScriptReport struct {
+	Labels []LabelScripts `json:"labels"`
+	// Skeleton 将易混淆字符替换为对应拉丁字母后的结果，如 "аpple.com" → "apple.com"
+	Skeleton   string `json:"skeleton"`
+	Suspicious bool   `json:"suspicious"`
+}
+
+// ScriptAnalyzer 提供文字系统与同形异义字符分析
+type ScriptAnalyzer struct{}
+
+// NewScriptAnalyzer 创建新的 ScriptAnalyzer 实例
+func NewScriptAnalyzer() *ScriptAnalyzer {
+	return &ScriptAnalyzer{}
+}
+
+// AnalyzeHost 按标签分析主机名，每个标签独立判断是否混用文字系统
+func (a *ScriptAnalyzer) AnalyzeHost(host string) *ScriptReport {
+	report := &ScriptReport{Labels: []LabelScripts{}}
+	labels := strings.Split(host, ".")
+	skeletons := make([]string, len(labels))
+	for i, label := range labels {
+		analysis := a.AnalyzeLabel(label)
+		if analysis.Risk != RiskNone {
+			report.Suspicious = true
+		}
+		report.Labels = append(report.Labels, analysis)
+		skeletons[i] = Skeleton(label)
+	}
+	report.Skeleton = strings.Join(skeletons, ".")
+	return report
+}
+
+// AnalyzeLabel 分析单个标签（或任意字符串）使用的文字系统与易混淆字符
+func (a *ScriptAnalyzer) AnalyzeLabel(label string) LabelScripts {
+	result := LabelScripts{Label: label, Risk: RiskNone, Scripts: []string{}, Confusables: []ConfusableChar{}}
+
+	scripts := map[string]bool{}
+	confusableCount, letterCount := 0, 0
+	for _, r := range label {
+		script := ScriptOf(r)
+		if script != "Common" && script != "Inherited" && script != "Unknown" {
+			scripts[script] = true
+			letterCount++
+		}
+		if target, ok := confusables[r]; ok {
+			confusableCount++
+			result.Confusables = append(result.Confusables, ConfusableChar{
+				Char:      string(r),
+				CodePoint: formatCodePoint(r),
+				Script:    script,
+				LooksLike: string(target),
+			})
+		}
+	}
+	for script := range scripts {
+		result.Scripts = append(result.Scripts, script)
+	}
+	sort.Strings(result.Scripts)
+
+	switch {
+	case len(result.Scripts) > 1 && !isAllowedScriptSet(result.Scripts):
+		result.Risk = RiskMixedScript
+	case len(result.Scripts) == 1 && result.Scripts[0] != "Latin" && letterCount > 0 && confusableCount == letterCount:
+		result.Risk = RiskWholeScriptConfusable
+	}
+	return result
+}
+
+// Skeleton 将易混淆字符替换为对应的拉丁字母
+func Skeleton(s string) string {
+	return strings.Map(func(r rune) rune {
+		if target, ok := confusables[r]; ok {
+			return target
+		}
+		return r
+	}, s)
+}
+
+// ScriptOf 返回字符所属的 Unicode 文字系统名称
+func ScriptOf(r rune) string {
+	if unicode.Is(unicode.Common, r) {
+		return "Common"
+	}
+	if unicode.Is(unicode.Inherited, r) {
+		return "Inherited"
+	}
+	for name, table := range unicode.Scripts {
+		if name != "Common" && name != "Inherited" && unicode.Is(table, r) {
+			return name
+		}
+	}
+	return "Unknown"
+}
+
+// isAllowedScriptSet 判断文字系统组合是否为 UTS 39 允许的组合（如日文混用汉字、假名与拉丁字母）
+func isAllowedScriptSet(scripts []string) bool {
+	for _, allowed := range allowedScriptSets {
+		if isSubset(scripts, allowed) {
+			return true
+		}
+	}
+	return false
+}
+
+// isSubset 判断 subset 中的元素是否都在 set 中
+func isSubset(subset, set []string) bool {
+	for _, item := range subset {
+		found := false
+		for _, candidate := range set {
+			if item == candidate {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
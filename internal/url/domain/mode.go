@@ -0,0 +1,168 @@
+package domain
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// 支持的编码模式
+const (
+	ModeComponent = "component" // 查询参数组件（encodeURIComponent 语义，空格编码为 %20）
+	ModePath      = "path"      // 路径段（保留 "@"、":" 等路径中允许的字符）
+	ModeFullURL   = "full"      // 完整 URL（encodeURI 语义，保留除 "[]" 以外的 URL 保留字符）
+	ModeRFC3986   = "rfc3986"   // RFC 3986 严格模式，仅保留非保留字符 A-Z a-z 0-9 - . _ ~
+	ModeForm      = "form"      // application/x-www-form-urlencoded（空格编码为 +）
+)
+
+const (
+	// maxDecodeDepth 递归解码的最大层数
+	maxDecodeDepth = 10
+	upperHex       = "0123456789ABCDEF"
+)
+
+// percentEscapePattern 匹配合法的百分号转义序列
+var percentEscapePattern = regexp.MustCompile(`%[0-9A-Fa-f]{2}`)
+
+// ModeInfo 编码模式描述
+type ModeInfo struct {
+	Name        string `json:"name"`
+	Label       string `json:"label"`
+	Description string `json:"description"`
+}
+
+// modes 编码模式列表
+var modes = []ModeInfo{
+	{Name: ModeComponent, Label: "查询参数", Description: "等同 encodeURIComponent，空格编码为 %20"},
+	{Name: ModePath, Label: "路径段", Description: "用于单个路径段，\"/\" 会被编码"},
+	{Name: ModeFullURL, Label: "完整 URL", Description: "等同 encodeURI，保留 :/?#@!$&'()*+,;= 等字符，[] 会被编码"},
+	{Name: ModeRFC3986, Label: "RFC 3986", Description: "仅保留 A-Z a-z 0-9 - . _ ~"},
+	{Name: ModeForm, Label: "表单", Description: "application/x-www-form-urlencoded，空格编码为 +"},
+}
+
+// ListModes 返回所有支持的编码模式
+func ListModes() []ModeInfo {
+	result := make([]ModeInfo, len(modes))
+	copy(result, modes)
+	return result
+}
+
+// DecodeLayer 递归解码中的一层
+type DecodeLayer struct {
+	Depth  int    `json:"depth"`
+	Output string `json:"output"`
+}
+
+// RecursiveDecodeResult 递归解码结果
+type RecursiveDecodeResult struct {
+	Layers []DecodeLayer `json:"layers"`
+	Result string        `json:"result"`
+	// Depth 实际解码的层数，大于 1 说明输入被多次编码
+	Depth int `json:"depth"`
+}
+
+// isUnreserved 判断是否为 RFC 3986 非保留字符
+func isUnreserved(c byte) bool {
+	return c >= 'A' && c <= 'Z' || c >= 'a' && c <= 'z' || c >= '0' && c <= '9' ||
+		c == '-' || c == '.' || c == '_' || c == '~'
+}
+
+// isComponentSafe encodeURIComponent 不编码的字符
+func isComponentSafe(c byte) bool {
+	return isUnreserved(c) || strings.IndexByte("!'()*", c) >= 0
+}
+
+// isURISafe encodeURI 不编码的字符
+func isURISafe(c byte) bool {
+	return isComponentSafe(c) || strings.IndexByte(";,/?:@&=+$#", c) >= 0
+}
+
+// escape 对不满足 keep 的字节进行百分号编码
+func escape(input string, keep func(byte) bool) string {
+	var sb strings.Builder
+	sb.Grow(len(input))
+	for i := 0; i < len(input); i++ {
+		c := input[i]
+		if keep(c) {
+			sb.WriteByte(c)
+			continue
+		}
+		sb.WriteByte('%')
+		sb.WriteByte(upperHex[c>>4])
+		sb.WriteByte(upperHex[c&0x0f])
+	}
+	return sb.String()
+}
+
+// EncodeMode 使用指定模式编码字符串
+func (e *Encoder) EncodeMode(mode, input string) (string, error) {
+	switch mode {
+	case ModeComponent:
+		return escape(input, isComponentSafe), nil
+	case ModePath:
+		return url.PathEscape(input), nil
+	case ModeFullURL:
+		return escape(input, isURISafe), nil
+	case ModeRFC3986:
+		return escape(input, isUnreserved), nil
+	case ModeForm, "":
+		return url.QueryEscape(input), nil
+	default:
+		return "", errors.Wrapf(ErrUnsupportedMode, "不支持的编码模式: %s", mode)
+	}
+}
+
+// DecodeMode 使用指定模式解码字符串，仅表单模式将 "+" 解码为空格
+func (d *Decoder) DecodeMode(mode, input string) (string, error) {
+	var decoded string
+	var err error
+	switch mode {
+	case ModeForm, "":
+		decoded, err = url.QueryUnescape(input)
+	case ModeComponent, ModePath, ModeFullURL, ModeRFC3986:
+		decoded, err = url.PathUnescape(input)
+	default:
+		return "", errors.Wrapf(ErrUnsupportedMode, "不支持的编码模式: %s", mode)
+	}
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to decode URL")
+	}
+	return decoded, nil
+}
+
+// DecodeRecursive 反复解码直到结果不再包含转义序列，返回每一层的解码结果
+// 用于排查被二次、三次编码的参数（如 %252F）
+func (d *Decoder) DecodeRecursive(mode, input string) (*RecursiveDecodeResult, error) {
+	result := &RecursiveDecodeResult{Layers: []DecodeLayer{}, Result: input}
+	current := input
+	for depth := 1; depth <= maxDecodeDepth; depth++ {
+		plusAsSpace := (mode == ModeForm || mode == "") && strings.Contains(current, "+")
+		if !percentEscapePattern.MatchString(current) && !plusAsSpace {
+			break
+		}
+
+		decoded, err := d.DecodeMode(mode, current)
+		if err != nil {
+			if depth == 1 {
+				return nil, err
+			}
+			// 后续层解码失败说明剩余内容并非编码数据，保留上一层结果
+			break
+		}
+		if decoded == current {
+			break
+		}
+
+		result.Layers = append(result.Layers, DecodeLayer{Depth: depth, Output: decoded})
+		current = decoded
+		// 表单模式只在第一层将 "+" 视为空格，后续层按百分号编码处理
+		if mode == ModeForm || mode == "" {
+			mode = ModeComponent
+		}
+	}
+	result.Result = current
+	result.Depth = len(result.Layers)
+	return result, nil
+}
@@ -0,0 +1,80 @@
+package domain
+
+import (
+	"testing"
+)
+
+func TestEncoder_EncodeMode(t *testing.T) {
+	encoder := NewEncoder()
+	input := "a b/c?d=e&f~g'中"
+
+	tests := []struct {
+		mode string
+		want string
+	}{
+		{mode: ModeComponent, want: "a%20b%2Fc%3Fd%3De%26f~g'%E4%B8%AD"},
+		{mode: ModePath, want: "a%20b%2Fc%3Fd=e&f~g%27%E4%B8%AD"},
+		{mode: ModeFullURL, want: "a%20b/c?d=e&f~g'%E4%B8%AD"},
+		{mode: ModeRFC3986, want: "a%20b%2Fc%3Fd%3De%26f~g%27%E4%B8%AD"},
+		{mode: ModeForm, want: "a+b%2Fc%3Fd%3De%26f~g%27%E4%B8%AD"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.mode, func(t *testing.T) {
+			got, err := encoder.EncodeMode(tt.mode, input)
+			if err != nil {
+				t.Fatalf("EncodeMode() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("EncodeMode() = %s, want %s", got, tt.want)
+			}
+
+			decoded, err := NewDecoder().DecodeMode(tt.mode, got)
+			if err != nil {
+				t.Fatalf("DecodeMode() error = %v", err)
+			}
+			if decoded != input {
+				t.Errorf("DecodeMode() = %s, want %s", decoded, input)
+			}
+		})
+	}
+
+	// 与 encodeURI 一致，方括号不在保留范围内
+	if got, _ := encoder.EncodeMode(ModeFullURL, "a[0]=1"); got != "a%5B0%5D=1" {
+		t.Errorf("EncodeMode(full) = %s, want a%%5B0%%5D=1", got)
+	}
+
+	if _, err := encoder.EncodeMode("unknown", input); err == nil {
+		t.Errorf("EncodeMode() should reject unknown modes")
+	}
+}
+
+func TestDecoder_DecodeRecursive(t *testing.T) {
+	decoder := NewDecoder()
+
+	// "https://a.test/?q=x y" 经过三次 encodeURIComponent
+	result, err := decoder.DecodeRecursive(ModeComponent, "https%25253A%25252F%25252Fa.test%25252F%25253Fq%25253Dx%252520y")
+	if err != nil {
+		t.Fatalf("DecodeRecursive() error = %v", err)
+	}
+	if result.Depth != 3 || result.Result != "https://a.test/?q=x y" {
+		t.Errorf("DecodeRecursive() = %+v", result)
+	}
+	if result.Layers[0].Output != "https%253A%252F%252Fa.test%252F%253Fq%253Dx%2520y" {
+		t.Errorf("first layer = %s", result.Layers[0].Output)
+	}
+
+	// 表单模式只在第一层将 "+" 解码为空格
+	result, err = decoder.DecodeRecursive(ModeForm, "a+b%252B")
+	if err != nil {
+		t.Fatalf("DecodeRecursive(form) error = %v", err)
+	}
+	if result.Result != "a b+" || result.Depth != 2 {
+		t.Errorf("DecodeRecursive(form) = %+v", result)
+	}
+
+	result, err = decoder.DecodeRecursive(ModeComponent, "plain text")
+	if err != nil || result.Depth != 0 || result.Result != "plain text" {
+		t.Errorf("DecodeRecursive(plain) = %+v, %v", result, err)
+	}
+}
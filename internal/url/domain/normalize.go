@@ -0,0 +1,119 @@
+package domain
+
+import (
+	"encoding/hex"
+	"fmt"
+	"unicode"
+
+	"github.com/pkg/errors"
+	"golang.org/x/text/unicode/norm"
+	"golang.org/x/text/unicode/runenames"
+)
+
+// 支持的 Unicode 规范化形式
+const (
+	FormNFC  = "NFC"
+	FormNFD  = "NFD"
+	FormNFKC = "NFKC"
+	FormNFKD = "NFKD"
+)
+
+// CodePoint 单个码点的详细信息
+type CodePoint struct {
+	Char      string `json:"char"`
+	CodePoint string `json:"codePoint"`
+	Name      string `json:"name"`
+	Script    string `json:"script"`
+	Category  string `json:"category"`
+	UTF8      string `json:"utf8"`
+}
+
+// NormalizationResult 规范化结果
+type NormalizationResult struct {
+	Form             string      `json:"form"`
+	Output           string      `json:"output"`
+	Changed          bool        `json:"changed"`
+	InputCodePoints  []CodePoint `json:"inputCodePoints"`
+	OutputCodePoints []CodePoint `json:"outputCodePoints"`
+	// IsNormalized 输入是否已符合各规范化形式
+	IsNormalized map[string]bool `json:"isNormalized"`
+}
+
+// Normalizer 提供 Unicode 规范化功能
+type Normalizer struct{}
+
+// NewNormalizer 创建新的 Normalizer 实例
+func NewNormalizer() *Normalizer {
+	return &Normalizer{}
+}
+
+// normForm 返回规范化形式对应的实现
+func normForm(form string) (norm.Form, bool) {
+	switch form {
+	case FormNFC:
+		return norm.NFC, true
+	case FormNFD:
+		return norm.NFD, true
+	case FormNFKC:
+		return norm.NFKC, true
+	case FormNFKD:
+		return norm.NFKD, true
+	default:
+		return 0, false
+	}
+}
+
+// Normalize 使用指定形式规范化字符串，并给出规范化前后的码点明细
+func (n *Normalizer) Normalize(input, form string) (*NormalizationResult, error) {
+	f, ok := normForm(form)
+	if !ok {
+		return nil, errors.Wrapf(ErrUnsupportedNormForm, "不支持的规范化形式: %s", form)
+	}
+
+	output := f.String(input)
+	result := &NormalizationResult{
+		Form:             form,
+		Output:           output,
+		Changed:          output != input,
+		InputCodePoints:  Breakdown(input),
+		OutputCodePoints: Breakdown(output),
+		IsNormalized:     map[string]bool{},
+	}
+	for _, name := range []string{FormNFC, FormNFD, FormNFKC, FormNFKD} {
+		candidate, _ := normForm(name)
+		result.IsNormalized[name] = candidate.IsNormalString(input)
+	}
+	return result, nil
+}
+
+// Breakdown 返回字符串中每个码点的名称、文字系统、类别与 UTF-8 编码
+func Breakdown(s string) []CodePoint {
+	points := []CodePoint{}
+	for _, r := range s {
+		points = append(points, CodePoint{
+			Char:      string(r),
+			CodePoint: formatCodePoint(r),
+			Name:      runenames.Name(r),
+			Script:    ScriptOf(r),
+			Category:  generalCategory(r),
+			UTF8:      hex.EncodeToString([]byte(string(r))),
+		})
+	}
+	return points
+}
+
+// formatCodePoint 格式化码点，如 U+00E9
+func formatCodePoint(r rune) string {
+	return fmt.Sprintf("U+%04X", r)
+}
+
+// generalCategory 返回字符的 Unicode 通用类别缩写（如 Lu、Mn）
+func generalCategory(r rune) string {
+	for _, name := range []string{"Lu", "Ll", "Lt", "Lm", "Lo", "Mn", "Mc", "Me", "Nd", "Nl", "No",
+		"Pc", "Pd", "Ps", "Pe", "Pi", "Pf", "Po", "Sm", "Sc", "Sk", "So", "Zs", "Zl", "Zp", "Cc", "Cf", "Co", "Cs"} {
+		if unicode.Is(unicode.Categories[name], r) {
+			return name
+		}
+	}
+	return "Cn"
+}
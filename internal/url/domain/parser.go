@@ -0,0 +1,240 @@
+package domain
+
+import (
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// QueryParam 查询参数（保留原始顺序与重复键）
+type QueryParam struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+	// Bare 参数原文没有 "="（如 ?flag），值为空时组装结果同样省略 "="
+	Bare bool `json:"bare,omitempty"`
+}
+
+// URLComponents URL 各组成部分，所有字段均为解码后的值，可编辑后重新组装
+type URLComponents struct {
+	Scheme   string `json:"scheme"`
+	Username string `json:"username"`
+	Password string `json:"password"`
+	Host     string `json:"host"`
+	Port     string `json:"port"`
+	// PathSegments 路径段，以 "/" 结尾的路径最后一段为空串（根路径 "/" 即 [""]），没有路径时为空
+	PathSegments []string     `json:"pathSegments"`
+	Query        []QueryParam `json:"query"`
+	Fragment     string       `json:"fragment"`
+	// Opaque 非层级 URL 的不透明部分（如 mailto:user@example.com 中的 user@example.com）
+	Opaque string `json:"opaque"`
+}
+
+// Parser 提供 URL 解析功能
+type Parser struct{}
+
+// NewParser 创建新的 Parser 实例
+func NewParser() *Parser {
+	return &Parser{}
+}
+
+// Parse 将 URL 拆分为各组成部分
+// 路径按 "/" 拆分为段（段内的 %2F 解码为普通字符），查询参数按出现顺序保留
+func (p *Parser) Parse(rawURL string) (*URLComponents, error) {
+	rawURL = strings.TrimSpace(rawURL)
+	if rawURL == "" {
+		return nil, errors.WithStack(ErrEmptyInput)
+	}
+
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, errors.Wrapf(ErrInvalidURL, "%v", err)
+	}
+
+	components := &URLComponents{
+		Scheme:   u.Scheme,
+		Host:     u.Hostname(),
+		Port:     u.Port(),
+		Fragment: u.Fragment,
+		Opaque:   u.Opaque,
+	}
+	if u.User != nil {
+		components.Username = u.User.Username()
+		components.Password, _ = u.User.Password()
+	}
+
+	segments, err := splitPath(u.EscapedPath())
+	if err != nil {
+		return nil, err
+	}
+	components.PathSegments = segments
+
+	query, err := ParseQuery(u.RawQuery)
+	if err != nil {
+		return nil, err
+	}
+	components.Query = query
+
+	return components, nil
+}
+
+// splitPath 将转义后的路径拆分为解码后的路径段，忽略开头的 "/"
+// 结尾的 "/" 保留为最后一个空段，根路径 "/" 拆分为 [""]，保证未编辑时能原样组装
+func splitPath(escapedPath string) ([]string, error) {
+	if escapedPath == "" {
+		return []string{}, nil
+	}
+	escapedPath = strings.TrimPrefix(escapedPath, "/")
+
+	parts := strings.Split(escapedPath, "/")
+	segments := make([]string, len(parts))
+	for i, part := range parts {
+		segment, err := url.PathUnescape(part)
+		if err != nil {
+			return nil, errors.Wrapf(ErrInvalidURL, "路径段 %q: %v", part, err)
+		}
+		segments[i] = segment
+	}
+	return segments, nil
+}
+
+// ParseQuery 按出现顺序解析查询字符串，保留重复键，"+" 解码为空格
+func ParseQuery(rawQuery string) ([]QueryParam, error) {
+	params := []QueryParam{}
+	rawQuery = strings.TrimPrefix(rawQuery, "?")
+	for _, pair := range strings.Split(rawQuery, "&") {
+		if pair == "" {
+			continue
+		}
+		rawKey, rawValue, hasEquals := strings.Cut(pair, "=")
+		key, err := url.QueryUnescape(rawKey)
+		if err != nil {
+			return nil, errors.Wrapf(ErrInvalidURL, "查询参数名 %q: %v", rawKey, err)
+		}
+		value, err := url.QueryUnescape(rawValue)
+		if err != nil {
+			return nil, errors.Wrapf(ErrInvalidURL, "查询参数 %s 的值 %q: %v", key, rawValue, err)
+		}
+		params = append(params, QueryParam{Key: key, Value: value, Bare: !hasEquals})
+	}
+	return params, nil
+}
+
+// Builder 提供 URL 组装功能
+type Builder struct{}
+
+// NewBuilder 创建新的 Builder 实例
+func NewBuilder() *Builder {
+	return &Builder{}
+}
+
+// Build 将各组成部分重新组装为 URL，每个部分按各自的规则转义
+func (b *Builder) Build(c *URLComponents) (string, error) {
+	if c == nil {
+		return "", errors.WithStack(ErrEmptyInput)
+	}
+	// 端口依附于主机，没有主机时不能静默丢弃
+	if c.Port != "" && c.Host == "" {
+		return "", errors.Wrapf(ErrInvalidPort, "设置了端口 %s 但缺少主机", c.Port)
+	}
+
+	var sb strings.Builder
+	if c.Scheme != "" {
+		if !isValidScheme(c.Scheme) {
+			return "", errors.Wrapf(ErrInvalidScheme, "无效的协议: %s", c.Scheme)
+		}
+		sb.WriteString(strings.ToLower(c.Scheme))
+		sb.WriteByte(':')
+	}
+
+	if c.Opaque != "" {
+		sb.WriteString(c.Opaque)
+	} else {
+		if c.Host != "" || c.Username != "" {
+			sb.WriteString("//")
+			if c.Username != "" || c.Password != "" {
+				user := url.User(c.Username)
+				if c.Password != "" {
+					user = url.UserPassword(c.Username, c.Password)
+				}
+				sb.WriteString(user.String())
+				sb.WriteByte('@')
+			}
+			host, err := buildHost(c.Host, c.Port)
+			if err != nil {
+				return "", err
+			}
+			sb.WriteString(host)
+		}
+		sb.WriteString(BuildPath(c.PathSegments))
+	}
+
+	if query := BuildQuery(c.Query); query != "" {
+		sb.WriteByte('?')
+		sb.WriteString(query)
+	}
+	if c.Fragment != "" {
+		sb.WriteByte('#')
+		sb.WriteString((&url.URL{Fragment: c.Fragment}).EscapedFragment())
+	}
+	return sb.String(), nil
+}
+
+// buildHost 组装主机与端口，IPv6 地址自动加方括号
+func buildHost(host, port string) (string, error) {
+	if port == "" {
+		if strings.Contains(host, ":") {
+			return "[" + host + "]", nil
+		}
+		return host, nil
+	}
+	n, err := strconv.Atoi(port)
+	if err != nil || n < 0 || n > 65535 {
+		return "", errors.Wrapf(ErrInvalidPort, "无效的端口: %s", port)
+	}
+	return net.JoinHostPort(host, port), nil
+}
+
+// BuildPath 将路径段转义后以 "/" 连接，最后一段为空串时保留结尾的 "/"
+func BuildPath(segments []string) string {
+	if len(segments) == 0 {
+		return ""
+	}
+	escaped := make([]string, len(segments))
+	for i, segment := range segments {
+		escaped[i] = url.PathEscape(segment)
+	}
+	return "/" + strings.Join(escaped, "/")
+}
+
+// BuildQuery 按顺序组装查询字符串（application/x-www-form-urlencoded 规则）
+// 原文没有 "=" 且值仍为空的参数只输出参数名
+func BuildQuery(params []QueryParam) string {
+	pairs := make([]string, 0, len(params))
+	for _, param := range params {
+		if param.Key == "" && param.Value == "" {
+			continue
+		}
+		if param.Bare && param.Value == "" {
+			pairs = append(pairs, url.QueryEscape(param.Key))
+			continue
+		}
+		pairs = append(pairs, url.QueryEscape(param.Key)+"="+url.QueryEscape(param.Value))
+	}
+	return strings.Join(pairs, "&")
+}
+
+// isValidScheme 判断协议名是否符合 RFC 3986：字母开头，后接字母、数字、"+"、"-"、"."
+func isValidScheme(scheme string) bool {
+	for i, r := range scheme {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z':
+		case i > 0 && (r >= '0' && r <= '9' || r == '+' || r == '-' || r == '.'):
+		default:
+			return false
+		}
+	}
+	return scheme != ""
+}
@@ -0,0 +1,337 @@
+package domain
+
+import (
+	"encoding/json"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// 查询字符串嵌套键约定
+const (
+	ConventionFlat   = "flat"   // 不解析嵌套，重复键转为数组
+	ConventionRails  = "rails"  // PHP/Rails：a[b][c]=1，数组使用 a[]=1&a[]=2
+	ConventionQs     = "qs"     // Node qs：a[b][c]=1，数组使用下标 a[0]=1&a[1]=2
+	ConventionSpring = "spring" // Spring：a.b.c=1，数组使用下标 a[0].b=1
+)
+
+const (
+	// maxArrayIndex 下标形式数组允许的最大下标，超过时按对象键处理，避免构造超大数组
+	maxArrayIndex = 1000
+)
+
+// ConventionInfo 嵌套键约定描述
+type ConventionInfo struct {
+	Name        string `json:"name"`
+	Label       string `json:"label"`
+	Description string `json:"description"`
+}
+
+// conventions 嵌套键约定列表
+var conventions = []ConventionInfo{
+	{Name: ConventionFlat, Label: "扁平", Description: "不解析嵌套，重复键转为数组"},
+	{Name: ConventionRails, Label: "PHP / Rails", Description: "a[b][c]=1，数组形如 a[]=1&a[]=2"},
+	{Name: ConventionQs, Label: "qs", Description: "a[b][c]=1，数组形如 a[0]=1&a[1]=2"},
+	{Name: ConventionSpring, Label: "Spring", Description: "a.b.c=1，数组形如 a[0].b=1"},
+}
+
+// ListConventions 返回所有支持的嵌套键约定
+func ListConventions() []ConventionInfo {
+	result := make([]ConventionInfo, len(conventions))
+	copy(result, conventions)
+	return result
+}
+
+// QueryJSONConverter 提供查询字符串（含表单请求体）与 JSON 值之间的转换
+type QueryJSONConverter struct{}
+
+// NewQueryJSONConverter 创建新的 QueryJSONConverter 实例
+func NewQueryJSONConverter() *QueryJSONConverter {
+	return &QueryJSONConverter{}
+}
+
+// ToValue 将查询字符串按指定约定转换为嵌套的 JSON 值（map[string]interface{}）
+// 输入可以是完整 URL、以 "?" 开头的查询字符串或表单请求体，所有叶子值均为字符串
+func (c *QueryJSONConverter) ToValue(input, convention string) (map[string]interface{}, error) {
+	if !isValidConvention(convention) {
+		return nil, errors.Wrapf(ErrUnsupportedConvention, "不支持的嵌套键约定: %s", convention)
+	}
+
+	params, err := ParseQuery(extractQuery(input))
+	if err != nil {
+		return nil, err
+	}
+
+	root := map[string]interface{}{}
+	for _, param := range params {
+		path := splitKey(param.Key, convention)
+		if _, err := assign(root, path, param.Value, convention); err != nil {
+			return nil, errors.Wrapf(err, "参数 %s", param.Key)
+		}
+	}
+	return root, nil
+}
+
+// FromValue 将 JSON 值按指定约定展开为有序的查询参数，对象的键按字典序输出
+func (c *QueryJSONConverter) FromValue(value interface{}, convention string) ([]QueryParam, error) {
+	if !isValidConvention(convention) {
+		return nil, errors.Wrapf(ErrUnsupportedConvention, "不支持的嵌套键约定: %s", convention)
+	}
+	object, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, errors.Wrapf(ErrInvalidQueryValue, "顶层必须是 JSON 对象")
+	}
+
+	params := []QueryParam{}
+	for _, key := range sortedKeys(object) {
+		if err := flatten(&params, key, object[key], convention); err != nil {
+			return nil, err
+		}
+	}
+	return params, nil
+}
+
+// BuildNestedQuery 组装查询字符串，键中的 "[" "]" 保持原样以便阅读
+func BuildNestedQuery(params []QueryParam) string {
+	query := BuildQuery(params)
+	return strings.NewReplacer("%5B", "[", "%5D", "]").Replace(query)
+}
+
+// isValidConvention 判断嵌套键约定是否受支持
+func isValidConvention(convention string) bool {
+	for _, info := range conventions {
+		if info.Name == convention {
+			return true
+		}
+	}
+	return false
+}
+
+// extractQuery 从完整 URL、查询字符串或多行表单请求体中提取查询部分
+func extractQuery(input string) string {
+	input = strings.TrimSpace(input)
+	if u, err := url.Parse(input); err == nil && u.Scheme != "" && u.Host != "" {
+		return u.RawQuery
+	}
+	if i := strings.IndexByte(input, '?'); i >= 0 && !strings.Contains(input[:i], "=") {
+		input = input[i+1:]
+	}
+	if i := strings.IndexByte(input, '#'); i >= 0 {
+		input = input[:i]
+	}
+	// 多行表单请求体：换行视为参数分隔符
+	lines := strings.FieldsFunc(input, func(r rune) bool { return r == '\n' || r == '\r' })
+	return strings.Join(lines, "&")
+}
+
+// splitKey 按约定将参数名拆分为路径，"" 表示数组追加（a[]）
+func splitKey(key, convention string) []string {
+	if convention == ConventionFlat {
+		return []string{key}
+	}
+
+	var path []string
+	var current strings.Builder
+	for i := 0; i < len(key); i++ {
+		switch ch := key[i]; {
+		case ch == '[' && (i > 0 || current.Len() > 0):
+			end := strings.IndexByte(key[i:], ']')
+			if end < 0 {
+				current.WriteString(key[i:])
+				i = len(key)
+				continue
+			}
+			if current.Len() > 0 || len(path) == 0 {
+				path = append(path, current.String())
+				current.Reset()
+			}
+			path = append(path, key[i+1:i+end])
+			i += end
+		case ch == '.' && convention == ConventionSpring:
+			if current.Len() > 0 {
+				path = append(path, current.String())
+				current.Reset()
+			}
+		default:
+			current.WriteByte(ch)
+		}
+	}
+	if current.Len() > 0 || len(path) == 0 {
+		path = append(path, current.String())
+	}
+	return path
+}
+
+// arrayIndex 解析下标形式的数组路径段，Rails 约定中数字键按对象键处理
+func arrayIndex(segment, convention string) (int, bool) {
+	if convention == ConventionRails || convention == ConventionFlat {
+		return 0, false
+	}
+	index, err := strconv.Atoi(segment)
+	if err != nil || index < 0 || index > maxArrayIndex || strconv.Itoa(index) != segment {
+		return 0, false
+	}
+	return index, true
+}
+
+// assign 将值写入路径对应的位置并返回更新后的节点；同一路径重复出现时转为数组
+func assign(node interface{}, path []string, value, convention string) (interface{}, error) {
+	if len(path) == 0 {
+		switch existing := node.(type) {
+		case nil:
+			return value, nil
+		case string:
+			return []interface{}{existing, value}, nil
+		case []interface{}:
+			return append(existing, value), nil
+		default:
+			return nil, errors.Wrapf(ErrQueryConflict, "同一键既是对象又是值")
+		}
+	}
+
+	segment, rest := path[0], path[1:]
+
+	if segment == "" {
+		// a[]=1：追加到数组末尾
+		list, isList := node.([]interface{})
+		if node != nil && !isList {
+			existing, isString := node.(string)
+			if !isString {
+				return nil, errors.Wrapf(ErrQueryConflict, "同一键既是对象又是数组")
+			}
+			list = []interface{}{existing}
+		}
+		// Rails（Rack）约定中 a[][x]=1&a[][y]=2 合并到最后一个对象，键已存在时才开始新对象
+		if convention == ConventionRails && len(rest) > 0 && len(list) > 0 {
+			if last, isMap := list[len(list)-1].(map[string]interface{}); isMap && !hasPath(last, rest) {
+				child, err := assign(last, rest, value, convention)
+				if err != nil {
+					return nil, err
+				}
+				list[len(list)-1] = child
+				return list, nil
+			}
+		}
+		child, err := assign(nil, rest, value, convention)
+		if err != nil {
+			return nil, err
+		}
+		return append(list, child), nil
+	}
+
+	if index, ok := arrayIndex(segment, convention); ok {
+		list, isList := node.([]interface{})
+		if node != nil && !isList {
+			if _, isMap := node.(map[string]interface{}); !isMap {
+				return nil, errors.Wrapf(ErrQueryConflict, "同一键既是数组又是值")
+			}
+		} else {
+			for len(list) <= index {
+				list = append(list, nil)
+			}
+			child, err := assign(list[index], rest, value, convention)
+			if err != nil {
+				return nil, err
+			}
+			list[index] = child
+			return list, nil
+		}
+	}
+
+	object, isMap := node.(map[string]interface{})
+	if node != nil && !isMap {
+		return nil, errors.Wrapf(ErrQueryConflict, "同一键既是对象又是值")
+	}
+	if object == nil {
+		object = map[string]interface{}{}
+	}
+	child, err := assign(object[segment], rest, value, convention)
+	if err != nil {
+		return nil, err
+	}
+	object[segment] = child
+	return object, nil
+}
+
+// hasPath 判断对象中是否已存在路径对应的值，路径中含数组追加段时视为不存在（与 Rack 一致）
+func hasPath(object map[string]interface{}, path []string) bool {
+	var node interface{} = object
+	for _, segment := range path {
+		if segment == "" {
+			return false
+		}
+		current, isMap := node.(map[string]interface{})
+		if !isMap {
+			return false
+		}
+		child, exists := current[segment]
+		if !exists {
+			return false
+		}
+		node = child
+	}
+	return true
+}
+
+// flatten 按约定将 JSON 值展开为查询参数
+func flatten(params *[]QueryParam, prefix string, value interface{}, convention string) error {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if convention == ConventionFlat {
+			return errors.Wrapf(ErrInvalidQueryValue, "扁平约定不支持嵌套对象: %s", prefix)
+		}
+		for _, key := range sortedKeys(v) {
+			childKey := prefix + "[" + key + "]"
+			if convention == ConventionSpring {
+				childKey = prefix + "." + key
+			}
+			if err := flatten(params, childKey, v[key], convention); err != nil {
+				return err
+			}
+		}
+	case []interface{}:
+		for i, item := range v {
+			var childKey string
+			switch convention {
+			case ConventionFlat:
+				if _, nested := item.(map[string]interface{}); nested {
+					return errors.Wrapf(ErrInvalidQueryValue, "扁平约定不支持嵌套对象: %s", prefix)
+				}
+				childKey = prefix
+			case ConventionRails:
+				childKey = prefix + "[]"
+			default:
+				childKey = prefix + "[" + strconv.Itoa(i) + "]"
+			}
+			if err := flatten(params, childKey, item, convention); err != nil {
+				return err
+			}
+		}
+	case nil:
+		*params = append(*params, QueryParam{Key: prefix, Value: ""})
+	case string:
+		*params = append(*params, QueryParam{Key: prefix, Value: v})
+	case json.Number:
+		*params = append(*params, QueryParam{Key: prefix, Value: v.String()})
+	case float64:
+		*params = append(*params, QueryParam{Key: prefix, Value: strconv.FormatFloat(v, 'f', -1, 64)})
+	case bool:
+		*params = append(*params, QueryParam{Key: prefix, Value: strconv.FormatBool(v)})
+	default:
+		return errors.Wrapf(ErrInvalidQueryValue, "不支持的值类型: %T", value)
+	}
+	return nil
+}
+
+// sortedKeys 返回按字典序排列的对象键
+func sortedKeys(object map[string]interface{}) []string {
+	keys := make([]string, 0, len(object))
+	for key := range object {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,157 @@
+package domain
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestQueryJSONConverter_ToValue(t *testing.T) {
+	converter := NewQueryJSONConverter()
+
+	tests := []struct {
+		name       string
+		input      string
+		convention string
+		want       map[string]interface{}
+	}{
+		{
+			name:       "flat repeated keys",
+			input:      "?tag=a&tag=b&q=x+y&user[name]=bob",
+			convention: ConventionFlat,
+			want: map[string]interface{}{
+				"tag":        []interface{}{"a", "b"},
+				"q":          "x y",
+				"user[name]": "bob",
+			},
+		},
+		{
+			name:       "rails",
+			input:      "user[name]=bob&user[roles][]=admin&user[roles][]=dev&user[0]=zero",
+			convention: ConventionRails,
+			want: map[string]interface{}{
+				"user": map[string]interface{}{
+					"name":  "bob",
+					"roles": []interface{}{"admin", "dev"},
+					"0":     "zero",
+				},
+			},
+		},
+		{
+			name:       "rails array of objects",
+			input:      "items[][id]=1&items[][name]=a&items[][id]=2&items[][tags][]=x&items[][tags][]=y",
+			convention: ConventionRails,
+			want: map[string]interface{}{
+				"items": []interface{}{
+					map[string]interface{}{"id": "1", "name": "a"},
+					map[string]interface{}{"id": "2", "tags": []interface{}{"x", "y"}},
+				},
+			},
+		},
+		{
+			name:       "qs indexes",
+			input:      "https://example.com/search?items[1][id]=2&items[0][id]=1&filter[a.b]=c",
+			convention: ConventionQs,
+			want: map[string]interface{}{
+				"items": []interface{}{
+					map[string]interface{}{"id": "1"},
+					map[string]interface{}{"id": "2"},
+				},
+				"filter": map[string]interface{}{"a.b": "c"},
+			},
+		},
+		{
+			name:       "spring",
+			input:      "user.name=bob\nuser.addresses[0].city=Paris\nuser.addresses[1].city=Rome",
+			convention: ConventionSpring,
+			want: map[string]interface{}{
+				"user": map[string]interface{}{
+					"name": "bob",
+					"addresses": []interface{}{
+						map[string]interface{}{"city": "Paris"},
+						map[string]interface{}{"city": "Rome"},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := converter.ToValue(tt.input, tt.convention)
+			if err != nil {
+				t.Fatalf("ToValue() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ToValue() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := converter.ToValue("a=1&a[b]=2", ConventionQs); err == nil {
+		t.Errorf("ToValue() should report conflicting structures")
+	}
+}
+
+func TestQueryJSONConverter_FromValue(t *testing.T) {
+	converter := NewQueryJSONConverter()
+	value := map[string]interface{}{
+		"user": map[string]interface{}{
+			"name":  "bob & co",
+			"roles": []interface{}{"admin", "dev"},
+		},
+		"page": float64(2),
+	}
+
+	tests := []struct {
+		convention string
+		want       string
+	}{
+		{convention: ConventionRails, want: "page=2&user[name]=bob+%26+co&user[roles][]=admin&user[roles][]=dev"},
+		{convention: ConventionQs, want: "page=2&user[name]=bob+%26+co&user[roles][0]=admin&user[roles][1]=dev"},
+		{convention: ConventionSpring, want: "page=2&user.name=bob+%26+co&user.roles[0]=admin&user.roles[1]=dev"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.convention, func(t *testing.T) {
+			params, err := converter.FromValue(value, tt.convention)
+			if err != nil {
+				t.Fatalf("FromValue() error = %v", err)
+			}
+			got := BuildNestedQuery(params)
+			if got != tt.want {
+				t.Errorf("FromValue() = %s, want %s", got, tt.want)
+			}
+
+			back, err := converter.ToValue(got, tt.convention)
+			if err != nil {
+				t.Fatalf("ToValue() error = %v", err)
+			}
+			if back["user"].(map[string]interface{})["name"] != "bob & co" {
+				t.Errorf("round trip = %#v", back)
+			}
+		})
+	}
+
+	// 对象数组经过 Rails 约定往返后保持不变
+	objects := map[string]interface{}{
+		"items": []interface{}{
+			map[string]interface{}{"id": "1", "name": "a"},
+			map[string]interface{}{"id": "2", "name": "b"},
+		},
+	}
+	params, err := converter.FromValue(objects, ConventionRails)
+	if err != nil {
+		t.Fatalf("FromValue() error = %v", err)
+	}
+	back, err := converter.ToValue(BuildNestedQuery(params), ConventionRails)
+	if err != nil {
+		t.Fatalf("ToValue() error = %v", err)
+	}
+	if !reflect.DeepEqual(back, objects) {
+		t.Errorf("round trip = %#v, want %#v", back, objects)
+	}
+
+	if _, err := converter.FromValue(value, ConventionFlat); err == nil {
+		t.Errorf("FromValue() should reject nested objects in flat convention")
+	}
+}
@@ -0,0 +1,96 @@
+package domain
+
+import (
+	"testing"
+)
+
+func TestIDNConverter_Convert(t *testing.T) {
+	converter := NewIDNConverter()
+
+	tests := []struct {
+		name        string
+		input       string
+		wantASCII   string
+		wantUnicode string
+	}{
+		{name: "unicode", input: "Bücher.example", wantASCII: "xn--bcher-kva.example", wantUnicode: "bücher.example"},
+		{name: "punycode", input: "xn--fiqs8s.cn", wantASCII: "xn--fiqs8s.cn", wantUnicode: "中国.cn"},
+		{name: "url", input: "https://münchen.de:8080/path", wantASCII: "xn--mnchen-3ya.de", wantUnicode: "münchen.de"},
+		{name: "nontransitional", input: "faß.de", wantASCII: "xn--fa-hia.de", wantUnicode: "faß.de"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := converter.Convert(tt.input)
+			if err != nil {
+				t.Fatalf("Convert() error = %v", err)
+			}
+			if got.ASCII != tt.wantASCII || got.Unicode != tt.wantUnicode {
+				t.Errorf("Convert() = %s / %s, want %s / %s", got.ASCII, got.Unicode, tt.wantASCII, tt.wantUnicode)
+			}
+		})
+	}
+
+	if _, err := converter.Convert("xn--a.com"); err == nil {
+		t.Errorf("Convert() should reject invalid punycode")
+	}
+}
+
+func TestScriptAnalyzer_AnalyzeHost(t *testing.T) {
+	analyzer := NewScriptAnalyzer()
+
+	tests := []struct {
+		name         string
+		host         string
+		wantRisk     string
+		wantSkeleton string
+	}{
+		{name: "latin", host: "apple.com", wantRisk: RiskNone, wantSkeleton: "apple.com"},
+		{name: "mixed cyrillic a", host: "аpple.com", wantRisk: RiskMixedScript, wantSkeleton: "apple.com"},
+		{name: "whole script", host: "рауа.com", wantRisk: RiskWholeScriptConfusable, wantSkeleton: "paya.com"},
+		{name: "japanese", host: "東京タワーtokyo.jp", wantRisk: RiskNone, wantSkeleton: "東京タワーtokyo.jp"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report := analyzer.AnalyzeHost(tt.host)
+			if report.Labels[0].Risk != tt.wantRisk {
+				t.Errorf("Risk = %s, want %s (%+v)", report.Labels[0].Risk, tt.wantRisk, report.Labels[0])
+			}
+			if report.Skeleton != tt.wantSkeleton {
+				t.Errorf("Skeleton = %s, want %s", report.Skeleton, tt.wantSkeleton)
+			}
+			if report.Suspicious != (tt.wantRisk != RiskNone) {
+				t.Errorf("Suspicious = %v", report.Suspicious)
+			}
+		})
+	}
+}
+
+func TestNormalizer_Normalize(t *testing.T) {
+	normalizer := NewNormalizer()
+
+	// "é" 由 e + U+0301 组合而成
+	result, err := normalizer.Normalize("é", FormNFC)
+	if err != nil {
+		t.Fatalf("Normalize() error = %v", err)
+	}
+	if result.Output != "é" || !result.Changed || len(result.InputCodePoints) != 2 || len(result.OutputCodePoints) != 1 {
+		t.Errorf("Normalize(NFC) = %+v", result)
+	}
+	if result.InputCodePoints[1].CodePoint != "U+0301" || result.InputCodePoints[1].Name != "COMBINING ACUTE ACCENT" || result.InputCodePoints[1].Category != "Mn" {
+		t.Errorf("code point = %+v", result.InputCodePoints[1])
+	}
+	if result.IsNormalized[FormNFC] || !result.IsNormalized[FormNFD] {
+		t.Errorf("IsNormalized = %v", result.IsNormalized)
+	}
+
+	result, err = normalizer.Normalize("ﬁ①", FormNFKC)
+	if err != nil || result.Output != "fi1" {
+		t.Errorf("Normalize(NFKC) = %+v, %v", result, err)
+	}
+
+	if _, err := normalizer.Normalize("x", "NFX"); err == nil {
+		t.Errorf("Normalize() should reject unknown forms")
+	}
+}
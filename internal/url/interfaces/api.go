@@ -3,6 +3,7 @@ package interfaces
 import (
 	historydomain "github.com/cyrnicolase/dev-tools/internal/history/domain"
 	"github.com/cyrnicolase/dev-tools/internal/url/application"
+	"github.com/cyrnicolase/dev-tools/internal/url/domain"
 )
 
 // API URL 工具 API 接口
@@ -27,6 +28,76 @@ func (a *API) Decode(input string) (string, error) {
 	return a.service.Decode(input)
 }
 
+// ListModes 返回所有支持的编码模式
+func (a *API) ListModes() []domain.ModeInfo {
+	return a.service.ListModes()
+}
+
+// EncodeMode 使用指定模式编码
+func (a *API) EncodeMode(mode, input string) (string, error) {
+	return a.service.EncodeMode(mode, input)
+}
+
+// DecodeMode 使用指定模式解码
+func (a *API) DecodeMode(mode, input string) (string, error) {
+	return a.service.DecodeMode(mode, input)
+}
+
+// DecodeRecursive 递归解码多次编码的字符串
+func (a *API) DecodeRecursive(mode, input string) (*domain.RecursiveDecodeResult, error) {
+	return a.service.DecodeRecursive(mode, input)
+}
+
+// Parse 将 URL 拆分为各组成部分
+func (a *API) Parse(rawURL string) (*domain.URLComponents, error) {
+	return a.service.Parse(rawURL)
+}
+
+// Build 将各组成部分重新组装为 URL
+func (a *API) Build(components *domain.URLComponents) (string, error) {
+	return a.service.Build(components)
+}
+
+// ListConventions 返回所有支持的嵌套键约定
+func (a *API) ListConventions() []domain.ConventionInfo {
+	return a.service.ListConventions()
+}
+
+// QueryToJSON 将查询字符串转换为 JSON
+func (a *API) QueryToJSON(input, convention string) (string, error) {
+	return a.service.QueryToJSON(input, convention)
+}
+
+// JSONToQuery 将 JSON 转换为查询字符串
+func (a *API) JSONToQuery(input, convention string) (string, error) {
+	return a.service.JSONToQuery(input, convention)
+}
+
+// ConvertIDN 在国际化域名的 Unicode 与 Punycode 形式之间转换
+func (a *API) ConvertIDN(input string) (*domain.IDNResult, error) {
+	return a.service.ConvertIDN(input)
+}
+
+// AnalyzeScripts 分析同形异义字符
+func (a *API) AnalyzeScripts(input string) *domain.ScriptReport {
+	return a.service.AnalyzeScripts(input)
+}
+
+// Normalize Unicode 规范化
+func (a *API) Normalize(input, form string) (*domain.NormalizationResult, error) {
+	return a.service.Normalize(input, form)
+}
+
+// ParseCurl 解析 curl 命令
+func (a *API) ParseCurl(command string) (*application.CurlParseResult, error) {
+	return a.service.ParseCurl(command)
+}
+
+// ConvertCurl 将 curl 命令转换为指定目标的代码
+func (a *API) ConvertCurl(command, target string) (string, error) {
+	return a.service.ConvertCurl(command, target)
+}
+
 // ListHistory 获取历史记录
 func (a *API) ListHistory() ([]historydomain.ToolHistoryRecord, error) {
 	return a.service.ListHistory()
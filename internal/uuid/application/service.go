@@ -1,7 +1,10 @@
 package application
 
 import (
+	"time"
+
 	historydomain "github.com/cyrnicolase/dev-tools/internal/history/domain"
+	timestampdomain "github.com/cyrnicolase/dev-tools/internal/timestamp/domain"
 	"github.com/cyrnicolase/dev-tools/internal/uuid/domain"
 	"github.com/pkg/errors"
 )
@@ -9,17 +12,60 @@ import (
 // Service UUID 工具应用服务
 type Service struct {
 	generator      *domain.Generator
+	inspector      *domain.Inspector
+	parser         *domain.Parser
+	formatter      *timestampdomain.Formatter
 	historyStore   *historydomain.ToolHistoryStore
 	historyInitErr error
 }
 
 const uuidToolID = "uuid"
 
+// IDInspection ID 解析结果的展示信息
+type IDInspection struct {
+	Kind       string `json:"kind"`
+	Normalized string `json:"normalized"`
+	// Layout Snowflake 布局名称
+	Layout  string `json:"layout"`
+	HasTime bool   `json:"hasTime"`
+	// Time 按所选格式与时区格式化的嵌入时间
+	Time       string               `json:"time"`
+	UnixMilli  int64                `json:"unixMilli"`
+	UTC        string               `json:"utc"`
+	Components []domain.IDComponent `json:"components"`
+}
+
+// GenerateResult 批量生成结果
+type GenerateResult struct {
+	IDs []string `json:"ids"`
+	// Output 按输出选项格式化后的文本
+	Output string `json:"output"`
+}
+
+// HashNamesResult 按名称批量生成结果
+type HashNamesResult struct {
+	Items []domain.NameUUID `json:"items"`
+	// Output 导出的 CSV 或 JSON 文本
+	Output string `json:"output"`
+}
+
+// UUIDParseResult UUID 解析结果的展示信息
+type UUIDParseResult struct {
+	*domain.UUIDDetails
+	// Time 按所选格式与时区格式化的时间戳（v1/v6/v7）
+	Time      string `json:"time"`
+	UnixMilli int64  `json:"unixMilli"`
+	UTC       string `json:"utc"`
+}
+
 // NewService 创建新的 Service 实例
 func NewService() *Service {
 	historyStore, historyErr := historydomain.NewToolHistoryStore()
 	return &Service{
 		generator:      domain.NewGenerator(),
+		inspector:      domain.NewInspector(),
+		parser:         domain.NewParser(),
+		formatter:      timestampdomain.NewFormatter(nil),
 		historyStore:   historyStore,
 		historyInitErr: historyErr,
 	}
@@ -55,6 +101,116 @@ func (s *Service) GenerateBatch(version string, count int, namespace, name strin
 	return s.generator.GenerateBatch(version, count, namespace, name)
 }
 
+// GenerateV6 生成 UUID v6
+func (s *Service) GenerateV6() (string, error) {
+	return s.generator.GenerateV6()
+}
+
+// GenerateV8 生成 UUID v8
+func (s *Service) GenerateV8(payload string) (string, error) {
+	return s.generator.GenerateV8(payload)
+}
+
+// GenerateULID 生成 ULID
+func (s *Service) GenerateULID() (string, error) {
+	return s.generator.GenerateULID()
+}
+
+// GenerateNanoID 生成 NanoID
+func (s *Service) GenerateNanoID(alphabet string, length int) (string, error) {
+	return s.generator.GenerateNanoID(alphabet, length)
+}
+
+// GenerateWithOptions 按选项批量生成并格式化输出
+func (s *Service) GenerateWithOptions(opts domain.GenerateOptions, format domain.OutputFormat) (*GenerateResult, error) {
+	ids, err := s.generator.Generate(opts)
+	if err != nil {
+		return nil, err
+	}
+	output, err := domain.FormatIDs(ids, opts.Version, format)
+	if err != nil {
+		return nil, err
+	}
+	return &GenerateResult{IDs: ids, Output: output}, nil
+}
+
+// ListNamespaces 返回预定义的命名空间
+func (s *Service) ListNamespaces() []domain.Namespace {
+	return domain.ListNamespaces()
+}
+
+// HashNames 为每行一个的名称生成确定的 v3/v5 UUID，并导出为 CSV 或 JSON
+func (s *Service) HashNames(version, namespace, names, exportFormat string) (*HashNamesResult, error) {
+	items, err := s.generator.HashNames(version, namespace, names)
+	if err != nil {
+		return nil, err
+	}
+	output, err := domain.ExportNameUUIDs(items, exportFormat)
+	if err != nil {
+		return nil, err
+	}
+	return &HashNamesResult{Items: items, Output: output}, nil
+}
+
+// InspectID 解析 Snowflake、ObjectID、ULID、KSUID 或 UUID，嵌入时间使用时间戳工具的格式输出
+func (s *Service) InspectID(input string, opts domain.InspectOptions, format, timezone string) (*IDInspection, error) {
+	info, err := s.inspector.Inspect(input, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &IDInspection{
+		Kind:       info.Kind,
+		Normalized: info.Normalized,
+		Layout:     info.Layout,
+		HasTime:    info.HasTime,
+		Components: info.Components,
+	}
+	if info.HasTime {
+		formatted, err := s.formatter.FormatTime(info.Time, format, timezone)
+		if err != nil {
+			return nil, err
+		}
+		result.Time = formatted
+		result.UnixMilli = info.Time.UnixMilli()
+		result.UTC = info.Time.UTC().Format(time.RFC3339Nano)
+	}
+	return result, nil
+}
+
+// ParseUUID 规范化并解析 UUID，返回版本、变体、时间戳及各种写法
+func (s *Service) ParseUUID(input, byteOrder, format, timezone string) (*UUIDParseResult, error) {
+	details, err := s.parser.Parse(input, byteOrder)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &UUIDParseResult{UUIDDetails: details}
+	if details.HasTime {
+		formatted, err := s.formatter.FormatTime(details.Time, format, timezone)
+		if err != nil {
+			return nil, err
+		}
+		result.Time = formatted
+		result.UnixMilli = details.Time.UnixMilli()
+		result.UTC = details.Time.UTC().Format(time.RFC3339Nano)
+	}
+	return result, nil
+}
+
+// ListSnowflakePresets 返回预设的 Snowflake 布局
+func (s *Service) ListSnowflakePresets() []domain.SnowflakeLayout {
+	names := domain.SnowflakePresetNames()
+	layouts := make([]domain.SnowflakeLayout, 0, len(names))
+	for _, name := range names {
+		layout, err := domain.SnowflakePreset(name)
+		if err == nil {
+			layouts = append(layouts, layout)
+		}
+	}
+	return layouts
+}
+
 // ListHistory 获取历史记录
 func (s *Service) ListHistory() ([]historydomain.ToolHistoryRecord, error) {
 	if s.historyInitErr != nil || s.historyStore == nil {
@@ -18,5 +18,21 @@ var (
 	ErrV5RequiresNamespaceAndName = UUIDError{Errmsg: "v5 requires namespace and name"}
 	// ErrUnsupportedUUIDVersion 不支持的UUID版本
 	ErrUnsupportedUUIDVersion = UUIDError{Errmsg: "不支持的UUID版本"}
+	// ErrEmptyID ID为空
+	ErrEmptyID = UUIDError{Errmsg: "ID不能为空"}
+	// ErrInvalidID 无效的ID
+	ErrInvalidID = UUIDError{Errmsg: "无效的ID"}
+	// ErrUnrecognizedID 无法识别的ID类型
+	ErrUnrecognizedID = UUIDError{Errmsg: "无法识别的ID类型"}
+	// ErrUnsupportedIDKind 不支持的ID类型
+	ErrUnsupportedIDKind = UUIDError{Errmsg: "不支持的ID类型"}
+	// ErrInvalidUUID 无效的UUID
+	ErrInvalidUUID = UUIDError{Errmsg: "无效的UUID"}
+	// ErrInvalidGenerateOptions 无效的生成选项
+	ErrInvalidGenerateOptions = UUIDError{Errmsg: "无效的生成选项"}
+	// ErrULIDOverflow 同一毫秒内生成的ULID数量超出上限
+	ErrULIDOverflow = UUIDError{Errmsg: "同一毫秒内生成的ULID数量超出上限"}
+	// ErrInvalidSnowflakeLayout 无效的Snowflake布局
+	ErrInvalidSnowflakeLayout = UUIDError{Errmsg: "无效的Snowflake布局"}
 )
 
@@ -1,12 +1,50 @@
 package domain
 
 import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"strings"
+	"sync"
+
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 )
 
+// 除 v1-v8 外支持的生成类型
+const (
+	VersionNil    = "nil"
+	VersionMax    = "max"
+	VersionULID   = "ulid"
+	VersionNanoID = "nanoid"
+)
+
+// MaxBatchCount 单次批量生成的最大数量
+const MaxBatchCount = 1000
+
+// GenerateOptions 批量生成选项
+type GenerateOptions struct {
+	// Version 类型：v1、v3、v4、v5、v6、v7、v8、nil、max、ulid、nanoid
+	Version string `json:"version"`
+	Count   int    `json:"count"`
+	// Namespace v3/v5 的命名空间：dns、url、oid、x500 或 UUID
+	Namespace string `json:"namespace"`
+	// Name v3/v5 的名称，每行一个，各生成一个 UUID（忽略 Count）
+	Name string `json:"name"`
+	// Payload v8 的自定义内容
+	Payload string `json:"payload"`
+	// Alphabet、Length NanoID 的字母表与长度，为空时使用默认值
+	Alphabet string `json:"alphabet"`
+	Length   int    `json:"length"`
+}
+
 // Generator 提供 UUID 生成功能
-type Generator struct{}
+type Generator struct {
+	// mu、lastULID 保证同一毫秒内生成的 ULID 单调递增
+	mu       sync.Mutex
+	lastULID [16]byte
+}
 
 // NewGenerator 创建新的 Generator 实例
 func NewGenerator() *Generator {
@@ -19,11 +57,11 @@ func (g *Generator) GenerateV1() string {
 	return id.String()
 }
 
-// GenerateV3 生成 UUID v3（基于命名空间和名称的 MD5）
+// GenerateV3 生成 UUID v3（基于命名空间和名称的 MD5），namespace 可以是 dns、url、oid、x500 或 UUID
 func (g *Generator) GenerateV3(namespace, name string) (string, error) {
-	nsUUID, err := uuid.Parse(namespace)
+	nsUUID, err := resolveNamespace(namespace)
 	if err != nil {
-		return "", errors.Wrapf(err, "invalid namespace: %s", namespace)
+		return "", err
 	}
 	id := uuid.NewMD5(nsUUID, []byte(name))
 	return id.String(), nil
@@ -44,70 +82,140 @@ func (g *Generator) GenerateV7() (string, error) {
 	return id.String(), nil
 }
 
-// GenerateV5 生成 UUID v5（基于命名空间和名称的 SHA-1）
+// GenerateV5 生成 UUID v5（基于命名空间和名称的 SHA-1），namespace 可以是 dns、url、oid、x500 或 UUID
 func (g *Generator) GenerateV5(namespace, name string) (string, error) {
-	nsUUID, err := uuid.Parse(namespace)
+	nsUUID, err := resolveNamespace(namespace)
 	if err != nil {
-		return "", errors.Wrapf(err, "invalid namespace: %s", namespace)
+		return "", err
 	}
 	id := uuid.NewSHA1(nsUUID, []byte(name))
 	return id.String(), nil
 }
 
+// GenerateV6 生成 UUID v6（字段按时间由高到低重排的 v1，可按字典序排序）
+// google/uuid v1.6.0 的 NewV6 未按 RFC 9562 拆分时间字段，这里自行组装
+func (g *Generator) GenerateV6() (string, error) {
+	now, seq, err := uuid.GetTime()
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+	var id uuid.UUID
+	ticks := uint64(now)
+	binary.BigEndian.PutUint32(id[0:4], uint32(ticks>>28))
+	binary.BigEndian.PutUint16(id[4:6], uint16(ticks>>12))
+	binary.BigEndian.PutUint16(id[6:8], uint16(ticks&0x0FFF)|0x6000)
+	binary.BigEndian.PutUint16(id[8:10], seq&0x3FFF|0x8000)
+	copy(id[10:], uuid.NodeID())
+	return id.String(), nil
+}
+
+// GenerateV8 生成 UUID v8（自定义内容）
+// payload 为空时使用随机数；为 32 位十六进制（可带连字符）时按原样使用；
+// 其他文本取 SHA-256 摘要的前 16 字节。版本与变体位会被覆盖
+func (g *Generator) GenerateV8(payload string) (string, error) {
+	var id uuid.UUID
+	compact := strings.ReplaceAll(strings.TrimSpace(payload), "-", "")
+	switch {
+	case payload == "":
+		if _, err := rand.Read(id[:]); err != nil {
+			return "", errors.WithStack(err)
+		}
+	case len(compact) == 32 && isHex(compact):
+		data, _ := hex.DecodeString(compact)
+		copy(id[:], data)
+	default:
+		sum := sha256.Sum256([]byte(payload))
+		copy(id[:], sum[:16])
+	}
+	id[6] = id[6]&0x0F | 0x80
+	id[8] = id[8]&0x3F | 0x80
+	return id.String(), nil
+}
+
+// GenerateNil 返回 Nil UUID（全 0）
+func (g *Generator) GenerateNil() string {
+	return uuid.Nil.String()
+}
+
+// GenerateMax 返回 Max UUID（全 1）
+func (g *Generator) GenerateMax() string {
+	return uuid.Max.String()
+}
+
 // GenerateBatch 批量生成 UUID
 func (g *Generator) GenerateBatch(version string, count int, namespace, name string) ([]string, error) {
+	return g.Generate(GenerateOptions{Version: version, Count: count, Namespace: namespace, Name: name})
+}
+
+// Generate 按选项批量生成 UUID、ULID 或 NanoID
+func (g *Generator) Generate(opts GenerateOptions) ([]string, error) {
+	count := opts.Count
 	if count <= 0 {
 		count = 1
 	}
-	if count > 1000 {
-		count = 1000 // 限制最大数量
+	if count > MaxBatchCount {
+		count = MaxBatchCount // 限制最大数量
 	}
 
-	results := make([]string, 0, count)
-
-	switch version {
+	var next func() (string, error)
+	switch opts.Version {
 	case "v1":
-		for i := 0; i < count; i++ {
-			results = append(results, g.GenerateV1())
+		next = func() (string, error) { return g.GenerateV1(), nil }
+	case "v3", "v5":
+		// 基于名称的 UUID 是确定的，重复生成没有意义：每行一个名称，各生成一个
+		if opts.Namespace == "" || opts.Name == "" {
+			if opts.Version == "v3" {
+				return nil, ErrV3RequiresNamespaceAndName
+			}
+			return nil, ErrV5RequiresNamespaceAndName
 		}
-	case "v3":
-		if namespace == "" || name == "" {
-			return nil, ErrV3RequiresNamespaceAndName
+		items, err := g.HashNames(opts.Version, opts.Namespace, opts.Name)
+		if err != nil {
+			return nil, err
 		}
-		for i := 0; i < count; i++ {
-			id, err := g.GenerateV3(namespace, name)
-			if err != nil {
-				return nil, err
-			}
-			results = append(results, id)
+		results := make([]string, len(items))
+		for i, item := range items {
+			results[i] = item.UUID
 		}
+		return results, nil
 	case "v4":
-		for i := 0; i < count; i++ {
-			results = append(results, g.GenerateV4())
-		}
+		next = func() (string, error) { return g.GenerateV4(), nil }
+	case "v6":
+		next = g.GenerateV6
 	case "v7":
-		for i := 0; i < count; i++ {
-			id, err := g.GenerateV7()
+		next = g.GenerateV7
+	case "v8":
+		if opts.Payload != "" {
+			// 指定内容时结果是确定的，与 v3/v5 一样只生成一个
+			id, err := g.GenerateV8(opts.Payload)
 			if err != nil {
 				return nil, err
 			}
-			results = append(results, id)
-		}
-	case "v5":
-		if namespace == "" || name == "" {
-			return nil, ErrV5RequiresNamespaceAndName
+			return []string{id}, nil
 		}
-		for i := 0; i < count; i++ {
-			id, err := g.GenerateV5(namespace, name)
-			if err != nil {
-				return nil, err
-			}
-			results = append(results, id)
+		next = func() (string, error) { return g.GenerateV8("") }
+	case VersionNil:
+		next = func() (string, error) { return g.GenerateNil(), nil }
+	case VersionMax:
+		next = func() (string, error) { return g.GenerateMax(), nil }
+	case VersionULID:
+		next = g.GenerateULID
+	case VersionNanoID:
+		if err := validateNanoID(opts.Alphabet, opts.Length); err != nil {
+			return nil, err
 		}
+		next = func() (string, error) { return g.GenerateNanoID(opts.Alphabet, opts.Length) }
 	default:
-		return nil, errors.Wrapf(ErrUnsupportedUUIDVersion, "unsupported UUID version: %s", version)
+		return nil, errors.Wrapf(ErrUnsupportedUUIDVersion, "unsupported UUID version: %s", opts.Version)
 	}
 
+	results := make([]string, 0, count)
+	for i := 0; i < count; i++ {
+		id, err := next()
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, id)
+	}
 	return results, nil
 }
-
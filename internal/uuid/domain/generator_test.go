@@ -0,0 +1,144 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestGeneratorGenerate(t *testing.T) {
+	generator := NewGenerator()
+	parser := NewParser()
+	tests := []struct {
+		name    string
+		opts    GenerateOptions
+		version int
+	}{
+		{"v6", GenerateOptions{Version: "v6", Count: 3}, 6},
+		{"v8 随机", GenerateOptions{Version: "v8", Count: 2}, 8},
+		{"v8 文本", GenerateOptions{Version: "v8", Payload: "order-42"}, 8},
+		{"nil", GenerateOptions{Version: VersionNil}, 0},
+		{"max", GenerateOptions{Version: VersionMax}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ids, err := generator.Generate(tt.opts)
+			if err != nil {
+				t.Fatalf("Generate error = %v", err)
+			}
+			if want := max(tt.opts.Count, 1); len(ids) != want {
+				t.Fatalf("len = %d, want %d", len(ids), want)
+			}
+			for _, id := range ids {
+				details, err := parser.Parse(id, "")
+				if err != nil {
+					t.Fatalf("Parse(%q) error = %v", id, err)
+				}
+				if details.Version != tt.version {
+					t.Errorf("Version = %d, want %d", details.Version, tt.version)
+				}
+				if details.Version == 6 && time.Since(details.Time).Abs() > time.Minute {
+					t.Errorf("v6 time = %v", details.Time)
+				}
+			}
+		})
+	}
+
+	// 相同内容的 v8 结果确定；十六进制内容只覆盖版本与变体位
+	a, _ := generator.GenerateV8("order-42")
+	b, _ := generator.GenerateV8("order-42")
+	if a != b {
+		t.Errorf("v8 not deterministic: %s != %s", a, b)
+	}
+	if got, _ := generator.GenerateV8("ffffffff-ffff-ffff-ffff-ffffffffffff"); got != "ffffffff-ffff-8fff-bfff-ffffffffffff" {
+		t.Errorf("v8 hex payload = %s", got)
+	}
+
+	// 指定内容的批量生成只返回一个，避免输出多个相同的 UUID
+	ids, err := generator.Generate(GenerateOptions{Version: "v8", Payload: "order-42", Count: 5})
+	if err != nil {
+		t.Fatalf("Generate error = %v", err)
+	}
+	if len(ids) != 1 || ids[0] != a {
+		t.Errorf("v8 payload batch = %v, want [%s]", ids, a)
+	}
+}
+
+func TestGeneratorULIDMonotonic(t *testing.T) {
+	generator := NewGenerator()
+	ids, err := generator.Generate(GenerateOptions{Version: VersionULID, Count: 200})
+	if err != nil {
+		t.Fatalf("Generate error = %v", err)
+	}
+	for i, id := range ids {
+		if len(id) != ulidLength {
+			t.Fatalf("ULID length = %d", len(id))
+		}
+		if i > 0 && id <= ids[i-1] {
+			t.Fatalf("ULID not monotonic: %s <= %s", id, ids[i-1])
+		}
+	}
+	info, err := decodeULIDInfo(ids[0])
+	if err != nil || time.Since(info.Time).Abs() > time.Minute {
+		t.Errorf("ULID time = %v, err = %v", info.Time, err)
+	}
+}
+
+func TestGeneratorNanoID(t *testing.T) {
+	generator := NewGenerator()
+
+	id, err := generator.GenerateNanoID("", 0)
+	if err != nil || len(id) != DefaultNanoIDLength {
+		t.Errorf("default NanoID = %q, err = %v", id, err)
+	}
+
+	id, err = generator.GenerateNanoID("abc", 40)
+	if err != nil || len(id) != 40 || strings.Trim(id, "abc") != "" {
+		t.Errorf("custom NanoID = %q, err = %v", id, err)
+	}
+
+	id, err = generator.GenerateNanoID("零一二", 5)
+	if err != nil || len([]rune(id)) != 5 {
+		t.Errorf("unicode NanoID = %q, err = %v", id, err)
+	}
+
+	for _, tt := range []struct {
+		alphabet string
+		length   int
+	}{{"a", 5}, {"aab", 5}, {"", MaxNanoIDLength + 1}} {
+		if _, err := generator.GenerateNanoID(tt.alphabet, tt.length); err == nil {
+			t.Errorf("GenerateNanoID(%q, %d) expected error", tt.alphabet, tt.length)
+		}
+	}
+}
+
+func TestFormatIDs(t *testing.T) {
+	ids := []string{"c232ab00-9414-11ec-b3c8-9f6bdeced846", "017f22e2-79b0-7cc3-98c4-dc0c0c07398f"}
+	tests := []struct {
+		name    string
+		version string
+		format  OutputFormat
+		want    string
+	}{
+		{"默认", "v4", OutputFormat{}, ids[0] + "\n" + ids[1]},
+		{"大写去连字符加引号", "v4", OutputFormat{Uppercase: true, NoDashes: true, Quote: `"`}, `"C232AB00941411ECB3C89F6BDECED846"` + "\n" + `"017F22E279B07CC398C4DC0C0C07398F"`},
+		{"花括号", "v4", OutputFormat{Braces: true, List: ListSQL}, "IN ('{" + ids[0] + "}', '{" + ids[1] + "}')"},
+		{"URN JSON", "v4", OutputFormat{URN: true, List: ListJSON}, "[\n  \"urn:uuid:" + ids[0] + "\",\n  \"urn:uuid:" + ids[1] + "\"\n]"},
+		{"NanoID 不做 UUID 转换", VersionNanoID, OutputFormat{Uppercase: true, Braces: true}, ids[0] + "\n" + ids[1]},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FormatIDs(ids, tt.version, tt.format)
+			if err != nil {
+				t.Fatalf("FormatIDs error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("FormatIDs = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := FormatIDs(ids, "v4", OutputFormat{List: "xml"}); err == nil {
+		t.Error("expected error for unsupported list")
+	}
+}
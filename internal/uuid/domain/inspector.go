@@ -0,0 +1,300 @@
+package domain
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// ID 类型
+const (
+	IDKindAuto      = "auto"
+	IDKindSnowflake = "snowflake"
+	IDKindObjectID  = "objectid"
+	IDKindULID      = "ulid"
+	IDKindKSUID     = "ksuid"
+	IDKindUUID      = "uuid"
+)
+
+const (
+	// ksuidEpoch KSUID 时间戳的纪元（2014-05-13 16:53:20 UTC）
+	ksuidEpoch = 1400000000
+	// ksuidLength KSUID 字符串长度
+	ksuidLength = 27
+	// gregorianEpochOffset 1582-10-15 至 1970-01-01 的 100 纳秒数
+	gregorianEpochOffset = 122192928000000000
+	// base62Alphabet KSUID 使用的 Base62 字母表
+	base62Alphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+)
+
+// IDComponent ID 中的一个组成部分
+type IDComponent struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+	// Bits 所占位数
+	Bits int `json:"bits,omitempty"`
+}
+
+// IDInfo ID 解析结果
+type IDInfo struct {
+	Kind  string
+	Input string
+	// Normalized 规范写法
+	Normalized string
+	// Layout Snowflake 布局名称
+	Layout     string
+	HasTime    bool
+	Time       time.Time
+	Components []IDComponent
+}
+
+// InspectOptions ID 解析选项
+type InspectOptions struct {
+	// Kind ID 类型，为空或 auto 时自动识别
+	Kind string `json:"kind"`
+	// Snowflake Snowflake 布局：仅填写 Name 时使用同名预设，填写位数时按自定义布局解析
+	Snowflake SnowflakeLayout `json:"snowflake"`
+}
+
+// Inspector 解析带时间信息的 ID：Snowflake、MongoDB ObjectID、ULID、KSUID 与 UUID
+type Inspector struct{}
+
+// NewInspector 创建新的 Inspector 实例
+func NewInspector() *Inspector {
+	return &Inspector{}
+}
+
+// Inspect 解析 ID，返回嵌入的时间及 worker、序列号、随机数等组成部分
+func (i *Inspector) Inspect(input string, opts InspectOptions) (*IDInfo, error) {
+	input = strings.TrimSpace(input)
+	if input == "" {
+		return nil, errors.WithStack(ErrEmptyID)
+	}
+
+	kind := opts.Kind
+	if kind == "" || kind == IDKindAuto {
+		kind = DetectIDKind(input)
+		if kind == "" {
+			return nil, errors.Wrapf(ErrUnrecognizedID, "无法识别的 ID: %s", input)
+		}
+	}
+
+	switch kind {
+	case IDKindSnowflake:
+		layout := opts.Snowflake
+		if layout.TimestampBits == 0 {
+			preset, err := SnowflakePreset(layout.Name)
+			if err != nil {
+				return nil, err
+			}
+			layout = preset
+		} else if layout.Name == "" {
+			layout.Name = SnowflakeCustom
+		}
+		return decodeSnowflake(input, layout)
+	case IDKindObjectID:
+		return decodeObjectID(input)
+	case IDKindULID:
+		return decodeULIDInfo(input)
+	case IDKindKSUID:
+		return decodeKSUID(input)
+	case IDKindUUID:
+		return decodeUUIDInfo(input)
+	default:
+		return nil, errors.Wrapf(ErrUnsupportedIDKind, "不支持的 ID 类型: %s", kind)
+	}
+}
+
+// DetectIDKind 根据长度与字符集识别 ID 类型，无法识别时返回空字符串
+func DetectIDKind(input string) string {
+	switch {
+	case isDigits(input) && len(input) <= 20:
+		return IDKindSnowflake
+	case len(input) == 24 && isHex(input):
+		return IDKindObjectID
+	case len(input) == ulidLength:
+		if _, err := decodeULID(input); err == nil {
+			return IDKindULID
+		}
+	case len(input) == ksuidLength:
+		if _, err := decodeBase62(input); err == nil {
+			return IDKindKSUID
+		}
+	}
+	if _, _, _, err := parseUUIDInput(input, ByteOrderRFC); err == nil {
+		return IDKindUUID
+	}
+	return ""
+}
+
+// decodeObjectID 拆解 MongoDB ObjectID：4 字节秒级时间戳 + 5 字节进程随机值 + 3 字节计数器
+func decodeObjectID(input string) (*IDInfo, error) {
+	data, err := hex.DecodeString(input)
+	if err != nil || len(data) != 12 {
+		return nil, errors.Wrapf(ErrInvalidID, "无效的 ObjectID: %s", input)
+	}
+	seconds := int64(binary.BigEndian.Uint32(data[:4]))
+	counter := uint32(data[9])<<16 | uint32(data[10])<<8 | uint32(data[11])
+	return &IDInfo{
+		Kind:       IDKindObjectID,
+		Input:      input,
+		Normalized: hex.EncodeToString(data),
+		HasTime:    true,
+		Time:       time.Unix(seconds, 0),
+		Components: []IDComponent{
+			{Name: "timestamp", Value: formatInt(seconds), Bits: 32},
+			{Name: "random", Value: hexString(data[4:9]), Bits: 40},
+			{Name: "counter", Value: strconv.FormatUint(uint64(counter), 10), Bits: 24},
+		},
+	}, nil
+}
+
+// decodeKSUID 拆解 KSUID：4 字节秒级时间戳（纪元 1400000000）+ 16 字节随机数
+func decodeKSUID(input string) (*IDInfo, error) {
+	data, err := decodeBase62(input)
+	if err != nil {
+		return nil, err
+	}
+	ticks := int64(binary.BigEndian.Uint32(data[:4]))
+	return &IDInfo{
+		Kind:       IDKindKSUID,
+		Input:      input,
+		Normalized: input,
+		HasTime:    true,
+		Time:       time.Unix(ksuidEpoch+ticks, 0),
+		Components: []IDComponent{
+			{Name: "timestamp", Value: formatInt(ticks), Bits: 32},
+			{Name: "payload", Value: hexString(data[4:]), Bits: 128},
+		},
+	}, nil
+}
+
+// decodeBase62 将 27 位 Base62 字符串解码为 20 字节
+func decodeBase62(s string) ([20]byte, error) {
+	var data [20]byte
+	if len(s) != ksuidLength {
+		return data, errors.Wrapf(ErrInvalidID, "KSUID 长度应为 %d", ksuidLength)
+	}
+	value := new(big.Int)
+	base := big.NewInt(62)
+	for i := 0; i < len(s); i++ {
+		index := strings.IndexByte(base62Alphabet, s[i])
+		if index < 0 {
+			return data, errors.Wrapf(ErrInvalidID, "KSUID 包含无效字符: %c", s[i])
+		}
+		value.Mul(value, base).Add(value, big.NewInt(int64(index)))
+	}
+	if value.BitLen() > len(data)*8 {
+		return data, errors.Wrap(ErrInvalidID, "KSUID 超出 160 位")
+	}
+	value.FillBytes(data[:])
+	return data, nil
+}
+
+// decodeUUIDInfo 拆解 UUID：v1/v6/v7 含时间戳，v1/v6 含时钟序列与节点
+// 输入支持 Parser 接受的所有写法（花括号、URN、无连字符、Base64、字节数组）
+func decodeUUIDInfo(input string) (*IDInfo, error) {
+	id, _, _, err := parseUUIDInput(input, ByteOrderRFC)
+	if err != nil {
+		return nil, err
+	}
+	info := &IDInfo{
+		Kind:       IDKindUUID,
+		Input:      input,
+		Normalized: id.String(),
+		Components: []IDComponent{
+			{Name: "version", Value: strconv.Itoa(int(id.Version())), Bits: 4},
+			{Name: "variant", Value: variantName(id)},
+		},
+	}
+
+	switch id.Version() {
+	case 1, 6:
+		ticks := gregorianTicks(id)
+		info.HasTime, info.Time = true, gregorianTime(ticks)
+		info.Components = append(info.Components,
+			IDComponent{Name: "timestamp", Value: formatInt(ticks), Bits: 60},
+			IDComponent{Name: "clockSequence", Value: strconv.Itoa(id.ClockSequence()), Bits: 14},
+			IDComponent{Name: "node", Value: formatNode(id.NodeID()), Bits: 48},
+		)
+	case 7:
+		millis := unixMillis(id)
+		info.HasTime, info.Time = true, time.UnixMilli(millis)
+		info.Components = append(info.Components,
+			IDComponent{Name: "timestamp", Value: formatInt(millis), Bits: 48},
+			IDComponent{Name: "randA", Value: hexString([]byte{id[6] & 0x0F, id[7]}), Bits: 12},
+			IDComponent{Name: "randB", Value: hexString(append([]byte{id[8] & 0x3F}, id[9:]...)), Bits: 62},
+		)
+	}
+	return info, nil
+}
+
+// unixMillis 返回 v7 UUID 前 48 位的 Unix 毫秒时间戳
+func unixMillis(id uuid.UUID) int64 {
+	return int64(binary.BigEndian.Uint64(id[:8]) >> 16)
+}
+
+// gregorianTicks 返回 v1/v6 UUID 中自 1582-10-15 起的 100 纳秒计数
+// v1 按 time_low、time_mid、time_hi 排列，v6 将其调整为由高到低以便排序
+func gregorianTicks(id uuid.UUID) int64 {
+	if id.Version() == 6 {
+		return int64(binary.BigEndian.Uint32(id[0:4]))<<28 |
+			int64(binary.BigEndian.Uint16(id[4:6]))<<12 |
+			int64(binary.BigEndian.Uint16(id[6:8])&0x0FFF)
+	}
+	return int64(binary.BigEndian.Uint32(id[0:4])) |
+		int64(binary.BigEndian.Uint16(id[4:6]))<<32 |
+		int64(binary.BigEndian.Uint16(id[6:8])&0x0FFF)<<48
+}
+
+// gregorianTime 将自 1582-10-15 起的 100 纳秒计数转换为时间
+func gregorianTime(ticks int64) time.Time {
+	unix := ticks - gregorianEpochOffset
+	return time.Unix(unix/1e7, unix%1e7*100)
+}
+
+// formatNode 将节点 ID 格式化为 MAC 地址形式
+func formatNode(node []byte) string {
+	parts := make([]string, len(node))
+	for i, b := range node {
+		parts[i] = hex.EncodeToString([]byte{b})
+	}
+	return strings.Join(parts, ":")
+}
+
+// formatInt 格式化整数
+func formatInt(v int64) string {
+	return strconv.FormatInt(v, 10)
+}
+
+// hexString 将字节格式化为十六进制字符串
+func hexString(data []byte) string {
+	return hex.EncodeToString(data)
+}
+
+// isDigits 判断字符串是否全部由数字组成
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return s != ""
+}
+
+// isHex 判断字符串是否全部由十六进制字符组成
+func isHex(s string) bool {
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if !(c >= '0' && c <= '9' || c >= 'a' && c <= 'f' || c >= 'A' && c <= 'F') {
+			return false
+		}
+	}
+	return s != ""
+}
@@ -0,0 +1,143 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestInspectorInspect(t *testing.T) {
+	inspector := NewInspector()
+	tests := []struct {
+		name       string
+		input      string
+		opts       InspectOptions
+		kind       string
+		time       string
+		components map[string]string
+	}{
+		{
+			name:       "Discord Snowflake",
+			input:      "175928847299117063",
+			opts:       InspectOptions{Snowflake: SnowflakeLayout{Name: SnowflakeDiscord}},
+			kind:       IDKindSnowflake,
+			time:       "2016-04-30T11:18:25.796Z",
+			components: map[string]string{"worker": "1", "process": "0", "sequence": "7"},
+		},
+		{
+			name:  "自定义 Snowflake 布局",
+			input: "4198401",
+			opts: InspectOptions{Snowflake: SnowflakeLayout{
+				Epoch: 1700000000000, TimeUnit: 1, TimestampBits: 41,
+				Fields: []SnowflakeField{{Name: "node", Bits: 10}, {Name: "sequence", Bits: 12}},
+			}},
+			kind:       IDKindSnowflake,
+			time:       "2023-11-14T22:13:20.001Z",
+			components: map[string]string{"node": "1", "sequence": "1"},
+		},
+		{
+			name:       "ObjectID",
+			input:      "507f1f77bcf86cd799439011",
+			kind:       IDKindObjectID,
+			time:       "2012-10-17T21:13:27Z",
+			components: map[string]string{"random": "bcf86cd799", "counter": "4427793"},
+		},
+		{
+			name:       "ULID",
+			input:      "01ARZ3NDEKTSV4RRFFQ69G5FAV",
+			kind:       IDKindULID,
+			time:       "2016-07-30T23:54:10.259Z",
+			components: map[string]string{"timestamp": "1469922850259"},
+		},
+		{
+			name:       "KSUID",
+			input:      "0ujtsYcgvSTl8PAuAdqWYSMnLOv",
+			kind:       IDKindKSUID,
+			time:       "2017-10-10T04:00:47Z",
+			components: map[string]string{"timestamp": "107608047", "payload": "b5a1cd34b5f99d1154fb6853345c9735"},
+		},
+		{
+			name:       "UUID v1",
+			input:      "C232AB00-9414-11EC-B3C8-9F6BDECED846",
+			kind:       IDKindUUID,
+			time:       "2022-02-22T19:22:22Z",
+			components: map[string]string{"version": "1", "clockSequence": "13256", "node": "9f:6b:de:ce:d8:46"},
+		},
+		{
+			name:       "UUID v6",
+			input:      "1ec9414c-232a-6b00-b3c8-9f6bdeced846",
+			kind:       IDKindUUID,
+			time:       "2022-02-22T19:22:22Z",
+			components: map[string]string{"version": "6", "clockSequence": "13256"},
+		},
+		{
+			name:       "UUID v7",
+			input:      "017f22e2-79b0-7cc3-98c4-dc0c0c07398f",
+			kind:       IDKindUUID,
+			time:       "2022-02-22T19:22:22Z",
+			components: map[string]string{"version": "7", "randA": "0cc3"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := inspector.Inspect(tt.input, tt.opts)
+			if err != nil {
+				t.Fatalf("Inspect(%q) error = %v", tt.input, err)
+			}
+			if got.Kind != tt.kind {
+				t.Errorf("Kind = %q, want %q", got.Kind, tt.kind)
+			}
+			if !got.HasTime || got.Time.UTC().Format(time.RFC3339Nano) != tt.time {
+				t.Errorf("Time = %v (HasTime %v), want %s", got.Time.UTC(), got.HasTime, tt.time)
+			}
+			values := map[string]string{}
+			for _, c := range got.Components {
+				values[c.Name] = c.Value
+			}
+			for name, want := range tt.components {
+				if values[name] != want {
+					t.Errorf("component %s = %q, want %q", name, values[name], want)
+				}
+			}
+		})
+	}
+}
+
+func TestInspectorErrors(t *testing.T) {
+	inspector := NewInspector()
+	tests := []struct {
+		name  string
+		input string
+		opts  InspectOptions
+	}{
+		{"空输入", " ", InspectOptions{}},
+		{"无法识别", "not-an-id", InspectOptions{}},
+		{"未知布局", "175928847299117063", InspectOptions{Snowflake: SnowflakeLayout{Name: "unknown"}}},
+		{"布局超过 64 位", "1", InspectOptions{Snowflake: SnowflakeLayout{TimeUnit: 1, TimestampBits: 60, Fields: []SnowflakeField{{Name: "x", Bits: 8}}}}},
+		{"超出布局位数", "18446744073709551615", InspectOptions{Snowflake: SnowflakeLayout{Name: SnowflakeTwitter}}},
+		{"ULID 溢出", "81ARZ3NDEKTSV4RRFFQ69G5FAV", InspectOptions{Kind: IDKindULID}},
+		{"不支持的类型", "1", InspectOptions{Kind: "xid"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := inspector.Inspect(tt.input, tt.opts); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
+
+func TestULIDRoundTrip(t *testing.T) {
+	var data [16]byte
+	for i := range data {
+		data[i] = byte(i*17 + 3)
+	}
+	encoded := encodeULID(data)
+	decoded, err := decodeULID(encoded)
+	if err != nil {
+		t.Fatalf("decodeULID error = %v", err)
+	}
+	if decoded != data {
+		t.Errorf("round trip = %x, want %x", decoded, data)
+	}
+}
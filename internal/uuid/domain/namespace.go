@@ -0,0 +1,133 @@
+package domain
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// 名称批量导出格式
+const (
+	ExportCSV  = "csv"
+	ExportJSON = "json"
+)
+
+// MaxBulkNames 批量按名称生成时的最大名称数
+const MaxBulkNames = 10000
+
+// Namespace 预定义命名空间
+type Namespace struct {
+	Name string `json:"name"`
+	UUID string `json:"uuid"`
+}
+
+// namespaces RFC 9562 附录 A 中预定义的命名空间
+var namespaces = []Namespace{
+	{Name: "dns", UUID: uuid.NameSpaceDNS.String()},
+	{Name: "url", UUID: uuid.NameSpaceURL.String()},
+	{Name: "oid", UUID: uuid.NameSpaceOID.String()},
+	{Name: "x500", UUID: uuid.NameSpaceX500.String()},
+}
+
+// NameUUID 名称及其生成的 UUID
+type NameUUID struct {
+	Name string `json:"name"`
+	UUID string `json:"uuid"`
+}
+
+// ListNamespaces 返回预定义的命名空间
+func ListNamespaces() []Namespace {
+	return append([]Namespace(nil), namespaces...)
+}
+
+// resolveNamespace 将命名空间名称（dns、url、oid、x500，不区分大小写）或 UUID 解析为命名空间
+func resolveNamespace(namespace string) (uuid.UUID, error) {
+	namespace = strings.TrimSpace(namespace)
+	for _, ns := range namespaces {
+		if strings.EqualFold(namespace, ns.Name) {
+			return uuid.MustParse(ns.UUID), nil
+		}
+	}
+	id, _, _, err := parseUUIDInput(namespace, ByteOrderRFC)
+	if err != nil {
+		return uuid.Nil, errors.Wrapf(err, "invalid namespace: %s", namespace)
+	}
+	return id, nil
+}
+
+// HashNames 为每个名称生成确定的 v3 或 v5 UUID，相同的命名空间与名称总是得到相同结果
+// names 按行拆分，忽略空行，行内空白按原样参与计算
+func (g *Generator) HashNames(version, namespace, names string) ([]NameUUID, error) {
+	if version != "v3" && version != "v5" {
+		return nil, errors.Wrapf(ErrUnsupportedUUIDVersion, "按名称生成仅支持 v3 与 v5: %s", version)
+	}
+	ns, err := resolveNamespace(namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	lines := splitNames(names)
+	if len(lines) == 0 {
+		if version == "v3" {
+			return nil, ErrV3RequiresNamespaceAndName
+		}
+		return nil, ErrV5RequiresNamespaceAndName
+	}
+	if len(lines) > MaxBulkNames {
+		return nil, errors.Wrapf(ErrInvalidGenerateOptions, "名称数量不能超过 %d", MaxBulkNames)
+	}
+
+	results := make([]NameUUID, len(lines))
+	for i, name := range lines {
+		var id uuid.UUID
+		if version == "v3" {
+			id = uuid.NewMD5(ns, []byte(name))
+		} else {
+			id = uuid.NewSHA1(ns, []byte(name))
+		}
+		results[i] = NameUUID{Name: name, UUID: id.String()}
+	}
+	return results, nil
+}
+
+// ExportNameUUIDs 将名称与 UUID 导出为 CSV（含表头）或 JSON
+func ExportNameUUIDs(items []NameUUID, format string) (string, error) {
+	switch format {
+	case ExportCSV, "":
+		var buf bytes.Buffer
+		w := csv.NewWriter(&buf)
+		records := make([][]string, 0, len(items)+1)
+		records = append(records, []string{"name", "uuid"})
+		for _, item := range items {
+			records = append(records, []string{item.Name, item.UUID})
+		}
+		if err := w.WriteAll(records); err != nil {
+			return "", errors.WithStack(err)
+		}
+		return buf.String(), nil
+	case ExportJSON:
+		data, err := json.MarshalIndent(items, "", "  ")
+		if err != nil {
+			return "", errors.WithStack(err)
+		}
+		return string(data), nil
+	default:
+		return "", errors.Wrapf(ErrInvalidGenerateOptions, "不支持的导出格式: %s", format)
+	}
+}
+
+// splitNames 按行拆分名称，去掉行尾的 \r 并忽略空行
+func splitNames(names string) []string {
+	var lines []string
+	for _, line := range strings.Split(names, "\n") {
+		line = strings.TrimSuffix(line, "\r")
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
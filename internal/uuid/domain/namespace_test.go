@@ -0,0 +1,77 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGeneratorNamespaces(t *testing.T) {
+	generator := NewGenerator()
+	tests := []struct {
+		name      string
+		version   string
+		namespace string
+		want      string
+	}{
+		{"v5 DNS 名称", "v5", "dns", "2ed6657d-e927-568b-95e1-2665a8aea6a2"},
+		{"v5 DNS 大写名称", "v5", "DNS", "2ed6657d-e927-568b-95e1-2665a8aea6a2"},
+		{"v5 DNS UUID", "v5", "6ba7b810-9dad-11d1-80b4-00c04fd430c8", "2ed6657d-e927-568b-95e1-2665a8aea6a2"},
+		{"v3 DNS 名称", "v3", "dns", "5df41881-3aed-3515-88a7-2f4a814cf09e"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got string
+			var err error
+			if tt.version == "v3" {
+				got, err = generator.GenerateV3(tt.namespace, "www.example.com")
+			} else {
+				got, err = generator.GenerateV5(tt.namespace, "www.example.com")
+			}
+			if err != nil {
+				t.Fatalf("generate error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := generator.GenerateV5("example", "www.example.com"); err == nil {
+		t.Error("expected error for unknown namespace")
+	}
+}
+
+func TestGeneratorHashNames(t *testing.T) {
+	generator := NewGenerator()
+	items, err := generator.HashNames("v5", "dns", "www.example.com\r\n\nexample.org\n")
+	if err != nil {
+		t.Fatalf("HashNames error = %v", err)
+	}
+	if len(items) != 2 || items[0].Name != "www.example.com" || items[0].UUID != "2ed6657d-e927-568b-95e1-2665a8aea6a2" {
+		t.Fatalf("items = %+v", items)
+	}
+
+	// 批量生成按行各生成一个，不再重复同一名称
+	ids, err := generator.Generate(GenerateOptions{Version: "v5", Count: 10, Namespace: "dns", Name: "www.example.com\nexample.org"})
+	if err != nil {
+		t.Fatalf("Generate error = %v", err)
+	}
+	if len(ids) != 2 || ids[1] != items[1].UUID {
+		t.Errorf("ids = %v", ids)
+	}
+
+	csvOutput, err := ExportNameUUIDs([]NameUUID{{Name: `a,"b"`, UUID: "u1"}}, ExportCSV)
+	if err != nil || csvOutput != "name,uuid\n\"a,\"\"b\"\"\",u1\n" {
+		t.Errorf("CSV = %q, err = %v", csvOutput, err)
+	}
+	jsonOutput, err := ExportNameUUIDs(items[:1], ExportJSON)
+	if err != nil || !strings.Contains(jsonOutput, `"uuid": "2ed6657d-e927-568b-95e1-2665a8aea6a2"`) {
+		t.Errorf("JSON = %s, err = %v", jsonOutput, err)
+	}
+
+	for _, tt := range []struct{ version, names string }{{"v4", "a"}, {"v5", "\n\n"}} {
+		if _, err := generator.HashNames(tt.version, "dns", tt.names); err == nil {
+			t.Errorf("HashNames(%q, %q) expected error", tt.version, tt.names)
+		}
+	}
+}
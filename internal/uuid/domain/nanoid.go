@@ -0,0 +1,78 @@
+package domain
+
+import (
+	"crypto/rand"
+	"math/bits"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// DefaultNanoIDAlphabet NanoID 默认字母表（URL 安全）
+	DefaultNanoIDAlphabet = "_-0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	// DefaultNanoIDLength NanoID 默认长度
+	DefaultNanoIDLength = 21
+	// MaxNanoIDLength NanoID 最大长度
+	MaxNanoIDLength = 256
+)
+
+// GenerateNanoID 使用自定义字母表与长度生成 NanoID，alphabet 为空或 length 小于等于 0 时使用默认值
+// 随机字节按掩码取值并丢弃超出字母表的部分，保证各字符概率均等
+func (g *Generator) GenerateNanoID(alphabet string, length int) (string, error) {
+	if err := validateNanoID(alphabet, length); err != nil {
+		return "", err
+	}
+	if alphabet == "" {
+		alphabet = DefaultNanoIDAlphabet
+	}
+	if length <= 0 {
+		length = DefaultNanoIDLength
+	}
+
+	symbols := []rune(alphabet)
+	mask := 1<<bits.Len(uint(len(symbols)-1)) - 1
+	var b strings.Builder
+	buf := make([]byte, length*2)
+	for count := 0; count < length; {
+		if _, err := rand.Read(buf); err != nil {
+			return "", errors.WithStack(err)
+		}
+		for _, v := range buf {
+			index := int(v) & mask
+			if index >= len(symbols) {
+				continue
+			}
+			b.WriteRune(symbols[index])
+			if count++; count == length {
+				break
+			}
+		}
+	}
+	return b.String(), nil
+}
+
+// validateNanoID 校验字母表与长度：字母表需包含 2 至 256 个互不重复的字符
+func validateNanoID(alphabet string, length int) error {
+	if length > MaxNanoIDLength {
+		return errors.Wrapf(ErrInvalidGenerateOptions, "NanoID 长度不能超过 %d", MaxNanoIDLength)
+	}
+	if alphabet == "" {
+		return nil
+	}
+	if !utf8.ValidString(alphabet) {
+		return errors.Wrap(ErrInvalidGenerateOptions, "字母表包含无效字符")
+	}
+	seen := make(map[rune]bool)
+	for _, r := range alphabet {
+		if seen[r] {
+			return errors.Wrapf(ErrInvalidGenerateOptions, "字母表中的字符 %q 重复", r)
+		}
+		seen[r] = true
+	}
+	if len(seen) < 2 || len(seen) > 256 {
+		return errors.Wrap(ErrInvalidGenerateOptions, "字母表需包含 2 至 256 个字符")
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+package domain
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// 批量输出的列表形式
+const (
+	// ListLines 每行一个
+	ListLines = "lines"
+	// ListJSON JSON 数组
+	ListJSON = "json"
+	// ListSQL SQL IN (...) 列表
+	ListSQL = "sql"
+)
+
+// OutputFormat 批量输出选项，大写、去连字符、花括号与 URN 仅作用于 UUID
+type OutputFormat struct {
+	Uppercase bool `json:"uppercase"`
+	NoDashes  bool `json:"noDashes"`
+	Braces    bool `json:"braces"`
+	URN       bool `json:"urn"`
+	// Quote 每行输出时包裹的引号：空、' 或 "
+	Quote string `json:"quote"`
+	// List 列表形式：lines、json、sql
+	List string `json:"list"`
+}
+
+// FormatIDs 按输出选项格式化生成结果，version 用于判断是否为 UUID
+func FormatIDs(ids []string, version string, format OutputFormat) (string, error) {
+	if format.Quote != "" && format.Quote != "'" && format.Quote != `"` {
+		return "", errors.Wrapf(ErrInvalidGenerateOptions, "不支持的引号: %s", format.Quote)
+	}
+
+	isUUID := version != VersionULID && version != VersionNanoID
+	formatted := make([]string, len(ids))
+	for i, id := range ids {
+		if isUUID {
+			id = formatUUID(id, format)
+		}
+		formatted[i] = id
+	}
+
+	switch format.List {
+	case ListLines, "":
+		for i, id := range formatted {
+			formatted[i] = format.Quote + id + format.Quote
+		}
+		return strings.Join(formatted, "\n"), nil
+	case ListJSON:
+		data, err := json.MarshalIndent(formatted, "", "  ")
+		if err != nil {
+			return "", errors.WithStack(err)
+		}
+		return string(data), nil
+	case ListSQL:
+		for i, id := range formatted {
+			formatted[i] = "'" + strings.ReplaceAll(id, "'", "''") + "'"
+		}
+		return "IN (" + strings.Join(formatted, ", ") + ")", nil
+	default:
+		return "", errors.Wrapf(ErrInvalidGenerateOptions, "不支持的列表形式: %s", format.List)
+	}
+}
+
+// formatUUID 按选项转换单个 UUID 的写法
+func formatUUID(id string, format OutputFormat) string {
+	if format.NoDashes {
+		id = strings.ReplaceAll(id, "-", "")
+	}
+	if format.Uppercase {
+		id = strings.ToUpper(id)
+	}
+	switch {
+	case format.URN:
+		id = "urn:uuid:" + id
+	case format.Braces:
+		id = "{" + id + "}"
+	}
+	return id
+}
@@ -0,0 +1,292 @@
+package domain
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// UUID 输入形式
+const (
+	UUIDFormatCanonical = "canonical"
+	UUIDFormatBraces    = "braces"
+	UUIDFormatURN       = "urn"
+	UUIDFormatHex       = "hex"
+	UUIDFormatBase64    = "base64"
+	UUIDFormatBytes     = "bytes"
+)
+
+// 字节序：影响 Base64 与字节数组形式的输入
+const (
+	// ByteOrderRFC 按 RFC 9562 的网络字节序（大端）
+	ByteOrderRFC = "rfc"
+	// ByteOrderGUID 按 Microsoft GUID 的混合字节序（前三段小端），如 .NET Guid.ToByteArray
+	ByteOrderGUID = "guid"
+)
+
+// versionNames 各版本的说明
+var versionNames = map[int]string{
+	1: "时间戳 + MAC 地址",
+	2: "DCE 安全",
+	3: "命名空间 + MD5",
+	4: "随机",
+	5: "命名空间 + SHA-1",
+	6: "可排序时间戳 + 节点",
+	7: "Unix 毫秒时间戳 + 随机",
+	8: "自定义",
+}
+
+// UUIDDetails UUID 解析结果
+type UUIDDetails struct {
+	Input string `json:"input"`
+	// Format 识别到的输入形式
+	Format string `json:"format"`
+	// ByteOrder 二进制输入采用的字节序
+	ByteOrder   string    `json:"byteOrder"`
+	Canonical   string    `json:"canonical"`
+	Uppercase   string    `json:"uppercase"`
+	Braces      string    `json:"braces"`
+	URN         string    `json:"urn"`
+	Hex         string    `json:"hex"`
+	Base64      string    `json:"base64"`
+	Base64URL   string    `json:"base64Url"`
+	Bytes       string    `json:"bytes"`
+	GUIDBytes   string    `json:"guidBytes"`
+	GUIDBase64  string    `json:"guidBase64"`
+	Version     int       `json:"version"`
+	VersionName string    `json:"versionName"`
+	Variant     string    `json:"variant"`
+	IsNil       bool      `json:"isNil"`
+	IsMax       bool      `json:"isMax"`
+	HasTime     bool      `json:"hasTime"`
+	Time        time.Time `json:"-"`
+	// ClockSequence v1/v6 的时钟序列，其他版本为 -1
+	ClockSequence int `json:"clockSequence"`
+	// Node v1/v6 的节点（通常为 MAC 地址）
+	Node string `json:"node"`
+	// Multicast 节点的组播位为 1 时表示随机生成而非真实 MAC 地址
+	Multicast bool     `json:"multicast"`
+	Warnings  []string `json:"warnings"`
+}
+
+// Parser 解析并校验各种写法的 UUID
+type Parser struct{}
+
+// NewParser 创建新的 Parser 实例
+func NewParser() *Parser {
+	return &Parser{}
+}
+
+// Parse 规范化输入（花括号、URN、大小写、无连字符、Base64、字节数组）并解析 UUID
+// byteOrder 指定 Base64 与字节数组输入的字节序，为空时按 RFC 字节序
+func (p *Parser) Parse(input, byteOrder string) (*UUIDDetails, error) {
+	if byteOrder == "" {
+		byteOrder = ByteOrderRFC
+	}
+	if byteOrder != ByteOrderRFC && byteOrder != ByteOrderGUID {
+		return nil, errors.Wrapf(ErrInvalidUUID, "不支持的字节序: %s", byteOrder)
+	}
+
+	id, format, warnings, err := parseUUIDInput(input, byteOrder)
+	if err != nil {
+		return nil, err
+	}
+	if format != UUIDFormatBase64 && format != UUIDFormatBytes {
+		byteOrder = ByteOrderRFC
+	}
+
+	canonical := id.String()
+	guid := guidBytes(id)
+	details := &UUIDDetails{
+		Input:         strings.TrimSpace(input),
+		Format:        format,
+		ByteOrder:     byteOrder,
+		Canonical:     canonical,
+		Uppercase:     strings.ToUpper(canonical),
+		Braces:        "{" + canonical + "}",
+		URN:           "urn:uuid:" + canonical,
+		Hex:           hex.EncodeToString(id[:]),
+		Base64:        base64.StdEncoding.EncodeToString(id[:]),
+		Base64URL:     base64.RawURLEncoding.EncodeToString(id[:]),
+		Bytes:         formatByteArray(id[:]),
+		GUIDBytes:     formatByteArray(guid[:]),
+		GUIDBase64:    base64.StdEncoding.EncodeToString(guid[:]),
+		Variant:       variantName(id),
+		IsNil:         id == uuid.Nil,
+		IsMax:         id == uuid.Max,
+		ClockSequence: -1,
+		Warnings:      warnings,
+	}
+
+	switch {
+	case details.IsNil:
+		details.VersionName = "Nil UUID"
+	case details.IsMax:
+		details.VersionName = "Max UUID"
+	case id.Variant() != uuid.RFC4122:
+		details.Warnings = append(details.Warnings, "变体不是 RFC 9562，版本号没有意义")
+	default:
+		details.Version = int(id.Version())
+		details.VersionName = versionNames[details.Version]
+		if details.VersionName == "" {
+			details.Warnings = append(details.Warnings, fmt.Sprintf("未定义的版本 %d", details.Version))
+		}
+	}
+
+	switch details.Version {
+	case 1, 6:
+		details.HasTime, details.Time = true, gregorianTime(gregorianTicks(id))
+		details.ClockSequence = id.ClockSequence()
+		details.Node = formatNode(id.NodeID())
+		details.Multicast = id[10]&0x01 == 1
+	case 7:
+		details.HasTime, details.Time = true, unixMilliTime(id)
+	}
+	return details, nil
+}
+
+// parseUUIDInput 识别输入形式并解析为 UUID
+func parseUUIDInput(input, byteOrder string) (uuid.UUID, string, []string, error) {
+	s := strings.Trim(strings.TrimSpace(input), `"'`)
+	if s == "" {
+		return uuid.Nil, "", nil, errors.WithStack(ErrEmptyID)
+	}
+
+	format := UUIDFormatCanonical
+	var warnings []string
+	switch {
+	case len(s) > 9 && strings.EqualFold(s[:9], "urn:uuid:"):
+		s, format = s[9:], UUIDFormatURN
+	case strings.HasPrefix(s, "{") && strings.HasSuffix(s, "}") && !strings.Contains(s, ","):
+		s, format = s[1:len(s)-1], UUIDFormatBraces
+	case strings.HasPrefix(s, "(") && strings.HasSuffix(s, ")"):
+		s, format = s[1:len(s)-1], UUIDFormatBraces
+	}
+
+	// 字节数组：0x12, 0x34, ... 或 [18 52 ...]
+	if format == UUIDFormatCanonical && (strings.ContainsAny(s, ",[") || strings.Contains(strings.ToLower(s), "0x")) {
+		data, err := parseByteArray(s)
+		if err != nil {
+			return uuid.Nil, "", nil, err
+		}
+		return fromBinary(data, byteOrder), UUIDFormatBytes, nil, nil
+	}
+
+	compact := strings.ReplaceAll(s, "-", "")
+	if len(compact) == 32 && isHex(compact) {
+		switch {
+		case !strings.Contains(s, "-"):
+			if format == UUIDFormatCanonical {
+				format = UUIDFormatHex
+			}
+		case len(s) != 36 || s[8] != '-' || s[13] != '-' || s[18] != '-' || s[23] != '-':
+			warnings = append(warnings, "连字符位置不标准，已忽略")
+		}
+		if strings.ToLower(s) != s {
+			warnings = append(warnings, "包含大写字母，规范写法为小写")
+		}
+		data, _ := hex.DecodeString(compact)
+		var id uuid.UUID
+		copy(id[:], data)
+		return id, format, warnings, nil
+	}
+
+	if format == UUIDFormatCanonical {
+		if data, ok := decodeBase64UUID(s); ok {
+			return fromBinary(data, byteOrder), UUIDFormatBase64, nil, nil
+		}
+	}
+	return uuid.Nil, "", nil, errors.Wrapf(ErrInvalidUUID, "无法解析的 UUID: %s", input)
+}
+
+// parseByteArray 解析 16 个字节的数组，元素可以是 0x 十六进制或十进制
+func parseByteArray(s string) ([16]byte, error) {
+	var data [16]byte
+	if i := strings.LastIndexAny(s, "[{"); i >= 0 {
+		s = s[i+1:]
+	}
+	s = strings.TrimRight(s, "]}; ")
+	fields := strings.FieldsFunc(s, func(r rune) bool {
+		return r == ',' || r == ' ' || r == '\t' || r == '\n'
+	})
+	if len(fields) != len(data) {
+		return data, errors.Wrapf(ErrInvalidUUID, "字节数组应包含 16 个元素，实际为 %d 个", len(fields))
+	}
+	for i, field := range fields {
+		base := 10
+		if lower := strings.ToLower(field); strings.HasPrefix(lower, "0x") {
+			field, base = field[2:], 16
+		}
+		v, err := strconv.ParseUint(field, base, 8)
+		if err != nil {
+			return data, errors.Wrapf(ErrInvalidUUID, "第 %d 个字节无效: %s", i+1, fields[i])
+		}
+		data[i] = byte(v)
+	}
+	return data, nil
+}
+
+// decodeBase64UUID 解码标准或 URL 安全的 Base64（可省略填充）
+func decodeBase64UUID(s string) ([16]byte, bool) {
+	var data [16]byte
+	trimmed := strings.TrimRight(s, "=")
+	for _, encoding := range []*base64.Encoding{base64.RawStdEncoding, base64.RawURLEncoding} {
+		decoded, err := encoding.DecodeString(trimmed)
+		if err == nil && len(decoded) == len(data) {
+			copy(data[:], decoded)
+			return data, true
+		}
+	}
+	return data, false
+}
+
+// fromBinary 按字节序将二进制转换为 UUID
+func fromBinary(data [16]byte, byteOrder string) uuid.UUID {
+	if byteOrder == ByteOrderGUID {
+		return uuid.UUID(guidBytes(uuid.UUID(data)))
+	}
+	return uuid.UUID(data)
+}
+
+// guidBytes 在 RFC 字节序与 Microsoft GUID 混合字节序之间转换（前三段字节反转，操作可逆）
+func guidBytes(id uuid.UUID) [16]byte {
+	b := [16]byte(id)
+	b[0], b[1], b[2], b[3] = b[3], b[2], b[1], b[0]
+	b[4], b[5] = b[5], b[4]
+	b[6], b[7] = b[7], b[6]
+	return b
+}
+
+// formatByteArray 将字节格式化为 0x12, 0x34 形式
+func formatByteArray(data []byte) string {
+	parts := make([]string, len(data))
+	for i, b := range data {
+		parts[i] = fmt.Sprintf("0x%02x", b)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// variantName 返回变体名称
+func variantName(id uuid.UUID) string {
+	switch id.Variant() {
+	case uuid.Reserved:
+		return "NCS（保留）"
+	case uuid.RFC4122:
+		return "RFC 9562"
+	case uuid.Microsoft:
+		return "Microsoft（保留）"
+	default:
+		return "Future（保留）"
+	}
+}
+
+// unixMilliTime 返回 v7 UUID 的毫秒时间戳
+func unixMilliTime(id uuid.UUID) time.Time {
+	return time.UnixMilli(unixMillis(id))
+}
@@ -0,0 +1,100 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParserNormalize(t *testing.T) {
+	parser := NewParser()
+	const canonical = "c232ab00-9414-11ec-b3c8-9f6bdeced846"
+	tests := []struct {
+		name      string
+		input     string
+		byteOrder string
+		format    string
+	}{
+		{"标准写法", canonical, "", UUIDFormatCanonical},
+		{"大写花括号", "{C232AB00-9414-11EC-B3C8-9F6BDECED846}", "", UUIDFormatBraces},
+		{"URN", "urn:uuid:" + canonical, "", UUIDFormatURN},
+		{"无连字符", "c232ab00941411ecb3c89f6bdeced846", "", UUIDFormatHex},
+		{"Base64", "wjKrAJQUEeyzyJ9r3s7YRg==", "", UUIDFormatBase64},
+		{"Base64 URL", "wjKrAJQUEeyzyJ9r3s7YRg", "", UUIDFormatBase64},
+		{"字节数组", "[]byte{0xc2, 0x32, 0xab, 0x00, 0x94, 0x14, 0x11, 0xec, 0xb3, 0xc8, 0x9f, 0x6b, 0xde, 0xce, 0xd8, 0x46}", "", UUIDFormatBytes},
+		{"十进制字节数组", "[194 50 171 0 148 20 17 236 179 200 159 107 222 206 216 70]", "", UUIDFormatBytes},
+		{"GUID 字节序", "0x00, 0xab, 0x32, 0xc2, 0x14, 0x94, 0xec, 0x11, 0xb3, 0xc8, 0x9f, 0x6b, 0xde, 0xce, 0xd8, 0x46", ByteOrderGUID, UUIDFormatBytes},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parser.Parse(tt.input, tt.byteOrder)
+			if err != nil {
+				t.Fatalf("Parse(%q) error = %v", tt.input, err)
+			}
+			if got.Canonical != canonical {
+				t.Errorf("Canonical = %q, want %q", got.Canonical, canonical)
+			}
+			if got.Format != tt.format {
+				t.Errorf("Format = %q, want %q", got.Format, tt.format)
+			}
+		})
+	}
+}
+
+func TestParserDetails(t *testing.T) {
+	parser := NewParser()
+
+	got, err := parser.Parse("c232ab00-9414-11ec-b3c8-9f6bdeced846", "")
+	if err != nil {
+		t.Fatalf("Parse error = %v", err)
+	}
+	if got.Version != 1 || got.Variant != "RFC 9562" {
+		t.Errorf("Version = %d, Variant = %q", got.Version, got.Variant)
+	}
+	if !got.HasTime || !got.Time.Equal(time.Date(2022, 2, 22, 19, 22, 22, 0, time.UTC)) {
+		t.Errorf("Time = %v", got.Time)
+	}
+	if got.ClockSequence != 13256 || got.Node != "9f:6b:de:ce:d8:46" || !got.Multicast {
+		t.Errorf("ClockSequence = %d, Node = %q, Multicast = %v", got.ClockSequence, got.Node, got.Multicast)
+	}
+	if got.GUIDBytes != "0x00, 0xab, 0x32, 0xc2, 0x14, 0x94, 0xec, 0x11, 0xb3, 0xc8, 0x9f, 0x6b, 0xde, 0xce, 0xd8, 0x46" {
+		t.Errorf("GUIDBytes = %q", got.GUIDBytes)
+	}
+	if got.Hex != "c232ab00941411ecb3c89f6bdeced846" || got.Base64 != "wjKrAJQUEeyzyJ9r3s7YRg==" {
+		t.Errorf("Hex = %q, Base64 = %q", got.Hex, got.Base64)
+	}
+
+	v7, err := parser.Parse("017f22e2-79b0-7cc3-98c4-dc0c0c07398f", "")
+	if err != nil {
+		t.Fatalf("Parse error = %v", err)
+	}
+	if v7.Version != 7 || !v7.HasTime || v7.Time.UnixMilli() != 1645557742000 || v7.ClockSequence != -1 {
+		t.Errorf("v7 = %+v", v7)
+	}
+
+	for input, check := range map[string]func(*UUIDDetails) bool{
+		"00000000-0000-0000-0000-000000000000": func(d *UUIDDetails) bool { return d.IsNil && d.Version == 0 },
+		"FFFFFFFF-FFFF-FFFF-FFFF-FFFFFFFFFFFF": func(d *UUIDDetails) bool { return d.IsMax && len(d.Warnings) == 1 },
+		"c232ab00-9414-11ec-f3c8-9f6bdeced846": func(d *UUIDDetails) bool { return d.Version == 0 && len(d.Warnings) == 1 },
+	} {
+		got, err := parser.Parse(input, "")
+		if err != nil {
+			t.Fatalf("Parse(%q) error = %v", input, err)
+		}
+		if !check(got) {
+			t.Errorf("Parse(%q) = %+v", input, got)
+		}
+	}
+}
+
+func TestParserErrors(t *testing.T) {
+	parser := NewParser()
+	for _, input := range []string{"", "not-a-uuid", "c232ab00-9414-11ec-b3c8-9f6bdeced84", "0x01, 0x02", "[1 2 3 4 5 6 7 8 9 10 11 12 13 14 15 256]"} {
+		if _, err := parser.Parse(input, ""); err == nil {
+			t.Errorf("Parse(%q) expected error", input)
+		}
+	}
+	if _, err := parser.Parse("c232ab00-9414-11ec-b3c8-9f6bdeced846", "little"); err == nil {
+		t.Error("expected error for unsupported byte order")
+	}
+}
@@ -0,0 +1,129 @@
+package domain
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Snowflake 预设布局名称
+const (
+	SnowflakeTwitter   = "twitter"
+	SnowflakeDiscord   = "discord"
+	SnowflakeInstagram = "instagram"
+	SnowflakeSonyflake = "sonyflake"
+	SnowflakeCustom    = "custom"
+)
+
+// SnowflakeField 时间戳之后的一个位段
+type SnowflakeField struct {
+	Name string `json:"name"`
+	Bits int    `json:"bits"`
+}
+
+// SnowflakeLayout Snowflake ID 的位布局：从高位起依次为时间戳与 Fields 中的各位段，未用到的高位须为 0
+type SnowflakeLayout struct {
+	Name string `json:"name"`
+	// Epoch 自定义纪元（Unix 毫秒）
+	Epoch int64 `json:"epoch"`
+	// TimeUnit 时间戳的单位（毫秒），Sonyflake 为 10
+	TimeUnit int64 `json:"timeUnit"`
+	// TimestampBits 时间戳位数
+	TimestampBits int `json:"timestampBits"`
+	// Fields 时间戳之后的位段，从高位到低位
+	Fields []SnowflakeField `json:"fields"`
+}
+
+// snowflakePresets 常见 Snowflake 实现的布局
+var snowflakePresets = map[string]SnowflakeLayout{
+	SnowflakeTwitter: {
+		Name: SnowflakeTwitter, Epoch: 1288834974657, TimeUnit: 1, TimestampBits: 41,
+		Fields: []SnowflakeField{{Name: "datacenter", Bits: 5}, {Name: "worker", Bits: 5}, {Name: "sequence", Bits: 12}},
+	},
+	SnowflakeDiscord: {
+		Name: SnowflakeDiscord, Epoch: 1420070400000, TimeUnit: 1, TimestampBits: 42,
+		Fields: []SnowflakeField{{Name: "worker", Bits: 5}, {Name: "process", Bits: 5}, {Name: "sequence", Bits: 12}},
+	},
+	SnowflakeInstagram: {
+		Name: SnowflakeInstagram, Epoch: 1314220021721, TimeUnit: 1, TimestampBits: 41,
+		Fields: []SnowflakeField{{Name: "shard", Bits: 13}, {Name: "sequence", Bits: 10}},
+	},
+	SnowflakeSonyflake: {
+		Name: SnowflakeSonyflake, Epoch: 1409529600000, TimeUnit: 10, TimestampBits: 39,
+		Fields: []SnowflakeField{{Name: "sequence", Bits: 8}, {Name: "machine", Bits: 16}},
+	},
+}
+
+// SnowflakePreset 返回预设布局，name 为空时使用 Twitter 布局
+func SnowflakePreset(name string) (SnowflakeLayout, error) {
+	if name == "" {
+		name = SnowflakeTwitter
+	}
+	layout, ok := snowflakePresets[strings.ToLower(name)]
+	if !ok {
+		return SnowflakeLayout{}, errors.Wrapf(ErrInvalidSnowflakeLayout, "未知的 Snowflake 布局: %s", name)
+	}
+	layout.Fields = append([]SnowflakeField(nil), layout.Fields...)
+	return layout, nil
+}
+
+// SnowflakePresetNames 返回预设布局名称
+func SnowflakePresetNames() []string {
+	return []string{SnowflakeTwitter, SnowflakeDiscord, SnowflakeInstagram, SnowflakeSonyflake}
+}
+
+// Validate 校验布局：时间戳与各位段之和不超过 64 位
+func (l SnowflakeLayout) Validate() error {
+	if l.TimestampBits <= 0 {
+		return errors.Wrap(ErrInvalidSnowflakeLayout, "时间戳位数必须大于 0")
+	}
+	if l.TimeUnit <= 0 {
+		return errors.Wrap(ErrInvalidSnowflakeLayout, "时间单位必须大于 0")
+	}
+	total := l.TimestampBits
+	for _, field := range l.Fields {
+		if field.Bits <= 0 {
+			return errors.Wrapf(ErrInvalidSnowflakeLayout, "位段 %s 的位数必须大于 0", field.Name)
+		}
+		total += field.Bits
+	}
+	if total > 64 {
+		return errors.Wrapf(ErrInvalidSnowflakeLayout, "位数之和为 %d，超过 64", total)
+	}
+	return nil
+}
+
+// decodeSnowflake 按布局拆解 Snowflake ID
+func decodeSnowflake(input string, layout SnowflakeLayout) (*IDInfo, error) {
+	if err := layout.Validate(); err != nil {
+		return nil, err
+	}
+	value, err := strconv.ParseUint(input, 10, 64)
+	if err != nil {
+		return nil, errors.Wrapf(ErrInvalidID, "无效的 Snowflake ID: %s", input)
+	}
+
+	info := &IDInfo{Kind: IDKindSnowflake, Input: input, Normalized: input, Layout: layout.Name}
+	// 从低位到高位依次取出各位段
+	remaining := value
+	components := make([]IDComponent, len(layout.Fields))
+	for i := len(layout.Fields) - 1; i >= 0; i-- {
+		field := layout.Fields[i]
+		components[i] = IDComponent{Name: field.Name, Value: strconv.FormatUint(remaining&(1<<field.Bits-1), 10), Bits: field.Bits}
+		remaining >>= field.Bits
+	}
+	ticks := remaining & (1<<layout.TimestampBits - 1)
+	if remaining != ticks {
+		return nil, errors.Wrapf(ErrInvalidID, "ID 超出 %s 布局的位数", layout.Name)
+	}
+
+	millis := layout.Epoch + int64(ticks)*layout.TimeUnit
+	info.Time = time.UnixMilli(millis)
+	info.HasTime = true
+	info.Components = append([]IDComponent{
+		{Name: "timestamp", Value: strconv.FormatUint(ticks, 10), Bits: layout.TimestampBits},
+	}, components...)
+	return info, nil
+}
@@ -0,0 +1,123 @@
+package domain
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// crockfordAlphabet Crockford Base32 字母表（不含 I、L、O、U）
+	crockfordAlphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+	// ulidLength ULID 字符串长度
+	ulidLength = 26
+)
+
+// crockfordDecode Crockford Base32 解码表，兼容小写以及 I/L→1、O→0 的容错写法
+var crockfordDecode = func() [256]byte {
+	var table [256]byte
+	for i := range table {
+		table[i] = 0xFF
+	}
+	for i := 0; i < len(crockfordAlphabet); i++ {
+		c := crockfordAlphabet[i]
+		table[c] = byte(i)
+		table[strings.ToLower(string(c))[0]] = byte(i)
+	}
+	for _, c := range "Ii" + "Ll" {
+		table[c] = 1
+	}
+	table['O'], table['o'] = 0, 0
+	return table
+}()
+
+// encodeULID 将 16 字节编码为 26 位 ULID 字符串（128 位按 5 位一组，首字符仅占 3 位）
+func encodeULID(data [16]byte) string {
+	hi := binary.BigEndian.Uint64(data[:8])
+	lo := binary.BigEndian.Uint64(data[8:])
+	out := make([]byte, ulidLength)
+	for i := ulidLength - 1; i >= 0; i-- {
+		out[i] = crockfordAlphabet[lo&0x1F]
+		lo = lo>>5 | hi<<59
+		hi >>= 5
+	}
+	return string(out)
+}
+
+// decodeULID 将 26 位 ULID 字符串解码为 16 字节
+func decodeULID(s string) ([16]byte, error) {
+	var data [16]byte
+	if len(s) != ulidLength {
+		return data, errors.Wrapf(ErrInvalidID, "ULID 长度应为 %d", ulidLength)
+	}
+	var hi, lo uint64
+	for i := 0; i < len(s); i++ {
+		v := crockfordDecode[s[i]]
+		if v == 0xFF {
+			return data, errors.Wrapf(ErrInvalidID, "ULID 包含无效字符: %c", s[i])
+		}
+		if i == 0 && v > 7 {
+			return data, errors.Wrap(ErrInvalidID, "ULID 超出 128 位")
+		}
+		hi = hi<<5 | lo>>59
+		lo = lo<<5 | uint64(v)
+	}
+	binary.BigEndian.PutUint64(data[:8], hi)
+	binary.BigEndian.PutUint64(data[8:], lo)
+	return data, nil
+}
+
+// decodeULIDInfo 拆解 ULID：48 位毫秒时间戳 + 80 位随机数
+func decodeULIDInfo(input string) (*IDInfo, error) {
+	data, err := decodeULID(input)
+	if err != nil {
+		return nil, err
+	}
+	millis := int64(data[0])<<40 | int64(data[1])<<32 | int64(binary.BigEndian.Uint32(data[2:6]))
+	return &IDInfo{
+		Kind:       IDKindULID,
+		Input:      input,
+		Normalized: encodeULID(data),
+		HasTime:    true,
+		Time:       time.UnixMilli(millis),
+		Components: []IDComponent{
+			{Name: "timestamp", Value: formatInt(millis), Bits: 48},
+			{Name: "random", Value: hexString(data[6:]), Bits: 80},
+		},
+	}, nil
+}
+
+// GenerateULID 生成 ULID（48 位毫秒时间戳 + 80 位随机数）
+// 同一毫秒内连续生成时在上一个 ULID 的随机部分上加一，保证单调递增
+func (g *Generator) GenerateULID() (string, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	var data [16]byte
+	now := uint64(time.Now().UnixMilli())
+	binary.BigEndian.PutUint16(data[0:2], uint16(now>>32))
+	binary.BigEndian.PutUint32(data[2:6], uint32(now))
+
+	if [6]byte(data[:6]) != [6]byte(g.lastULID[:6]) {
+		if _, err := rand.Read(data[6:]); err != nil {
+			return "", errors.WithStack(err)
+		}
+	} else {
+		copy(data[6:], g.lastULID[6:])
+		i := len(data) - 1
+		for ; i >= 6; i-- {
+			data[i]++
+			if data[i] != 0 {
+				break
+			}
+		}
+		if i < 6 {
+			return "", errors.WithStack(ErrULIDOverflow)
+		}
+	}
+	g.lastULID = data
+	return encodeULID(data), nil
+}
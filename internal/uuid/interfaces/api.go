@@ -3,6 +3,7 @@ package interfaces
 import (
 	historydomain "github.com/cyrnicolase/dev-tools/internal/history/domain"
 	"github.com/cyrnicolase/dev-tools/internal/uuid/application"
+	"github.com/cyrnicolase/dev-tools/internal/uuid/domain"
 )
 
 // API UUID 工具 API
@@ -47,6 +48,56 @@ func (a *API) GenerateBatch(version string, count int, namespace, name string) (
 	return a.service.GenerateBatch(version, count, namespace, name)
 }
 
+// GenerateV6 生成 UUID v6
+func (a *API) GenerateV6() (string, error) {
+	return a.service.GenerateV6()
+}
+
+// GenerateV8 生成 UUID v8
+func (a *API) GenerateV8(payload string) (string, error) {
+	return a.service.GenerateV8(payload)
+}
+
+// GenerateULID 生成 ULID
+func (a *API) GenerateULID() (string, error) {
+	return a.service.GenerateULID()
+}
+
+// GenerateNanoID 生成 NanoID
+func (a *API) GenerateNanoID(alphabet string, length int) (string, error) {
+	return a.service.GenerateNanoID(alphabet, length)
+}
+
+// GenerateWithOptions 按选项批量生成并格式化输出
+func (a *API) GenerateWithOptions(opts domain.GenerateOptions, format domain.OutputFormat) (*application.GenerateResult, error) {
+	return a.service.GenerateWithOptions(opts, format)
+}
+
+// ListNamespaces 获取预定义的命名空间
+func (a *API) ListNamespaces() []domain.Namespace {
+	return a.service.ListNamespaces()
+}
+
+// HashNames 按名称批量生成 v3/v5 UUID
+func (a *API) HashNames(version, namespace, names, exportFormat string) (*application.HashNamesResult, error) {
+	return a.service.HashNames(version, namespace, names, exportFormat)
+}
+
+// InspectID 解析带时间信息的 ID
+func (a *API) InspectID(input string, opts domain.InspectOptions, format, timezone string) (*application.IDInspection, error) {
+	return a.service.InspectID(input, opts, format, timezone)
+}
+
+// ParseUUID 规范化并解析 UUID
+func (a *API) ParseUUID(input, byteOrder, format, timezone string) (*application.UUIDParseResult, error) {
+	return a.service.ParseUUID(input, byteOrder, format, timezone)
+}
+
+// ListSnowflakePresets 获取预设的 Snowflake 布局
+func (a *API) ListSnowflakePresets() []domain.SnowflakeLayout {
+	return a.service.ListSnowflakePresets()
+}
+
 // ListHistory 获取历史记录
 func (a *API) ListHistory() ([]historydomain.ToolHistoryRecord, error) {
 	return a.service.ListHistory()
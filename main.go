@@ -141,6 +141,8 @@ func createAppConfig(appInstance *app.App) *options.App {
 			appInstance.Handlers.Translate,    // 翻译工具处理器
 			appInstance.Handlers.Hash,         // 散列值计算工具处理器
 			appInstance.Handlers.RandomString, // 随机字符串工具处理器
+			appInstance.Handlers.Hex,          // 十六进制工具处理器
+			appInstance.Handlers.Cron,         // cron 表达式工具处理器
 			appInstance.Theme.GetHandler(),    // 主题处理器
 		},
 	}
@@ -21,13 +21,13 @@ esac
 
 # 验证工具名称
 case "$TOOL_NAME" in
-  json|base64|timestamp|uuid|url|qrcode|ipquery|translate|hash|randomstring)
+  json|base64|timestamp|uuid|url|qrcode|ipquery|translate|hash|randomstring|hex|cron)
     # 使用 URL Scheme 打开应用
     open "devtools://tool/$TOOL_NAME"
     ;;
   *)
     echo "无效的工具名称: $TOOL_NAME"
-    echo "可用工具: json, base64, timestamp, uuid, url, qrcode, ipquery, translate, hash, randomstring"
+    echo "可用工具: json, base64, timestamp, uuid, url, qrcode, ipquery, translate, hash, randomstring, hex, cron"
     exit 1
     ;;
 esac